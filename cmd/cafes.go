@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 )
 
 func CafeAdd(peerId string, token string) error {
@@ -51,3 +53,95 @@ func CafeMessages() error {
 	output(res)
 	return nil
 }
+
+func CafeClientList() error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/clients", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientGet(clientID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/clients/"+clientID, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientThreads(clientID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/clients/"+clientID+"/threads", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientQuota(clientID string, bytes int64, objects int32, threads int32) error {
+	res, err := executeJsonCmd(http.MethodPut, "cafe/clients/"+clientID+"/quota", params{
+		opts: map[string]string{
+			"quota_bytes":   strconv.FormatInt(bytes, 10),
+			"quota_objects": strconv.FormatInt(int64(objects), 10),
+			"quota_threads": strconv.FormatInt(int64(threads), 10),
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientRevoke(clientID string, token string) error {
+	if token != "" {
+		res, err := executeJsonCmd(http.MethodPost, "tokens/"+token+"/revoke", params{}, nil)
+		if err != nil {
+			return err
+		}
+		output(res)
+		return nil
+	}
+	if clientID == "" {
+		return fmt.Errorf("a client peer ID or token is required")
+	}
+
+	res, err := executeStringCmd(http.MethodPost, "cafe/clients/"+clientID+"/revoke", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeGC(dryRun bool) error {
+	res, err := executeJsonCmd(http.MethodPost, "cafe/gc", params{
+		opts: map[string]string{"dry_run": strconv.FormatBool(dryRun)},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClusterList() error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/cluster", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClusterSync(peerID string) error {
+	res, err := executeJsonCmd(http.MethodPost, "cafe/cluster/"+peerID+"/sync", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
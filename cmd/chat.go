@@ -79,7 +79,7 @@ func Chat(threadID string) error {
 
 func handleLine(line string, threadID string) error {
 	if strings.TrimSpace(line) != "" {
-		if _, err := addMessage(threadID, line); err != nil {
+		if _, err := addMessage(threadID, line, "", ""); err != nil {
 			return err
 		}
 	}
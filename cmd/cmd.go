@@ -130,6 +130,7 @@ var (
 	apiAddr    = appCmd.Flag("api", "API Address to use").Envar("API").Default("http://127.0.0.1:40600").String()
 	apiVersion = appCmd.Flag("api-version", "API version to use").Envar("API_VERSION").Default("v0").String()
 	logDebug   = appCmd.Flag("debug", "Set the logging level to debug").Bool()
+	adminToken = appCmd.Flag("admin-token", "Cafe admin token, see Cafe.Host.AdminToken in the config").Envar("ADMIN_TOKEN").String()
 )
 
 func Run() error {
@@ -203,7 +204,7 @@ An access token is required to register, and should be obtained separately from
 	}
 
 	// cafe list
-	cafeListCmd := cafeCmd.Command("list", "List info about all active cafe sessions").Alias("ls").Default()
+	cafeListCmd := cafeCmd.Command("list", "List info about all active cafe sessions, including the last reported storage usage and quota").Alias("ls").Default()
 	cmds[cafeListCmd.FullCommand()] = CafeList
 
 	// cafe get
@@ -224,6 +225,72 @@ An access token is required to register, and should be obtained separately from
 	cafeMessagesCmd := cafeCmd.Command("messages", "Check for messages at all cafes. New messages are downloaded and processed opportunistically.")
 	cmds[cafeMessagesCmd.FullCommand()] = CafeMessages
 
+	// cafe clients
+	cafeClientsCmd := cafeCmd.Command("clients", "Commands to manage the peers registered with this cafe").Alias("client")
+
+	// cafe clients list
+	cafeClientsListCmd := cafeClientsCmd.Command("list", "Lists registered peers, most recently seen first, including their storage usage and quota").Alias("ls").Default()
+	cmds[cafeClientsListCmd.FullCommand()] = CafeClientList
+
+	// cafe clients get
+	cafeClientsGetCmd := cafeClientsCmd.Command("get", "Gets a registered peer, including its storage usage and quota")
+	cafeClientsGetClientID := cafeClientsGetCmd.Arg("client", "Client peer ID").Required().String()
+	cmds[cafeClientsGetCmd.FullCommand()] = func() error {
+		return CafeClientGet(*cafeClientsGetClientID)
+	}
+
+	// cafe clients threads
+	cafeClientsThreadsCmd := cafeClientsCmd.Command("threads", "Lists the encrypted thread backups stored by a registered peer")
+	cafeClientsThreadsClientID := cafeClientsThreadsCmd.Arg("client", "Client peer ID").Required().String()
+	cmds[cafeClientsThreadsCmd.FullCommand()] = func() error {
+		return CafeClientThreads(*cafeClientsThreadsClientID)
+	}
+
+	// cafe clients quota
+	cafeClientsQuotaCmd := cafeClientsCmd.Command("quota", "Overrides the default storage quota of a registered peer, omitted limits fall back to the default")
+	cafeClientsQuotaClientID := cafeClientsQuotaCmd.Arg("client", "Client peer ID").Required().String()
+	cafeClientsQuotaBytes := cafeClientsQuotaCmd.Flag("bytes", "Max bytes pinned").Int64()
+	cafeClientsQuotaObjects := cafeClientsQuotaCmd.Flag("objects", "Max objects pinned").Int32()
+	cafeClientsQuotaThreads := cafeClientsQuotaCmd.Flag("threads", "Max thread backups").Int32()
+	cmds[cafeClientsQuotaCmd.FullCommand()] = func() error {
+		return CafeClientQuota(*cafeClientsQuotaClientID, *cafeClientsQuotaBytes, *cafeClientsQuotaObjects, *cafeClientsQuotaThreads)
+	}
+
+	// cafe clients revoke
+	cafeClientsRevokeCmd := cafeClientsCmd.Command("revoke", `Removes a registered peer along with its pins, thread backups, and inbox messages.
+Sessions issued to the peer are no longer accepted. With --token, all peers registered with the token are revoked.`)
+	cafeClientsRevokeClientID := cafeClientsRevokeCmd.Arg("client", "Client peer ID").String()
+	cafeClientsRevokeToken := cafeClientsRevokeCmd.Flag("token", "Revoke all peers registered with this token instead").Short('t').String()
+	cmds[cafeClientsRevokeCmd.FullCommand()] = func() error {
+		return CafeClientRevoke(*cafeClientsRevokeClientID, *cafeClientsRevokeToken)
+	}
+
+	// cafe gc
+	cafeGCCmd := cafeCmd.Command("gc", `Applies the client retention policy (see Cafe.Host.GC in the config).
+Peers unseen for longer than the TTL are scheduled for deletion, which happens after the grace period unless
+they are seen again. Their pins, thread backups, and inbox messages are then deleted and the ipfs repo is
+garbage collected. The policy is applied hourly, use --dry-run to report what would happen now.`)
+	cafeGCDryRun := cafeGCCmd.Flag("dry-run", "Only report what would happen").Short('n').Bool()
+	cmds[cafeGCCmd.FullCommand()] = func() error {
+		return CafeGC(*cafeGCDryRun)
+	}
+
+	// cafe cluster
+	cafeClusterCmd := cafeCmd.Command("cluster", `Commands to manage the cafes that replicate client data with this cafe (see Cafe.Host.Cluster in the config).
+Pins, thread backups, and inbox messages of registered peers are replicated to each cluster peer, which accepts
+sessions issued by this cafe.`)
+
+	// cafe cluster list
+	cafeClusterListCmd := cafeClusterCmd.Command("list", "Lists cluster peers, including the number of replications pending for each").Alias("ls").Default()
+	cmds[cafeClusterListCmd.FullCommand()] = CafeClusterList
+
+	// cafe cluster sync
+	cafeClusterSyncCmd := cafeClusterCmd.Command("sync", "Queues everything stored by registered peers for replication to a cluster peer, e.g., when it joins the cluster")
+	cafeClusterSyncPeerID := cafeClusterSyncCmd.Arg("peer", "Cluster peer ID").Required().String()
+	cmds[cafeClusterSyncCmd.FullCommand()] = func() error {
+		return CafeClusterSync(*cafeClusterSyncPeerID)
+	}
+
 	// ================================
 
 	// chat
@@ -346,8 +413,42 @@ An access token is required to register, and should be obtained separately from
 
 	// ================================
 
+	// edit
+	editCmd := appCmd.Command("edit", `Edits are added as blocks in a thread, which replace the body of a message or the caption of a file(s). Only the author of a block can edit it.`).Alias("edits")
+
+	// edit add
+	editAddCmd := editCmd.Command("add", "Edit a message or file(s) caption")
+	editAddBlockID := editAddCmd.Arg("block", "Block ID of the message or file(s) to edit").Required().String()
+	editAddBody := editAddCmd.Arg("body", "The new body or caption").Required().String()
+	cmds[editAddCmd.FullCommand()] = func() error {
+		return EditAdd(*editAddBlockID, *editAddBody)
+	}
+
+	// edit list
+	editListCmd := editCmd.Command("list", "Get the edit history of a block, newest first").Alias("ls").Default()
+	editListBlockID := editListCmd.Arg("block", "Block ID of the message or file(s)").Required().String()
+	cmds[editListCmd.FullCommand()] = func() error {
+		return EditList(*editListBlockID)
+	}
+
+	// edit get
+	editGetCmd := editCmd.Command("get", "Get an edit by its own Block ID")
+	editGetEditID := editGetCmd.Arg("edit-block", "Edit Block ID").Required().String()
+	cmds[editGetCmd.FullCommand()] = func() error {
+		return EditGet(*editGetEditID)
+	}
+
+	// edit ignore
+	editIgnoreCmd := editCmd.Command("ignore", "Retract an edit by its own Block ID, restoring the previous revision").Alias("remove").Alias("rm")
+	editIgnoreEditID := editIgnoreCmd.Arg("edit-block", "Edit Block ID").Required().String()
+	cmds[editIgnoreCmd.FullCommand()] = func() error {
+		return EditIgnore(*editIgnoreEditID)
+	}
+
+	// ================================
+
 	// feed
-	feedCmd := appCmd.Command("feed", `Paginates post (join|leave|files|message) and annotation (comment|like) block types as a consumable feed.
+	feedCmd := appCmd.Command("feed", `Paginates post (join|leave|files|message) and annotation (comment|like|reply) block types as a consumable feed. Replies are messages that target another post.
 
 The --mode option dictates how the feed is displayed:
 
@@ -366,8 +467,9 @@ Stacks may include:
 	feedLimit := feedCmd.Flag("limit", "List page size").Short('l').Default("3").Int()
 	feedMode := feedCmd.Flag("mode", "Feed mode, one of: chrono, annotated, stacks").Short('m').Default("chrono").String()
 	// ^ when kingpin v2 lands with enumerables, we could move the usage docs to the enum docs
+	feedSort := feedCmd.Flag("sort", "Block order, one of: date, causal. Causal order is the same for all thread members").Short('s').Default("date").String()
 	cmds[feedCmd.FullCommand()] = func() error {
-		return Feed(*feedThreadID, *feedOffset, *feedLimit, *feedMode)
+		return Feed(*feedThreadID, *feedOffset, *feedLimit, *feedMode, *feedSort)
 	}
 
 	// ================================
@@ -398,10 +500,11 @@ Stacks may include:
 	fileAddThreadID := fileAddCmd.Arg("thread", "Thread ID").Required().String()
 	fileAddPath := fileAddCmd.Arg("path", "The path to the file or directory to add, can also be an existing hash. If omitted, you must provide a stdin blob input.").String()
 	fileAddCaption := fileAddCmd.Flag("caption", "File(s) caption").Short('c').String()
+	fileAddTTL := fileAddCmd.Flag("ttl", "Duration after which the file(s) expire and are purged, e.g., 24h").String()
 	fileAddGroup := fileAddCmd.Flag("group", "If provided, group a directory's files together into a single object, includes nested directories").Short('g').Bool()
 	fileAddVerbose := fileAddCmd.Flag("verbose", "Prints files as they are milled").Short('v').Bool()
 	cmds[fileAddCmd.FullCommand()] = func() error {
-		return FileAdd(*fileAddPath, *fileAddThreadID, *fileAddCaption, *fileAddGroup, *fileAddVerbose)
+		return FileAdd(*fileAddPath, *fileAddThreadID, *fileAddCaption, *fileAddTTL, *fileAddGroup, *fileAddVerbose)
 	}
 
 	// file ignore
@@ -606,9 +709,11 @@ There are two types of invites, direct account-to-account and external:
 	// message add
 	messageAddCmd := messageCmd.Command("add", "Adds a message to a thread")
 	messageAddThreadID := messageAddCmd.Arg("thread", "Thread ID").Required().String()
-	messageAddBody := messageAddCmd.Arg("body", "The message to add the thread").Required().String()
+	messageAddBody := messageAddCmd.Arg("body", "The message to add the thread, mention accounts with @address").Required().String()
+	messageAddParent := messageAddCmd.Flag("parent", "Block ID of a message or file(s) to reply to").Short('p').String()
+	messageAddTTL := messageAddCmd.Flag("ttl", "Duration after which the message expires and is purged, e.g., 24h").String()
 	cmds[messageAddCmd.FullCommand()] = func() error {
-		return MessageAdd(*messageAddThreadID, *messageAddBody)
+		return MessageAdd(*messageAddThreadID, *messageAddBody, *messageAddParent, *messageAddTTL)
 	}
 
 	// message list
@@ -616,8 +721,9 @@ There are two types of invites, direct account-to-account and external:
 	messageListThreadID := messageListCmd.Arg("thread", "Thread ID, omit to paginate all messages").String()
 	messageListOffset := messageListCmd.Flag("offset", "Offset ID to start the listing from").Short('o').String()
 	messageListLimit := messageListCmd.Flag("limit", "List page size").Default("10").Short('l').Int()
+	messageListSort := messageListCmd.Flag("sort", "Message order, one of: date, causal. Causal order is the same for all thread members").Short('s').Default("date").String()
 	cmds[messageListCmd.FullCommand()] = func() error {
-		return MessageList(*messageListThreadID, *messageListOffset, *messageListLimit)
+		return MessageList(*messageListThreadID, *messageListOffset, *messageListLimit, *messageListSort)
 	}
 
 	// message get
@@ -719,6 +825,33 @@ There are two types of invites, direct account-to-account and external:
 
 	// ================================
 
+	// reaction
+	reactionCmd := appCmd.Command("reaction", `Reactions are added as blocks in a thread, which target another block with a single emoji or short code. Reacting again with the same emoji, from any peer of the same account, removes it.`).Alias("reactions")
+
+	// reaction add
+	reactionAddCmd := reactionCmd.Command("add", "Toggle a reaction on a block")
+	reactionAddBlockID := reactionAddCmd.Arg("block", "Block ID to react to, usually a message or file's block").Required().String()
+	reactionAddEmoji := reactionAddCmd.Arg("emoji", "A single emoji or short code, e.g. '🎉' or ':tada:'").Required().String()
+	cmds[reactionAddCmd.FullCommand()] = func() error {
+		return ReactionAdd(*reactionAddBlockID, *reactionAddEmoji)
+	}
+
+	// reaction list
+	reactionListCmd := reactionCmd.Command("list", "Get the reactions on a block, grouped by emoji").Alias("ls").Default()
+	reactionListBlockID := reactionListCmd.Arg("block", "Block ID of the reacted block").Required().String()
+	cmds[reactionListCmd.FullCommand()] = func() error {
+		return ReactionList(*reactionListBlockID)
+	}
+
+	// reaction get
+	reactionGetCmd := reactionCmd.Command("get", "Get a reaction by its own Block ID")
+	reactionGetReactionID := reactionGetCmd.Arg("reaction-block", "Reaction Block ID").Required().String()
+	cmds[reactionGetCmd.FullCommand()] = func() error {
+		return ReactionGet(*reactionGetReactionID)
+	}
+
+	// ================================
+
 	// summary
 	summaryCmd := appCmd.Command("summary", "Get a summary of the local node's data")
 	cmds[summaryCmd.FullCommand()] = func() error {
@@ -762,8 +895,11 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 	threadAddBlob := threadAddCmd.Flag("blob", "Use the built-in blob schema for generic data").Bool()
 	threadAddCameraRoll := threadAddCmd.Flag("camera-roll", "Use the built-in camera roll schema").Bool()
 	threadAddMedia := threadAddCmd.Flag("media", "Use the built-in media schema").Bool()
+	threadAddVideo := threadAddCmd.Flag("video", "Use the built-in video schema").Bool()
+	threadAddAudio := threadAddCmd.Flag("audio", "Use the built-in audio schema for music and podcasts").Bool()
+	threadAddDocument := threadAddCmd.Flag("document", "Use the built-in document schema for PDFs and text").Bool()
 	cmds[threadAddCmd.FullCommand()] = func() error {
-		return ThreadAdd(*threadAddName, *threadAddKey, *threadAddType, *threadAddSharing, *threadAddWhitelist, *threadAddSchema, *threadAddSchemaFile, *threadAddBlob, *threadAddCameraRoll, *threadAddMedia)
+		return ThreadAdd(*threadAddName, *threadAddKey, *threadAddType, *threadAddSharing, *threadAddWhitelist, *threadAddSchema, *threadAddSchemaFile, *threadAddBlob, *threadAddCameraRoll, *threadAddMedia, *threadAddVideo, *threadAddAudio, *threadAddDocument)
 	}
 
 	// thread list
@@ -779,6 +915,13 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 		return ThreadGet(*threadGetThreadID)
 	}
 
+	// thread import
+	threadImportCmd := threadCmd.Command("import", "Adds or updates a thread from an archive, indexing its blocks and files without network access")
+	threadImportFile := threadImportCmd.Arg("file", "Archive filename, e.g., backup"+core.ThreadArchiveExt).Required().String()
+	cmds[threadImportCmd.FullCommand()] = func() error {
+		return ThreadImport(*threadImportFile)
+	}
+
 	// thread peer
 	threadPeerCmd := threadCmd.Command("peer", "Lists all peers in a thread").Alias("peers")
 	threadPeerThreadID := threadPeerCmd.Arg("thread", "Thread ID").Required().String()
@@ -801,6 +944,134 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 		return ThreadAbandon(*threadAbandonThreadID)
 	}
 
+	// thread member
+	threadMemberCmd := threadCmd.Command("member", "Manage thread membership and roles. Only admins can change membership.").Alias("members")
+
+	// thread member list
+	threadMemberListCmd := threadMemberCmd.Command("list", "Lists addresses whose membership or role was changed").Alias("ls").Default()
+	threadMemberListThreadID := threadMemberListCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadMemberListCmd.FullCommand()] = func() error {
+		return ThreadMemberList(*threadMemberListThreadID)
+	}
+
+	// thread member add
+	threadMemberAddCmd := threadMemberCmd.Command("add", "Adds an account address to a thread")
+	threadMemberAddThreadID := threadMemberAddCmd.Arg("thread", "Thread ID").Required().String()
+	threadMemberAddAddress := threadMemberAddCmd.Arg("address", "Account address").Required().String()
+	threadMemberAddRole := threadMemberAddCmd.Flag("role", "Set the member role to one of 'reader', 'annotator', 'writer', or 'admin'. Defaults to the thread type's access").Short('r').String()
+	cmds[threadMemberAddCmd.FullCommand()] = func() error {
+		return ThreadMemberAdd(*threadMemberAddThreadID, *threadMemberAddAddress, *threadMemberAddRole)
+	}
+
+	// thread member remove
+	threadMemberRemoveCmd := threadMemberCmd.Command("remove", "Removes an account address from a thread").Alias("rm")
+	threadMemberRemoveThreadID := threadMemberRemoveCmd.Arg("thread", "Thread ID").Required().String()
+	threadMemberRemoveAddress := threadMemberRemoveCmd.Arg("address", "Account address").Required().String()
+	cmds[threadMemberRemoveCmd.FullCommand()] = func() error {
+		return ThreadMemberRemove(*threadMemberRemoveThreadID, *threadMemberRemoveAddress)
+	}
+
+	// thread member grant
+	threadMemberGrantCmd := threadMemberCmd.Command("grant", "Grants a role to a thread member")
+	threadMemberGrantThreadID := threadMemberGrantCmd.Arg("thread", "Thread ID").Required().String()
+	threadMemberGrantAddress := threadMemberGrantCmd.Arg("address", "Account address").Required().String()
+	threadMemberGrantRole := threadMemberGrantCmd.Arg("role", "One of 'reader', 'annotator', 'writer', or 'admin'").Required().String()
+	cmds[threadMemberGrantCmd.FullCommand()] = func() error {
+		return ThreadMemberGrant(*threadMemberGrantThreadID, *threadMemberGrantAddress, *threadMemberGrantRole)
+	}
+
+	// thread member revoke
+	threadMemberRevokeCmd := threadMemberCmd.Command("revoke", "Revokes a member's role, returning them to the thread type's access")
+	threadMemberRevokeThreadID := threadMemberRevokeCmd.Arg("thread", "Thread ID").Required().String()
+	threadMemberRevokeAddress := threadMemberRevokeCmd.Arg("address", "Account address").Required().String()
+	cmds[threadMemberRevokeCmd.FullCommand()] = func() error {
+		return ThreadMemberRevoke(*threadMemberRevokeThreadID, *threadMemberRevokeAddress)
+	}
+
+	// thread export
+	threadExportCmd := threadCmd.Command("export", "Exports a thread archive, which includes the thread DAG, the files and schemas it references, and optionally the thread key")
+	threadExportThreadID := threadExportCmd.Arg("thread", "Thread ID").Required().String()
+	threadExportOut := threadExportCmd.Flag("out", "Archive filename, e.g., backup"+core.ThreadArchiveExt+", omit to write to stdout").Short('o').String()
+	threadExportKey := threadExportCmd.Flag("key", "Include the thread key so the archive can be imported into any repo").Short('k').Bool()
+	cmds[threadExportCmd.FullCommand()] = func() error {
+		return ThreadExport(*threadExportThreadID, *threadExportOut, *threadExportKey)
+	}
+
+	// thread fork
+	threadForkCmd := threadCmd.Command("fork", "Adds and joins a new thread with a new key, re-publishing the messages and files of an existing thread. Type, sharing, and whitelist default to those of the existing thread.")
+	threadForkThreadID := threadForkCmd.Arg("thread", "Thread ID").Required().String()
+	threadForkName := threadForkCmd.Flag("name", "The name to use for the new thread, defaults to the existing name").Short('n').String()
+	threadForkKey := threadForkCmd.Flag("key", "A locally unique key used by an app to identify the new thread on recovery").Short('k').String()
+	threadForkType := threadForkCmd.Flag("type", "Set the thread type to one of: private, read_only, public, open").Short('t').String()
+	threadForkSharing := threadForkCmd.Flag("sharing", "Set the thread sharing style to one of: not_shared, invite_only, shared").Short('s').String()
+	threadForkWhitelist := threadForkCmd.Flag("whitelist", "A contact address. When supplied, the new thread will not allow additional peers. Can be used multiple times to include multiple contacts").Short('w').Strings()
+	threadForkFrom := threadForkCmd.Flag("from", "ID of the oldest block to include").String()
+	threadForkTo := threadForkCmd.Flag("to", "ID of the newest block to include").String()
+	cmds[threadForkCmd.FullCommand()] = func() error {
+		return ThreadFork(*threadForkThreadID, *threadForkName, *threadForkKey, *threadForkType, *threadForkSharing, *threadForkWhitelist, *threadForkFrom, *threadForkTo)
+	}
+
+	// thread rekey
+	threadRekeyCmd := threadCmd.Command("rekey", "Rotates a thread key so accounts that are no longer members can't read new blocks. Only admins can re-key.")
+	threadRekeyThreadID := threadRekeyCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadRekeyCmd.FullCommand()] = func() error {
+		return ThreadRekey(*threadRekeyThreadID)
+	}
+
+	// thread retention
+	threadRetentionCmd := threadCmd.Command("retention", "Manage the retention policy of a thread. Messages, files, and their annotations outside of the policy are purged by all members.")
+
+	// thread retention get
+	threadRetentionGetCmd := threadRetentionCmd.Command("get", "Gets a thread retention policy").Default()
+	threadRetentionGetThreadID := threadRetentionGetCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadRetentionGetCmd.FullCommand()] = func() error {
+		return ThreadRetentionGet(*threadRetentionGetThreadID)
+	}
+
+	// thread retention set
+	threadRetentionSetCmd := threadRetentionCmd.Command("set", "Shares a new thread retention policy (admins only), omit both limits to keep everything")
+	threadRetentionSetThreadID := threadRetentionSetCmd.Arg("thread", "Thread ID").Required().String()
+	threadRetentionSetMaxAge := threadRetentionSetCmd.Flag("max-age", "Max age of posts, e.g., 720h").String()
+	threadRetentionSetMaxBlocks := threadRetentionSetCmd.Flag("max-blocks", "Max number of posts").String()
+	cmds[threadRetentionSetCmd.FullCommand()] = func() error {
+		return ThreadRetentionSet(*threadRetentionSetThreadID, *threadRetentionSetMaxAge, *threadRetentionSetMaxBlocks)
+	}
+
+	// thread verify
+	threadVerifyCmd := threadCmd.Command("verify", "Walks a thread's DAG from its heads, reporting missing nodes, undecryptable blocks, and missing, pending, orphaned, or mismatched indexes")
+	threadVerifyThreadID := threadVerifyCmd.Arg("thread", "Thread ID").Required().String()
+	threadVerifyRepair := threadVerifyCmd.Flag("repair", "Re-fetch missing nodes from peers and cafes, rebuild bad indexes, and remove orphaned indexes").Short('r').Bool()
+	cmds[threadVerifyCmd.FullCommand()] = func() error {
+		return ThreadVerify(*threadVerifyThreadID, *threadVerifyRepair)
+	}
+
+	// thread schema
+	threadSchemaCmd := threadCmd.Command("schema", "Manage thread schema versions").Alias("schemas")
+
+	// thread schema list
+	threadSchemaListCmd := threadSchemaCmd.Command("list", "Lists the schema versions recorded for a thread").Alias("ls").Default()
+	threadSchemaListThreadID := threadSchemaListCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadSchemaListCmd.FullCommand()] = func() error {
+		return ThreadSchemaList(*threadSchemaListThreadID)
+	}
+
+	// thread schema update
+	threadSchemaUpdateCmd := threadSchemaCmd.Command("update", "Updates a thread to a new schema version, shared with members (admins only). Updates that remove or change existing links require --force.")
+	threadSchemaUpdateThreadID := threadSchemaUpdateCmd.Arg("thread", "Thread ID").Required().String()
+	threadSchemaUpdateSchema := threadSchemaUpdateCmd.Flag("schema", "Thread schema ID. Supersedes schema filename").String()
+	threadSchemaUpdateSchemaFile := threadSchemaUpdateCmd.Flag("schema-file", "Thread schema filename").String()
+	threadSchemaUpdateForce := threadSchemaUpdateCmd.Flag("force", "Apply the schema even if it is not compatible").Short('f').Bool()
+	cmds[threadSchemaUpdateCmd.FullCommand()] = func() error {
+		return ThreadSchemaUpdate(*threadSchemaUpdateThreadID, *threadSchemaUpdateSchema, *threadSchemaUpdateSchemaFile, *threadSchemaUpdateForce)
+	}
+
+	// thread schema backfill
+	threadSchemaBackfillCmd := threadSchemaCmd.Command("backfill", "Re-mills your existing files into links added by newer schema versions")
+	threadSchemaBackfillThreadID := threadSchemaBackfillCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadSchemaBackfillCmd.FullCommand()] = func() error {
+		return ThreadSchemaBackfill(*threadSchemaBackfillThreadID)
+	}
+
 	// thread snapshot
 	// A snapshot is an encrypted object containing thread metadata and the latest block hash, which is enough to recover the thread.
 	threadSnapshotCmd := threadCmd.Command("snapshot", "Manage thread snapshots").Alias("snapshots")
@@ -845,8 +1116,15 @@ The response contains a base58 encoded version of the random bytes token.`).Alia
 	tokenCreateNoStore := tokenCreateCmd.Flag("no-store", "If used instead of token, the token is generated but not stored in the local cafe database").Short('n').Bool()
 	// @todo at some point remove --no-store, if no one is using it
 	tokenCreateToken := tokenCreateCmd.Flag("token", "If used instead of no-store, use this existing token rather than creating a new one").Short('t').String()
+	tokenCreateQuotaBytes := tokenCreateCmd.Flag("quota-bytes", "Max bytes pinned by all peers registered with the token, omit for no limit").Int64()
+	tokenCreateQuotaObjects := tokenCreateCmd.Flag("quota-objects", "Max objects pinned by all peers registered with the token, omit for no limit").Int32()
+	tokenCreateQuotaThreads := tokenCreateCmd.Flag("quota-threads", "Max thread backups by all peers registered with the token, omit for no limit").Int32()
+	tokenCreateExpires := tokenCreateCmd.Flag("expires", "Duration after which registrations and sessions are no longer accepted, e.g., 720h, omit for never").String()
+	tokenCreateMaxRegistrations := tokenCreateCmd.Flag("max-registrations", "Max number of peers that can register with the token, omit for no limit").Int()
+	tokenCreateScopes := tokenCreateCmd.Flag("scope", "Limit sessions to a cafe service, repeat for more than one, omit for all").Enums("store", "inbox", "threads", "search")
 	cmds[tokenCreateCmd.FullCommand()] = func() error {
-		return TokenCreate(*tokenCreateToken, *tokenCreateNoStore)
+		return TokenCreate(*tokenCreateToken, *tokenCreateNoStore, *tokenCreateQuotaBytes, *tokenCreateQuotaObjects, *tokenCreateQuotaThreads,
+			*tokenCreateExpires, *tokenCreateMaxRegistrations, *tokenCreateScopes)
 	}
 
 	// token list
@@ -1048,6 +1326,10 @@ func request(meth method, pth string, pars params) (*http.Response, func(), erro
 		req.Header.Set("Content-Type", pars.ctype)
 	}
 
+	if *adminToken != "" {
+		req.Header.Set("Authorization", "Bearer "+*adminToken)
+	}
+
 	tr := &http.Transport{}
 	client := &http.Client{Transport: tr}
 	res, err := client.Do(req)
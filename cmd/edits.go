@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"net/http"
+)
+
+func EditAdd(blockID string, body string) error {
+	res, err := executeJsonCmd(http.MethodPost, "blocks/"+blockID+"/edits", params{args: []string{body}}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func EditList(blockID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "blocks/"+blockID+"/edits", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func EditGet(editID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "blocks/"+editID+"/edit", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func EditIgnore(editID string) error {
+	return BlockIgnore(editID)
+}
@@ -7,13 +7,14 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func Feed(threadID string, offset string, limit int, mode string) error {
+func Feed(threadID string, offset string, limit int, mode string, sort string) error {
 	var list pb.FeedItemList
 	opts := map[string]string{
 		"thread": threadID,
 		"offset": offset,
 		"limit":  strconv.Itoa(limit),
 		"mode":   mode,
+		"sort":   sort,
 	}
 	res, err := executeJsonPbCmd(http.MethodGet, "feed", params{opts: opts}, &list)
 	if err != nil {
@@ -31,5 +32,5 @@ func Feed(threadID string, offset string, limit int, mode string) error {
 		return err
 	}
 
-	return Feed(threadID, list.Next, limit, mode)
+	return Feed(threadID, list.Next, limit, mode, sort)
 }
@@ -47,10 +47,14 @@ func (m millOpts) setUse(v string) {
 	m.val["use"] = v
 }
 
+func (m millOpts) setThread(v string) {
+	m.val["thread"] = v
+}
+
 // ------------------------------------
 // > file add
 
-func FileAdd(path string, threadID string, caption string, group bool, verbose bool) error {
+func FileAdd(path string, threadID string, caption string, ttl string, group bool, verbose bool) error {
 	var pth string
 	fi, err := os.Stdin.Stat()
 	if err != nil {
@@ -118,7 +122,7 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 		for i, batch := range batches {
 
 			ready := make(chan *pb.Directory, batchSize)
-			go millBatch(batch, thrd.SchemaNode, ready, verbose)
+			go millBatch(batch, &thrd, ready, verbose)
 
 			var cerr error
 		loop:
@@ -134,6 +138,7 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 							[]*pb.Directory{dir},
 							threadID,
 							strings.TrimSpace(fmt.Sprintf("%s (%d)", caption, count+1)),
+							ttl,
 							verbose)
 						if err != nil {
 							cerr = err
@@ -156,7 +161,7 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 		}
 
 		if group && len(dirs) > 0 {
-			files, err := add(dirs, threadID, caption, verbose)
+			files, err := add(dirs, threadID, caption, ttl, verbose)
 			if err != nil {
 				return err
 			}
@@ -169,12 +174,12 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 
 	} else {
 		// add the file
-		dir, err := mill(pth, thrd.SchemaNode, verbose)
+		dir, err := mill(pth, &thrd, verbose)
 		if err != nil {
 			return err
 		}
 
-		_, err = add([]*pb.Directory{dir}, threadID, caption, true)
+		_, err = add([]*pb.Directory{dir}, threadID, caption, ttl, true)
 		if err != nil {
 			return err
 		}
@@ -194,7 +199,7 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 	return nil
 }
 
-func add(dirs []*pb.Directory, threadID string, caption string, verbose bool) (*pb.Files, error) {
+func add(dirs []*pb.Directory, threadID string, caption string, ttl string, verbose bool) (*pb.Files, error) {
 	data, err := pbMarshaler.MarshalToString(&pb.DirectoryList{Items: dirs})
 	if err != nil {
 		return nil, err
@@ -202,7 +207,7 @@ func add(dirs []*pb.Directory, threadID string, caption string, verbose bool) (*
 
 	files := new(pb.Files)
 	res, err := executeJsonPbCmd(http.MethodPost, "threads/"+threadID+"/files", params{
-		opts:    map[string]string{"caption": caption},
+		opts:    map[string]string{"caption": caption, "ttl": ttl},
 		payload: strings.NewReader(data),
 		ctype:   "application/json",
 	}, files)
@@ -217,7 +222,8 @@ func add(dirs []*pb.Directory, threadID string, caption string, verbose bool) (*
 	return files, nil
 }
 
-func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
+func mill(pth string, thrd *pb.Thread, verbose bool) (*pb.Directory, error) {
+	node := thrd.SchemaNode
 	ref, err := ipfspath.ParsePath(pth)
 	if err == nil {
 		pth = ref.String()
@@ -258,6 +264,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 		mopts := newMillOpts(node.Opts)
 		mopts.setPlaintext(node.Plaintext)
+		mopts.setThread(thrd.Id)
 
 		if node.Mill == "/json" {
 			reader = f
@@ -300,6 +307,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 			mopts := newMillOpts(step.Link.Opts)
 			mopts.setPlaintext(step.Link.Plaintext)
+			mopts.setThread(thrd.Id)
 
 			if step.Link.Use == schema.FileTag {
 				if reader != nil {
@@ -322,11 +330,17 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 				res, file, err = handleStep(step.Link.Mill, reader, mopts, ctype)
 				if err != nil {
+					if step.Link.Optional {
+						continue
+					}
 					return nil, err
 				}
 
 			} else {
-				if dir.Files[step.Link.Use].Hash == "" {
+				if dir.Files[step.Link.Use] == nil || dir.Files[step.Link.Use].Hash == "" {
+					if step.Link.Optional {
+						continue
+					}
 					return nil, fmt.Errorf(step.Link.Use + " not found")
 				}
 				mopts.setUse(dir.Files[step.Link.Use].Hash)
@@ -335,6 +349,9 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 					opts: mopts.val,
 				}, file)
 				if err != nil {
+					if step.Link.Optional {
+						continue
+					}
 					return nil, err
 				}
 			}
@@ -352,14 +369,14 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 	return dir, nil
 }
 
-func millBatch(pths []string, node *pb.Node, ready chan *pb.Directory, verbose bool) {
+func millBatch(pths []string, thrd *pb.Thread, ready chan *pb.Directory, verbose bool) {
 	wg := sync.WaitGroup{}
 
 	for _, pth := range pths {
 		wg.Add(1)
 
 		go func(p string) {
-			dir, err := mill(p, node, verbose)
+			dir, err := mill(p, thrd, verbose)
 			if err != nil {
 				output("mill error: " + err.Error())
 			} else {
@@ -7,8 +7,8 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func MessageAdd(threadID string, body string) error {
-	res, err := addMessage(threadID, body)
+func MessageAdd(threadID string, body string, parent string, ttl string) error {
+	res, err := addMessage(threadID, body, parent, ttl)
 	if err != nil {
 		return err
 	}
@@ -16,9 +16,10 @@ func MessageAdd(threadID string, body string) error {
 	return nil
 }
 
-func addMessage(threadID string, body string) (string, error) {
+func addMessage(threadID string, body string, parent string, ttl string) (string, error) {
 	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/messages", params{
 		args: []string{body},
+		opts: map[string]string{"parent": parent, "ttl": ttl},
 	}, nil)
 
 	if err != nil {
@@ -28,12 +29,13 @@ func addMessage(threadID string, body string) (string, error) {
 	return res, nil
 }
 
-func MessageList(threadID string, offset string, limit int) error {
+func MessageList(threadID string, offset string, limit int, sort string) error {
 	var list pb.TextList
 	opts := map[string]string{
 		"thread": threadID,
 		"offset": offset,
 		"limit":  strconv.Itoa(limit),
+		"sort":   sort,
 	}
 	res, err := executeJsonPbCmd(http.MethodGet, "messages", params{opts: opts}, &list)
 	if err != nil {
@@ -51,7 +53,7 @@ func MessageList(threadID string, offset string, limit int) error {
 		return err
 	}
 
-	return MessageList(threadID, list.Items[len(list.Items)-1].Block, limit)
+	return MessageList(threadID, list.Items[len(list.Items)-1].Block, limit, sort)
 }
 
 func MessageGet(blockID string) error {
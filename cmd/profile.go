@@ -48,7 +48,7 @@ func ProfileSet(name string, avatar string) error {
 			return err
 		}
 
-		if err := FileAdd(avatar, id.Pretty(), "avatar", false, false); err != nil {
+		if err := FileAdd(avatar, id.Pretty(), "avatar", "", false, false); err != nil {
 			return err
 		}
 
@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -10,11 +13,13 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/mitchellh/go-homedir"
+	"github.com/textileio/go-textile/core"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/schema/textile"
+	"github.com/textileio/go-textile/util"
 )
 
-func ThreadAdd(name string, key string, tipe string, sharing string, whitelist []string, schema string, schemaFile string, blob bool, cameraRoll bool, media bool) error {
+func ThreadAdd(name string, key string, tipe string, sharing string, whitelist []string, schema string, schemaFile string, blob bool, cameraRoll bool, media bool, video bool, audio bool, document bool) error {
 	var body []byte
 	if schema == "" {
 		if schemaFile != "" {
@@ -39,6 +44,12 @@ func ThreadAdd(name string, key string, tipe string, sharing string, whitelist [
 			body = []byte(textile.CameraRoll)
 		} else if media {
 			body = []byte(textile.Media)
+		} else if video {
+			body = []byte(textile.Video)
+		} else if audio {
+			body = []byte(textile.Audio)
+		} else if document {
+			body = []byte(textile.Document)
 		}
 	}
 
@@ -115,6 +126,248 @@ func ThreadAbandon(threadID string) error {
 	return nil
 }
 
+func ThreadMemberList(threadID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "threads/"+threadID+"/members", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadMemberAdd(threadID string, address string, role string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/members", params{
+		args: []string{address},
+		opts: map[string]string{"role": role},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadMemberRemove(threadID string, address string) error {
+	res, err := executeJsonCmd(http.MethodDelete, "threads/"+threadID+"/members/"+address, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadMemberGrant(threadID string, address string, role string) error {
+	res, err := executeJsonCmd(http.MethodPut, "threads/"+threadID+"/members/"+address+"/role", params{
+		args: []string{role},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadMemberRevoke(threadID string, address string) error {
+	res, err := executeJsonCmd(http.MethodDelete, "threads/"+threadID+"/members/"+address+"/role", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadExport(threadID string, out string, key bool) error {
+	pars := params{
+		opts: map[string]string{
+			"key": strconv.FormatBool(key),
+		},
+	}
+	if out == "" {
+		return executeBlobCmd(http.MethodGet, "threads/"+threadID+"/archive", pars)
+	}
+
+	path, err := homedir.Expand(out)
+	if err != nil {
+		return err
+	}
+	res, _, err := request(http.MethodGet, "threads/"+threadID+"/archive", pars)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, err := util.UnmarshalString(res.Body)
+		if err != nil {
+			return err
+		}
+		return errors.New(body)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, res.Body)
+	if err != nil {
+		return err
+	}
+	output("wrote " + path)
+	return nil
+}
+
+func ThreadImport(archive string) error {
+	path, err := homedir.Expand(archive)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	header, err := core.ThreadArchiveHeader(file)
+	if err != nil {
+		return err
+	}
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	res, err := executeJsonCmd(http.MethodPut, "threads/"+header.Thread.Id+"/archive", params{
+		payload: file,
+		ctype:   "application/octet-stream",
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadFork(threadID string, name string, key string, tipe string, sharing string, whitelist []string, from string, to string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/fork", params{
+		opts: map[string]string{
+			"name":      name,
+			"key":       key,
+			"type":      tipe,
+			"sharing":   sharing,
+			"whitelist": strings.Join(whitelist, ","),
+			"from":      from,
+			"to":        to,
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRekey(threadID string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/key", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRetentionGet(threadID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "threads/"+threadID+"/retention", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRetentionSet(threadID string, maxAge string, maxBlocks string) error {
+	res, err := executeJsonCmd(http.MethodPut, "threads/"+threadID+"/retention", params{
+		opts: map[string]string{
+			"max_age":    maxAge,
+			"max_blocks": maxBlocks,
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadVerify(threadID string, repair bool) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/verify", params{
+		opts: map[string]string{
+			"repair": strconv.FormatBool(repair),
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadSchemaList(threadID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "threads/"+threadID+"/schemas", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadSchemaUpdate(threadID string, schema string, schemaFile string, force bool) error {
+	if schema == "" {
+		if schemaFile == "" {
+			return fmt.Errorf("schema or schema-file is required")
+		}
+		path, err := homedir.Expand(schemaFile)
+		if err != nil {
+			return err
+		}
+		body, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var schemaf pb.FileIndex
+		if _, err := executeJsonPbCmd(http.MethodPost, "mills/schema", params{
+			payload: bytes.NewReader(body),
+			ctype:   "application/json",
+		}, &schemaf); err != nil {
+			return err
+		}
+		schema = schemaf.Hash
+	}
+
+	res, err := executeJsonCmd(http.MethodPut, "threads/"+threadID+"/schema", params{
+		args: []string{schema},
+		opts: map[string]string{
+			"force": strconv.FormatBool(force),
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadSchemaBackfill(threadID string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/schema/backfill", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func ThreadSnapshotCreate() error {
 	res, err := createThreadSnapshot()
 	if err != nil {
@@ -3,12 +3,20 @@ package cmd
 import (
 	"net/http"
 	"strconv"
+	"strings"
 )
 
-func TokenCreate(token string, noStore bool) error {
+func TokenCreate(token string, noStore bool, quotaBytes int64, quotaObjects int32, quotaThreads int32,
+	expires string, maxRegistrations int, scopes []string) error {
 	opts := map[string]string{
-		"token": token,
-		"store": strconv.FormatBool(!noStore),
+		"token":             token,
+		"store":             strconv.FormatBool(!noStore),
+		"quota_bytes":       strconv.FormatInt(quotaBytes, 10),
+		"quota_objects":     strconv.FormatInt(int64(quotaObjects), 10),
+		"quota_threads":     strconv.FormatInt(int64(quotaThreads), 10),
+		"expires":           expires,
+		"max_registrations": strconv.Itoa(maxRegistrations),
+		"scopes":            strings.Join(scopes, ","),
 	}
 
 	res, err := executeStringCmd(http.MethodPost, "tokens", params{opts: opts})
@@ -3,9 +3,9 @@ package core
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -25,6 +25,7 @@ import (
 	ipfsutil "github.com/textileio/go-textile/ipfs"
 	m "github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
 )
 
 // apiVersion is the api version
@@ -156,7 +157,19 @@ func (a *api) Start() {
 			mills.POST("/blob", a.blobMill)
 			mills.POST("/image/resize", a.imageResizeMill)
 			mills.POST("/image/exif", a.imageExifMill)
+			mills.POST("/image/phash", a.imagePhashMill)
+			mills.POST("/video/poster", a.videoPosterMill)
+			mills.POST("/video/probe", a.videoProbeMill)
+			mills.POST("/audio/meta", a.audioMetaMill)
+			mills.POST("/audio/cover", a.audioCoverMill)
+			mills.POST("/doc/meta", a.docMetaMill)
+			mills.POST("/doc/text", a.docTextMill)
 			mills.POST("/json", a.jsonMill)
+
+			// external mill ids are validated against the built in routes on start
+			for id := range a.node.mills {
+				mills.POST(id, a.externalMill(id))
+			}
 		}
 
 		threads := v0.Group("/threads")
@@ -167,6 +180,21 @@ func (a *api) Start() {
 			threads.GET("", a.lsThreads)
 			threads.GET("/:id", a.getThreads)
 			threads.GET("/:id/peers", a.peersThreads)
+			threads.GET("/:id/schemas", a.schemasThreads)
+			threads.PUT("/:id/schema", a.updateSchemaThreads)
+			threads.POST("/:id/schema/backfill", a.backfillSchemaThreads)
+			threads.GET("/:id/members", a.membersThreads)
+			threads.POST("/:id/members", a.addMemberThreads)
+			threads.DELETE("/:id/members/:address", a.rmMemberThreads)
+			threads.PUT("/:id/members/:address/role", a.grantMemberThreads)
+			threads.DELETE("/:id/members/:address/role", a.revokeMemberThreads)
+			threads.POST("/:id/key", a.rekeyThreads)
+			threads.POST("/:id/fork", a.forkThreads)
+			threads.POST("/:id/verify", a.verifyThreads)
+			threads.GET("/:id/archive", a.exportThreads)
+			threads.PUT("/:id/archive", a.importThreads)
+			threads.GET("/:id/retention", a.retentionThreads)
+			threads.PUT("/:id/retention", a.setRetentionThreads)
 			threads.DELETE("/:id", a.rmThreads)
 			threads.POST("/:id/messages", a.addThreadMessages)
 			threads.POST("/:id/files", a.addThreadFiles)
@@ -214,6 +242,20 @@ func (a *api) Start() {
 					likes.POST("", a.addBlockLikes)
 					likes.GET("", a.lsBlockLikes)
 				}
+
+				block.GET("/edit", a.getBlockEdit)
+				edits := block.Group("/edits")
+				{
+					edits.POST("", a.addBlockEdits)
+					edits.GET("", a.lsBlockEdits)
+				}
+
+				block.GET("/reaction", a.getBlockReaction)
+				reactions := block.Group("/reactions")
+				{
+					reactions.POST("", a.addBlockReactions)
+					reactions.GET("", a.lsBlockReactions)
+				}
 			}
 		}
 
@@ -226,9 +268,7 @@ func (a *api) Start() {
 		files := v0.Group("/files")
 		{
 			files.GET("", a.lsThreadFiles)
-			files.GET("/:block", func(g *gin.Context) {
-				g.Redirect(http.StatusPermanentRedirect, "/api/v0/blocks/"+g.Param("block")+"/files")
-			})
+			files.GET("/similar", a.lsSimilarFiles)
 		}
 
 		file := v0.Group("/file")
@@ -301,6 +341,26 @@ func (a *api) Start() {
 			tokens.GET("", a.lsTokens)
 			tokens.GET("/:token", a.validateTokens)
 			tokens.DELETE("/:token", a.rmTokens)
+			tokens.POST("/:token/revoke", a.cafeAdmin, a.revokeTokens)
+		}
+
+		cafe := v0.Group("/cafe", a.cafeAdmin)
+		{
+			clients := cafe.Group("/clients")
+			{
+				clients.GET("", a.lsCafeClients)
+				clients.GET("/:id", a.getCafeClients)
+				clients.GET("/:id/threads", a.lsCafeClientThreads)
+				clients.PUT("/:id/quota", a.setCafeClientQuota)
+				clients.POST("/:id/revoke", a.revokeCafeClients)
+			}
+			cafe.POST("/gc", a.cafeGC)
+
+			cluster := cafe.Group("/cluster")
+			{
+				cluster.GET("", a.lsCafeCluster)
+				cluster.POST("/:peer/sync", a.syncCafeClusterPeer)
+			}
 		}
 
 		ipfs := v0.Group("/ipfs")
@@ -332,6 +392,15 @@ func (a *api) Start() {
 		}
 	}
 
+	// the legacy block files path can't share a route with /files/similar
+	router.NoRoute(func(g *gin.Context) {
+		pth := g.Request.URL.Path
+		block := strings.TrimPrefix(pth, "/api/v0/files/")
+		if g.Request.Method == http.MethodGet && block != pth && block != "" && !strings.Contains(block, "/") {
+			g.Redirect(http.StatusPermanentRedirect, "/api/v0/blocks/"+block+"/files")
+		}
+	})
+
 	a.server = &http.Server{
 		Addr:    a.addr,
 		Handler: router,
@@ -381,6 +450,25 @@ func (a *api) nodeSummary(g *gin.Context) {
 	pbJSON(g, http.StatusOK, a.node.Summary())
 }
 
+// cafeAdmin is middleware that requires the cafe admin token as a bearer token
+func (a *api) cafeAdmin(g *gin.Context) {
+	token := a.node.Config().Cafe.Host.AdminToken
+	if token == "" {
+		sendError(g, fmt.Errorf("cafe admin token not configured"), http.StatusForbidden)
+		g.Abort()
+		return
+	}
+
+	auth := strings.Split(g.Request.Header.Get("Authorization"), " ")
+	if len(auth) < 2 || auth[0] != "Bearer" ||
+		subtle.ConstantTimeCompare([]byte(auth[1]), []byte(token)) != 1 {
+		sendError(g, fmt.Errorf("invalid credentials"), http.StatusUnauthorized)
+		g.Abort()
+		return
+	}
+	g.Next()
+}
+
 func (a *api) abort500(g *gin.Context, err error) {
 	sendError(g, err, http.StatusInternalServerError)
 }
@@ -444,7 +532,6 @@ func (a *api) getFileConfig(g *gin.Context, mill m.Mill, use string, plaintext b
 		if err != nil {
 			return nil, err
 		}
-		defer f.Close()
 		reader = f
 		conf.Name = fn
 	} else {
@@ -473,23 +560,30 @@ func (a *api) getFileConfig(g *gin.Context, mill m.Mill, use string, plaintext b
 		}
 	}
 
+	// readers are consumed (and closed) by AddFileIndex
+	conf.Reader = reader
+	conf.Plaintext = plaintext
+
 	media, err := a.node.GetMillMedia(reader, mill)
 	if err != nil {
+		if closer, ok := reader.(io.Closer); ok {
+			_ = closer.Close()
+		}
 		return nil, err
 	}
 	conf.Media = media
 	_, _ = reader.Seek(0, 0)
 
-	data, err := ioutil.ReadAll(reader)
-	if err != nil {
-		return nil, err
-	}
-	conf.Input = data
-	conf.Plaintext = plaintext
-
 	return conf, nil
 }
 
+// serveFileContent responds with file content, honoring range and conditional requests
+func serveFileContent(g *gin.Context, file *pb.FileIndex, reader io.ReadSeeker) {
+	g.Header("Content-Type", file.Media)
+	g.Header("Etag", "\""+file.Hash+"\"")
+	http.ServeContent(g.Writer, g.Request, "", util.ProtoTime(file.Added), reader)
+}
+
 // pbJSON responds with a JSON rendered protobuf message
 func pbJSON(g *gin.Context, status int, msg proto.Message) {
 	str, err := pbMarshaler.MarshalToString(msg)
@@ -127,7 +127,7 @@ func (a *api) lsBlocks(g *gin.Context) {
 	}
 
 	query := fmt.Sprintf("threadId='%s'", thread.Id)
-	blocks := a.node.datastore.Blocks().List(opts["offset"], limit, query)
+	blocks := a.node.datastore.Blocks().List(opts["offset"], limit, query, pb.Block_DATE)
 	for _, block := range blocks.Items {
 		block.User = a.node.PeerUser(block.Author)
 	}
@@ -151,7 +151,7 @@ func (a *api) lsBlocks(g *gin.Context) {
 		nextOffset = blocks.Items[len(blocks.Items)-1].Id
 
 		// see if there's actually more
-		if len(a.node.datastore.Blocks().List(nextOffset, 1, query).Items) == 0 {
+		if len(a.node.datastore.Blocks().List(nextOffset, 1, query, pb.Block_DATE).Items) == 0 {
 			nextOffset = ""
 		}
 	}
@@ -247,7 +247,8 @@ func (a *api) getBlockFileContent(g *gin.Context) {
 		sendError(g, err, http.StatusNotFound)
 		return
 	}
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+	defer reader.Close()
+	serveFileContent(g, file, reader)
 }
 
 // rmBlocks godoc
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lsCafeClients godoc
+// @Summary List registered cafe clients
+// @Description Lists the peers registered with this cafe, most recently seen first, including
+// @Description their storage usage and effective quota
+// @Tags cafe
+// @Produce application/json
+// @Success 200 {object} pb.CafeClientList "clients"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/clients [get]
+func (a *api) lsCafeClients(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.CafeClients())
+}
+
+// getCafeClients godoc
+// @Summary Get a registered cafe client
+// @Description Gets a peer registered with this cafe, including its storage usage and
+// @Description effective quota
+// @Tags cafe
+// @Produce application/json
+// @Param id path string true "client peer id"
+// @Success 200 {object} pb.CafeClient "client"
+// @Failure 404 {string} string "Not Found"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/clients/{id} [get]
+func (a *api) getCafeClients(g *gin.Context) {
+	client, err := a.node.CafeClient(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, client)
+}
+
+// lsCafeClientThreads godoc
+// @Summary List a cafe client's thread backups
+// @Description Lists the encrypted thread backups stored by a peer registered with this cafe
+// @Tags cafe
+// @Produce application/json
+// @Param id path string true "client peer id"
+// @Success 200 {object} pb.CafeClientThreadList "threads"
+// @Failure 404 {string} string "Not Found"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/clients/{id}/threads [get]
+func (a *api) lsCafeClientThreads(g *gin.Context) {
+	threads, err := a.node.CafeClientThreads(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, threads)
+}
+
+// setCafeClientQuota godoc
+// @Summary Set a cafe client's quota
+// @Description Overrides the default storage quota for a peer registered with this cafe.
+// @Description Omitted limits fall back to the default.
+// @Tags cafe
+// @Produce application/json
+// @Param id path string true "client peer id"
+// @Param X-Textile-Opts header string false "quota_bytes: Max bytes pinned, quota_objects: Max objects pinned, quota_threads: Max thread backups" default(quota_bytes=,quota_objects=,quota_threads=)
+// @Success 200 {object} pb.CafeClient "client"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/clients/{id}/quota [put]
+func (a *api) setCafeClientQuota(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	quota, err := readQuotaOpts(opts)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	client, err := a.node.SetCafeClientQuota(g.Param("id"), quota)
+	if err != nil {
+		if err == ErrCafeClientNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	pbJSON(g, http.StatusOK, client)
+}
+
+// revokeCafeClients godoc
+// @Summary Revoke a cafe client
+// @Description Removes a peer registered with this cafe along with its pins, thread backups,
+// @Description and inbox messages. Sessions issued to the peer are no longer accepted.
+// @Tags cafe
+// @Param id path string true "client peer id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/clients/{id}/revoke [post]
+func (a *api) revokeCafeClients(g *gin.Context) {
+	err := a.node.RevokeCafeClient(g.Param("id"))
+	if err != nil {
+		if err == ErrCafeClientNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// cafeGC godoc
+// @Summary Run cafe client GC
+// @Description Applies the client retention policy. Peers unseen for longer than the configured
+// @Description TTL are scheduled for deletion, which happens after the grace period unless they
+// @Description are seen again. Use the dry_run option to only report what would happen.
+// @Tags cafe
+// @Produce application/json
+// @Param X-Textile-Opts header string false "dry_run: Only report what would happen" default(dry_run="false")
+// @Success 200 {object} pb.CafeGCReport "report"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/gc [post]
+func (a *api) cafeGC(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	report, err := a.node.CafeGC(opts["dry_run"] == "true")
+	if err != nil {
+		if err == ErrCafeGCDisabled {
+			g.String(http.StatusBadRequest, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	pbJSON(g, http.StatusOK, report)
+}
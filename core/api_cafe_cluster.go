@@ -0,0 +1,41 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lsCafeCluster godoc
+// @Summary List cafe cluster peers
+// @Description Lists the cafes that replicate client data with this cafe, including the
+// @Description number of replications pending for each
+// @Tags cafe
+// @Produce application/json
+// @Success 200 {object} pb.CafeClusterPeerList "peers"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/cluster [get]
+func (a *api) lsCafeCluster(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.CafeCluster())
+}
+
+// syncCafeClusterPeer godoc
+// @Summary Sync a cafe cluster peer
+// @Description Queues the pins, thread backups, and inbox messages of all registered peers
+// @Description for replication to a cluster peer, e.g., when it joins the cluster
+// @Tags cafe
+// @Produce application/json
+// @Param peer path string true "cluster peer id"
+// @Success 200 {object} pb.CafeClusterPeer "peer"
+// @Failure 404 {string} string "Not Found"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /cafe/cluster/{peer}/sync [post]
+func (a *api) syncCafeClusterPeer(g *gin.Context) {
+	peer, err := a.node.SyncCafeClusterPeer(g.Param("peer"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, peer)
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// addBlockEdits godoc
+// @Summary Edit a message or caption
+// @Description Adds an edit to a thread message or files block, replacing its body or caption.
+// @Description Only the author of the block can edit it.
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "urlescaped new body"
+// @Success 201 {object} pb.Edit "edit"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/edits [post]
+func (a *api) addBlockEdits(g *gin.Context) {
+	id := g.Param("id")
+
+	thread, err, code := getBlockThread(a.node, id)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing edit body")
+		return
+	}
+
+	hash, err := thread.AddEdit(id, args[0])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	edit, err := a.node.Edit(hash.B58String())
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, edit)
+}
+
+// lsBlockEdits godoc
+// @Summary List edits
+// @Description Lists the edit history of a thread message or files block, newest first
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.EditList "edits"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocks/{id}/edits [get]
+func (a *api) lsBlockEdits(g *gin.Context) {
+	edits, err := a.node.Edits(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, edits)
+}
+
+// getBlockEdit godoc
+// @Summary Get thread edit
+// @Description Gets a thread edit by block ID
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.Edit "edit"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocks/{id}/edit [get]
+func (a *api) getBlockEdit(g *gin.Context) {
+	info, err := a.node.Edit(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, info)
+}
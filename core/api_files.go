@@ -20,7 +20,7 @@ import (
 // @Accept application/json
 // @Produce application/json
 // @Param dir body pb.DirectoryList true "list of milled dirs (output from mill endpoint)"
-// @Param X-Textile-Opts header string false "caption: Caption to add to file(s)" default(caption=)
+// @Param X-Textile-Opts header string false "caption: Caption to add to file(s), ttl: Duration after which the file(s) expire, e.g., 24h (omit to keep)" default(caption=,ttl=)
 // @Success 201 {object} pb.Files "file"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -40,6 +40,12 @@ func (a *api) addThreadFiles(g *gin.Context) {
 		return
 	}
 
+	ttl, err := parseTTL(opts["ttl"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
 	var node ipld.Node
 	var keys *pb.Keys
 
@@ -79,7 +85,7 @@ func (a *api) addThreadFiles(g *gin.Context) {
 	}
 
 	// @todo Allow the setting of the target in 0.5.0
-	hash, err := thrd.AddFiles(node, "", opts["caption"], keys.Files)
+	hash, err := thrd.AddFiles(node, "", opts["caption"], keys.Files, ttl)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -141,6 +147,53 @@ func (a *api) lsThreadFiles(g *gin.Context) {
 	pbJSON(g, http.StatusOK, list)
 }
 
+// lsSimilarFiles godoc
+// @Summary Lists similar files
+// @Description Lists files, across all threads, with images that are perceptually similar
+// @Description to those in the given files block, most similar first. Images are compared
+// @Description using their /image/phash links.
+// @Tags files
+// @Produce application/json
+// @Param X-Textile-Opts header string true "block: Files block ID (required), distance: Max number of differing hash bits. (default: 10)" default(block=,distance=10)
+// @Success 200 {object} pb.FilesList "files"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /files/similar [get]
+func (a *api) lsSimilarFiles(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	if opts["block"] == "" {
+		g.String(http.StatusBadRequest, "missing block")
+		return
+	}
+
+	distance := DefaultSimilarDistance
+	if opts["distance"] != "" {
+		distance, err = strconv.Atoi(opts["distance"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	list, err := a.node.SimilarFiles(opts["block"], distance)
+	if err != nil {
+		if err == ErrBlockNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, list)
+}
+
 // lsThreadFileTargetKeys godoc
 // @Summary Show file keys
 // @Description Shows file keys under the given target from an add
@@ -189,11 +242,13 @@ func (a *api) getFileMeta(g *gin.Context) {
 
 // getFileContent godoc
 // @Summary File content at hash
-// @Description Returns decrypted raw content for file
+// @Description Returns decrypted raw content for file. Supports HTTP range requests.
 // @Tags files
 // @Produce application/octet-stream
 // @Param hash path string true "file hash"
+// @Param Range header string false "byte range, e.g., bytes=0-1023"
 // @Success 200 {string} byte
+// @Success 206 {string} byte
 // @Failure 404 {string} string "Not Found"
 // @Router /file/{hash}/content [get]
 func (a *api) getFileContent(g *gin.Context) {
@@ -202,6 +257,7 @@ func (a *api) getFileContent(g *gin.Context) {
 		g.String(http.StatusNotFound, err.Error())
 		return
 	}
+	defer reader.Close()
 
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+	serveFileContent(g, file, reader)
 }
@@ -1,18 +1,23 @@
 package core
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/pb"
 )
 
 // addThreadMessages godoc
 // @Summary Add a message
-// @Description Adds a message to a thread
+// @Description Adds a message to a thread, optionally as a reply. Account addresses in the
+// @Description body prefixed with @ are notified as mentions.
 // @Tags threads
 // @Produce application/json
 // @Param X-Textile-Args header string true "urlescaped message body"
+// @Param X-Textile-Opts header string false "parent: Block ID of a message or files block to reply to (omit for a new post), ttl: Duration after which the message expires, e.g., 24h (omit to keep)" default(parent=,ttl=)
 // @Success 200 {object} pb.Text "message"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -28,6 +33,11 @@ func (a *api) addThreadMessages(g *gin.Context) {
 		g.String(http.StatusBadRequest, "missing message body")
 		return
 	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
 
 	threadId := g.Param("id")
 	thrd := a.node.Thread(threadId)
@@ -36,8 +46,13 @@ func (a *api) addThreadMessages(g *gin.Context) {
 		return
 	}
 
-	// @todo Allow the setting of the target in 0.5.0, which is the new way to comment
-	hash, err := thrd.AddMessage("", args[0])
+	ttl, err := parseTTL(opts["ttl"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	hash, err := thrd.AddMessage(opts["parent"], args[0], ttl)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -59,7 +74,7 @@ func (a *api) addThreadMessages(g *gin.Context) {
 // @Description Paginates thread messages
 // @Tags messages
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default', omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)" default(thread=,offset=,limit=10)
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default', omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), sort: Message order (one of 'date' or 'causal')" default(thread=,offset=,limit=10,sort="date")
 // @Success 200 {object} pb.TextList "messages"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -90,7 +105,9 @@ func (a *api) lsThreadMessages(g *gin.Context) {
 		}
 	}
 
-	list, err := a.node.Messages(opts["offset"], limit, threadId)
+	sort := pb.Block_BlockSort(pbValForEnumString(pb.Block_BlockSort_value, opts["sort"]))
+
+	list, err := a.node.Messages(opts["offset"], limit, threadId, sort)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -117,3 +134,18 @@ func (a *api) getThreadMessages(g *gin.Context) {
 
 	pbJSON(g, http.StatusOK, info)
 }
+
+// parseTTL parses an optional block time-to-live
+func parseTTL(ttl string) (time.Duration, error) {
+	if ttl == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("ttl must be positive")
+	}
+	return d, nil
+}
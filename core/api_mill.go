@@ -26,7 +26,7 @@ func (a *api) schemaMill(g *gin.Context) {
 	}
 	defer g.Request.Body.Close()
 
-	mill := &m.Schema{}
+	mill := a.node.SchemaMill()
 
 	conf := AddFileConfig{
 		Input: body,
@@ -50,7 +50,7 @@ func (a *api) schemaMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted), use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted), use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, chunked: whether to store as deduplicated chunks, thread: thread ID used to derive chunk keys" default(plaintext=false,use="",chunked=false,thread="")
 // @Success 201 {object} pb.FileIndex "file"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
@@ -61,7 +61,11 @@ func (a *api) blobMill(g *gin.Context) {
 		a.abort500(g, err)
 		return
 	}
-	mill := &m.Blob{}
+	mill := &m.Blob{
+		Opts: m.BlobOpts{
+			Chunked: opts["chunked"],
+		},
+	}
 
 	plaintext := opts["plaintext"] == "true"
 
@@ -70,6 +74,7 @@ func (a *api) blobMill(g *gin.Context) {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
+	conf.Thread = opts["thread"]
 
 	added, err := a.node.AddFileIndex(mill, *conf)
 	if err != nil {
@@ -88,7 +93,7 @@ func (a *api) blobMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested image width (required), quality: the requested JPEG image quality" default(plaintext=false,use="",quality=75,width=100)
+// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested image width (required), quality: the requested JPEG or WebP image quality, format: the output format (jpeg, png, or webp), defaults to the input format or jpeg" default(plaintext=false,use="",quality=75,width=100)
 // @Success 201 {object} pb.FileIndex "file"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
@@ -116,6 +121,7 @@ func (a *api) imageResizeMill(g *gin.Context) {
 	if opts["quality"] != "" {
 		mill.Opts.Quality = opts["quality"]
 	}
+	mill.Opts.Format = opts["format"]
 
 	plaintext := opts["plaintext"] == "true"
 
@@ -142,7 +148,7 @@ func (a *api) imageResizeMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, location: exact, coarse (rounded to ~1km), or none (removed)" default(plaintext=false,use="",location="exact")
 // @Success 201 {object} pb.FileIndex "file"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
@@ -153,7 +159,143 @@ func (a *api) imageExifMill(g *gin.Context) {
 		a.abort500(g, err)
 		return
 	}
-	mill := &m.ImageExif{}
+	mill := &m.ImageExif{
+		Opts: m.ImageExifOpts{
+			Location: opts["location"],
+		},
+	}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	conf.Media = "application/json"
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// imagePhashMill godoc
+// @Summary Compute a perceptual hash of an image
+// @Description Takes an input image, and computes a perceptual hash that is similar for
+// @Description visually similar images (optionally encrypting output), before adding to IPFS,
+// @Description and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/image/phash [post]
+func (a *api) imagePhashMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.ImagePhash{}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// videoPosterMill godoc
+// @Summary Extract a poster frame from a video
+// @Description Takes an input video, picks a representative frame, and resizes it to a JPEG image
+// @Description (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested poster width (required), quality: the requested JPEG image quality" default(plaintext=false,use="",quality=75,width=100)
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/video/poster [post]
+func (a *api) videoPosterMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.VideoPoster{
+		Opts: m.VideoPosterOpts{
+			Quality: "75",
+		},
+	}
+
+	// width is required
+	if opts["width"] == "" {
+		g.String(http.StatusBadRequest, "missing width")
+		return
+	}
+	mill.Opts.Width = opts["width"]
+
+	// quality defaults to 75
+	if opts["quality"] != "" {
+		mill.Opts.Quality = opts["quality"]
+	}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// videoProbeMill godoc
+// @Summary Extract metadata from a video
+// @Description Takes an input MP4 or QuickTime video, and extracts its duration, codecs, dimensions,
+// @Description and rotation (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/video/probe [post]
+func (a *api) videoProbeMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.VideoProbe{}
 
 	plaintext := opts["plaintext"] == "true"
 
@@ -173,6 +315,230 @@ func (a *api) imageExifMill(g *gin.Context) {
 	pbJSON(g, http.StatusCreated, added)
 }
 
+// audioMetaMill godoc
+// @Summary Extract metadata from audio
+// @Description Takes an input MP3, MP4, FLAC, or OGG audio file, and extracts its tags and duration
+// @Description (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/audio/meta [post]
+func (a *api) audioMetaMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.AudioMeta{}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	conf.Media = "application/json"
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// audioCoverMill godoc
+// @Summary Extract cover art from audio
+// @Description Takes an input audio file, and resizes its embedded cover art (optionally encrypting output),
+// @Description before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested cover width (required), quality: the requested JPEG or WebP image quality, format: the output format (jpeg, png, or webp), defaults to jpeg" default(plaintext=false,use="",quality=75,width=100)
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/audio/cover [post]
+func (a *api) audioCoverMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.AudioCover{
+		Opts: m.ImageResizeOpts{
+			Quality: "75",
+		},
+	}
+
+	// width is required
+	if opts["width"] == "" {
+		g.String(http.StatusBadRequest, "missing width")
+		return
+	}
+	mill.Opts.Width = opts["width"]
+
+	// quality defaults to 75
+	if opts["quality"] != "" {
+		mill.Opts.Quality = opts["quality"]
+	}
+	mill.Opts.Format = opts["format"]
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// docMetaMill godoc
+// @Summary Extract metadata from a document
+// @Description Takes an input PDF or plain text document, and extracts its page count, title, author,
+// @Description and word count (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/doc/meta [post]
+func (a *api) docMetaMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.DocMeta{}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	conf.Media = "application/json"
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// docTextMill godoc
+// @Summary Extract plaintext from a document
+// @Description Takes an input PDF or plain text document, and extracts its text content
+// @Description (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/doc/text [post]
+func (a *api) docTextMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.DocText{}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// externalMill godoc
+// @Summary Process input data with an external mill
+// @Description Takes an input file, and processes it with an external mill from the textile
+// @Description config (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param id path string true "external mill id, e.g., acme/ocr"
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, all other options are passed to the mill" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/{id} [post]
+func (a *api) externalMill(id string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		opts, err := a.readOpts(g)
+		if err != nil {
+			a.abort500(g, err)
+			return
+		}
+
+		// the remaining opts belong to the mill
+		plaintext := opts["plaintext"] == "true"
+		use := opts["use"]
+		thread := opts["thread"]
+		delete(opts, "plaintext")
+		delete(opts, "use")
+		delete(opts, "thread")
+
+		mill := a.node.ExternalMill(id, opts)
+		if mill == nil {
+			g.String(http.StatusNotFound, "mill not found")
+			return
+		}
+
+		conf, err := a.getFileConfig(g, mill, use, plaintext)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		conf.Thread = thread
+
+		added, err := a.node.AddFileIndex(mill, *conf)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pbJSON(g, http.StatusCreated, added)
+	}
+}
+
 // jsonMill godoc
 // @Summary Process input JSON data
 // @Description Takes an input JSON document, validates it according to its json-schema.org definition,
@@ -220,6 +586,7 @@ func (a *api) jsonMill(g *gin.Context) {
 			g.String(http.StatusBadRequest, err.Error())
 			return
 		}
+		defer reader.Close()
 		conf.Use = file.Checksum
 
 		conf.Input, err = ioutil.ReadAll(reader)
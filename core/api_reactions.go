@@ -0,0 +1,94 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// addBlockReactions godoc
+// @Summary Add a reaction
+// @Description Adds an emoji or short code reaction to a thread block. Reacting again with the same
+// @Description emoji removes the reaction.
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "urlescaped emoji or short code, e.g., :thumbsup:"
+// @Success 201 {object} pb.ReactionGroupList "reactions"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [post]
+func (a *api) addBlockReactions(g *gin.Context) {
+	id := g.Param("id")
+
+	thread, err, code := getBlockThread(a.node, id)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing reaction")
+		return
+	}
+
+	_, err = thread.AddReaction(id, args[0])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reactions, err := a.node.Reactions(id)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, reactions)
+}
+
+// lsBlockReactions godoc
+// @Summary List reactions
+// @Description Lists reactions on a thread block, grouped by emoji
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.ReactionGroupList "reactions"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocks/{id}/reactions [get]
+func (a *api) lsBlockReactions(g *gin.Context) {
+	reactions, err := a.node.Reactions(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, reactions)
+}
+
+// getBlockReaction godoc
+// @Summary Get thread reaction
+// @Description Gets a thread reaction by block ID
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.Reaction "reaction"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocks/{id}/reaction [get]
+func (a *api) getBlockReaction(g *gin.Context) {
+	info, err := a.node.Reaction(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, info)
+}
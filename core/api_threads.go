@@ -1,8 +1,12 @@
 package core
 
 import (
+	"bytes"
 	"crypto/rand"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
@@ -215,6 +219,495 @@ func (a *api) peersThreads(g *gin.Context) {
 	pbJSON(g, http.StatusOK, peers)
 }
 
+// schemasThreads godoc
+// @Summary List thread schema versions
+// @Description Lists the schema versions recorded for a thread, oldest first
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 200 {object} pb.ThreadSchemaList "schemas"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/schemas [get]
+func (a *api) schemasThreads(g *gin.Context) {
+	list, err := a.node.ThreadSchemas(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, list)
+}
+
+// updateSchemaThreads godoc
+// @Summary Update a thread schema
+// @Description Updates a thread's schema to a new version, which is shared with members. Only
+// @Description admins can update the schema. Updates that remove or change existing links are
+// @Description rejected with the schema diff unless forced.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Args header string true "schema hash"
+// @Param X-Textile-Opts header string false "force: Whether or not to apply an incompatible schema, default: false" default(force=false)
+// @Success 200 {object} pb.SchemaDiff "diff"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 409 {object} pb.SchemaDiff "diff"
+// @Router /threads/{id}/schema [put]
+func (a *api) updateSchemaThreads(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing schema hash")
+		return
+	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	diff, err := a.node.UpdateThreadSchema(g.Param("id"), args[0], opts["force"] == "true")
+	if err != nil {
+		if err == ErrIncompatibleSchema {
+			pbJSON(g, http.StatusConflict, diff)
+			return
+		}
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, diff)
+}
+
+// backfillSchemaThreads godoc
+// @Summary Backfill a thread schema
+// @Description Re-mills your existing files in a thread into schema links that were added
+// @Description after they were posted. The files of each updated post are replaced in place,
+// @Description keeping its position and annotations.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 201 {object} pb.BlockList "blocks"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/schema/backfill [post]
+func (a *api) backfillSchemaThreads(g *gin.Context) {
+	list, err := a.node.BackfillThreadSchema(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, list)
+}
+
+// membersThreads godoc
+// @Summary List thread members
+// @Description Lists addresses whose membership or role was changed by member blocks
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 200 {object} pb.ThreadMemberList "members"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/members [get]
+func (a *api) membersThreads(g *gin.Context) {
+	list, err := a.node.ThreadMembers(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, list)
+}
+
+// addMemberThreads godoc
+// @Summary Add a thread member
+// @Description Adds an account address as a thread member. Only admins can change membership.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Args header string true "address"
+// @Param X-Textile-Opts header string false "role: One of 'reader', 'annotator', 'writer', or 'admin', defaults to the thread type's access" default(role=)
+// @Success 201 {object} pb.Block "block"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/members [post]
+func (a *api) addMemberThreads(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing member address")
+		return
+	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	role, err := ParseThreadRole(opts["role"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.updateThreadMember(g, args[0], pb.ThreadMembership_ADD, role)
+}
+
+// rmMemberThreads godoc
+// @Summary Remove a thread member
+// @Description Removes an account address from a thread. Only admins can change membership.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param address path string true "address"
+// @Success 201 {object} pb.Block "block"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/members/{address} [delete]
+func (a *api) rmMemberThreads(g *gin.Context) {
+	a.updateThreadMember(g, g.Param("address"), pb.ThreadMembership_REMOVE, pb.ThreadMember_DEFAULT)
+}
+
+// grantMemberThreads godoc
+// @Summary Grant a thread role
+// @Description Grants a role to a thread member. Only admins can change roles.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param address path string true "address"
+// @Param X-Textile-Args header string true "role: One of 'reader', 'annotator', 'writer', or 'admin'"
+// @Success 201 {object} pb.Block "block"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/members/{address}/role [put]
+func (a *api) grantMemberThreads(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing role")
+		return
+	}
+	role, err := ParseThreadRole(args[0])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.updateThreadMember(g, g.Param("address"), pb.ThreadMembership_GRANT, role)
+}
+
+// revokeMemberThreads godoc
+// @Summary Revoke a thread role
+// @Description Revokes a member's role, returning them to the thread type's access.
+// @Description Only admins can change roles.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param address path string true "address"
+// @Success 201 {object} pb.Block "block"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/members/{address}/role [delete]
+func (a *api) revokeMemberThreads(g *gin.Context) {
+	a.updateThreadMember(g, g.Param("address"), pb.ThreadMembership_REVOKE, pb.ThreadMember_DEFAULT)
+}
+
+// updateThreadMember adds a member block and responds with the new block
+func (a *api) updateThreadMember(g *gin.Context, address string, action pb.ThreadMembership_Action, role pb.ThreadMember_Role) {
+	hash, err := a.node.UpdateThreadMember(g.Param("id"), address, action, role)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	block, err, code := getBlock(a.node, hash.B58String())
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	a.node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, block)
+}
+
+// rekeyThreads godoc
+// @Summary Rotate a thread key
+// @Description Re-keys a thread, distributing the new key to current members. Blocks added
+// @Description afterwards can't be read by accounts that are no longer members. Only admins can re-key.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 201 {object} pb.Block "block"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/key [post]
+func (a *api) rekeyThreads(g *gin.Context) {
+	hash, err := a.node.RotateThreadKey(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	block, err, code := getBlock(a.node, hash.B58String())
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	a.node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, block)
+}
+
+// forkThreads godoc
+// @Summary Forks a thread
+// @Description Adds and joins a new thread with a new key, re-publishing the messages and files
+// @Description of an existing thread between two blocks. The type, sharing, and whitelist
+// @Description default to those of the existing thread.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "name: Name of the new thread, key: A locally unique key used by an app to identify the new thread on recovery, type: Set the thread type to one of 'private', 'read_only', 'public', or 'open', sharing: Set the thread sharing style to one of 'not_shared','invite_only', or 'shared', whitelist: An array of contact addresses, from: Oldest block to include, to: Newest block to include" default(name=,key=,type=,sharing=,whitelist=,from=,to=)
+// @Success 201 {object} pb.Thread "thread"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/fork [post]
+func (a *api) forkThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	source, err := a.node.ThreadView(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	config := pb.AddThreadConfig{
+		Name:      source.Name,
+		Type:      source.Type,
+		Sharing:   source.Sharing,
+		Whitelist: source.Whitelist,
+	}
+	if opts["name"] != "" {
+		config.Name = opts["name"]
+	}
+	if opts["key"] != "" {
+		config.Key = opts["key"]
+	} else {
+		config.Key = ksuid.New().String()
+	}
+	if opts["type"] != "" {
+		config.Type = pb.Thread_Type(pbValForEnumString(pb.Thread_Type_value, opts["type"]))
+	}
+	if opts["sharing"] != "" {
+		config.Sharing = pb.Thread_Sharing(pbValForEnumString(pb.Thread_Sharing_value, opts["sharing"]))
+	}
+	if opts["whitelist"] != "" {
+		config.Whitelist = util.SplitString(opts["whitelist"], ",")
+	}
+
+	// make a new secret
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	thrd, err := a.node.ForkThread(source.Id, config, sk, opts["from"], opts["to"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	view, err := a.node.ThreadView(thrd.Id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	a.node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, view)
+}
+
+// verifyThreads godoc
+// @Summary Verify a thread
+// @Description Walks a thread's DAG from its heads, reporting missing nodes, undecryptable
+// @Description blocks, and missing, pending, orphaned, or mismatched indexes. With repair,
+// @Description missing nodes are re-fetched from peers and cafes and indexes are rebuilt.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "repair: Whether or not to repair the thread" default(repair=false)
+// @Success 200 {object} pb.ThreadVerification "verification"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/verify [post]
+func (a *api) verifyThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if a.node.Thread(id) == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	res, err := a.node.VerifyThread(id, opts["repair"] == "true")
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, res)
+}
+
+// exportThreads godoc
+// @Summary Export a thread
+// @Description Exports a thread archive, which includes the thread DAG, the files and schemas
+// @Description it references, and optionally the thread key
+// @Tags threads
+// @Produce application/octet-stream
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "key: Whether or not to include the thread key" default(key=false)
+// @Success 200 {array} byte "archive"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/archive [get]
+func (a *api) exportThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if a.node.Thread(id) == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	g.Header("Content-Type", "application/octet-stream")
+	g.Status(http.StatusOK)
+	err = a.node.ExportThread(id, opts["key"] == "true", g.Writer)
+	if err != nil {
+		log.Errorf("error exporting thread %s: %s", id, err)
+		g.Abort()
+	}
+}
+
+// importThreads godoc
+// @Summary Import a thread
+// @Description Adds or updates a thread from an archive, indexing its blocks and files
+// @Description without network access
+// @Tags threads
+// @Accept application/octet-stream
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param archive body string true "archive"
+// @Success 201 {object} pb.Thread "thread"
+// @Failure 400 {string} string "Bad Request"
+// @Router /threads/{id}/archive [put]
+func (a *api) importThreads(g *gin.Context) {
+	defer g.Request.Body.Close()
+
+	// check the header, keeping what's read for the import
+	var read bytes.Buffer
+	archive, err := ThreadArchiveHeader(io.TeeReader(g.Request.Body, &read))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if archive.Thread.Id != g.Param("id") {
+		g.String(http.StatusBadRequest, "thread id mismatch")
+		return
+	}
+
+	thrd, err := a.node.ImportThread(io.MultiReader(&read, g.Request.Body))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, thrd)
+}
+
+// retentionThreads godoc
+// @Summary Get a thread retention policy
+// @Description Gets the retention policy of a thread
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 200 {object} pb.ThreadRetention "retention"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/retention [get]
+func (a *api) retentionThreads(g *gin.Context) {
+	retention, err := a.node.ThreadRetention(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, retention)
+}
+
+// setRetentionThreads godoc
+// @Summary Set a thread retention policy
+// @Description Shares a new retention policy with thread members. Messages, files, and their
+// @Description annotations older than the max age, or beyond the max number of posts, are purged.
+// @Description Only admins can change the policy.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "max_age: Max age of posts, e.g., 720h (omit for no limit), max_blocks: Max number of posts (omit for no limit)" default(max_age=,max_blocks=)
+// @Success 200 {object} pb.ThreadRetention "retention"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/retention [put]
+func (a *api) setRetentionThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	var maxAge time.Duration
+	if opts["max_age"] != "" {
+		maxAge, err = time.ParseDuration(opts["max_age"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	var maxBlocks int
+	if opts["max_blocks"] != "" {
+		maxBlocks, err = strconv.Atoi(opts["max_blocks"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	retention, err := a.node.SetThreadRetention(g.Param("id"), maxAge, maxBlocks)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, retention)
+}
+
 // rmThreads godoc
 // @Summary Abandons a thread.
 // @Description Abandons a thread, and if no one else is participating, then the thread dissipates.
@@ -1,9 +1,15 @@
 package core
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
 )
 
 // createTokens godoc
@@ -13,10 +19,12 @@ import (
 // @Description token. If the 'store' option is set to false, the token is generated, but not
 // @Description stored in the local Cafe db. Alternatively, an existing token can be added using
 // @Description by specifying the 'token' option.
-// @Description Tokens allow other peers to register with a Cafe peer.
+// @Description Tokens allow other peers to register with a Cafe peer. The quota options limit
+// @Description the storage shared by all peers registered with the token. Tokens may also
+// @Description expire, limit the number of registrations, and limit sessions to some scopes.
 // @Tags tokens
 // @Produce application/json
-// @Param X-Textile-Opts header string false "token: Use existing token, rather than creating a new one, store: Whether to store the added/generated token to the local db" default(token=,store="true")
+// @Param X-Textile-Opts header string false "token: Use existing token, rather than creating a new one, store: Whether to store the added/generated token to the local db, quota_bytes: Max bytes pinned (omit for no limit), quota_objects: Max objects pinned (omit for no limit), quota_threads: Max thread backups (omit for no limit), expires: Duration after which registrations and sessions are no longer accepted, e.g., 720h (omit for never), max_registrations: Max number of registered peers (omit for no limit), scopes: Comma-separated list of store, inbox, threads, and search (omit for all)" default(token=,store="true",quota_bytes=,quota_objects=,quota_threads=,expires=,max_registrations=,scopes=)
 // @Success 201 {string} string "token"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
@@ -27,7 +35,12 @@ func (a *api) createTokens(g *gin.Context) {
 		a.abort500(g, err)
 		return
 	}
-	token, err := a.node.CreateCafeToken(opts["token"], opts["store"] == "true")
+	conf, err := readTokenOpts(opts)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := a.node.CreateCafeToken(opts["token"], opts["store"] == "true", conf)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -90,3 +103,86 @@ func (a *api) rmTokens(g *gin.Context) {
 	}
 	g.Status(http.StatusNoContent)
 }
+
+// revokeTokens godoc
+// @Summary Revokes the clients of a cafe token
+// @Description Revokes all peers registered with a cafe token, removing their pins, thread
+// @Description backups, and inbox messages. Sessions issued under the token are no longer
+// @Description accepted. The token itself is kept.
+// @Tags tokens
+// @Produce application/json
+// @Param token path string true "token"
+// @Success 200 {object} pb.CafeClientList "revoked clients"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Failure 401 {string} string "Unauthorized"
+// @Router /tokens/{id}/revoke [post]
+func (a *api) revokeTokens(g *gin.Context) {
+	clients, err := a.node.RevokeCafeToken(g.Param("token"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, clients)
+}
+
+// readTokenOpts reads cafe token limits from opts
+func readTokenOpts(opts map[string]string) (CafeTokenConfig, error) {
+	var conf CafeTokenConfig
+	var err error
+	conf.Quota, err = readQuotaOpts(opts)
+	if err != nil {
+		return conf, err
+	}
+	if opts["expires"] != "" {
+		ttl, err := time.ParseDuration(opts["expires"])
+		if err != nil {
+			return conf, err
+		}
+		conf.Expires = time.Now().Add(ttl)
+	}
+	if opts["max_registrations"] != "" {
+		conf.MaxRegistrations, err = strconv.Atoi(opts["max_registrations"])
+		if err != nil {
+			return conf, err
+		}
+	}
+	for _, name := range util.SplitString(opts["scopes"], ",") {
+		scope, ok := pb.CafeToken_Scope_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return conf, fmt.Errorf("invalid scope: %s", name)
+		}
+		conf.Scopes = append(conf.Scopes, pb.CafeToken_Scope(scope))
+	}
+	return conf, nil
+}
+
+// readQuotaOpts reads cafe quota limits from opts, omitted limits are zero (unlimited)
+func readQuotaOpts(opts map[string]string) (*pb.CafeQuota, error) {
+	quota := &pb.CafeQuota{}
+	if opts["quota_bytes"] != "" {
+		bytes, err := strconv.ParseInt(opts["quota_bytes"], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		quota.Bytes = bytes
+	}
+	for _, limit := range []struct {
+		opt string
+		val *int32
+	}{{"quota_objects", &quota.Objects}, {"quota_threads", &quota.Threads}} {
+		if opts[limit.opt] == "" {
+			continue
+		}
+		val, err := strconv.ParseInt(opts[limit.opt], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		*limit.val = int32(val)
+	}
+	if quota.Bytes < 0 || quota.Objects < 0 || quota.Threads < 0 {
+		return nil, fmt.Errorf("quota limits must not be negative")
+	}
+	return quota, nil
+}
@@ -53,7 +53,7 @@ func (q *BlockDownloads) Flush() {
 	log.Debug("flushing downloads")
 
 	query := fmt.Sprintf("status=%d", pb.Block_PENDING)
-	q.batch(q.datastore.Blocks().List("", downloadsFlushGroupSize, query).Items)
+	q.batch(q.datastore.Blocks().List("", downloadsFlushGroupSize, query, pb.Block_DATE).Items)
 }
 
 // batch flushes a batch of downloads
@@ -77,7 +77,7 @@ func (q *BlockDownloads) batch(downloads []*pb.Block) {
 	// next batch
 	offset := downloads[len(downloads)-1].Id
 	query := fmt.Sprintf("status=%d", pb.Block_PENDING)
-	q.batch(q.datastore.Blocks().List(offset, downloadsFlushGroupSize, query).Items)
+	q.batch(q.datastore.Blocks().List(offset, downloadsFlushGroupSize, query, pb.Block_DATE).Items)
 }
 
 // handle handles a single message
@@ -97,7 +97,7 @@ func (q *BlockDownloads) handle(dl *pb.Block) error {
 		return fail("thread not found")
 	}
 
-	_, err = thread.handle(&blockNode{hash: dl.Id,
+	index, err := thread.handle(&blockNode{hash: dl.Id,
 		ciphertext: ciphertext,
 		parents:    dl.Parents,
 		target:     dl.Target,
@@ -106,6 +106,14 @@ func (q *BlockDownloads) handle(dl *pb.Block) error {
 	if err != nil {
 		return fail(err.Error())
 	}
+
+	// re-key if an account left while we were away
+	if index != nil && index.Type == pb.Block_LEAVE {
+		err = thread.rotateKeyOnLeave()
+		if err != nil {
+			log.Warningf("failed to rotate key for %s: %s", thread.Id, err)
+		}
+	}
 	return nil
 }
 
@@ -4,18 +4,19 @@ import (
 	"fmt"
 
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
 )
 
 // ErrBlockNotFound indicates a block was not found in the index
 var ErrBlockNotFound = fmt.Errorf("block not found")
 
 // GetBlocks paginates blocks
-func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
+func (t *Textile) Blocks(offset string, limit int, query string, sort pb.Block_BlockSort) *pb.BlockList {
 	filtered := &pb.BlockList{Items: make([]*pb.Block, 0)}
 
-	for _, block := range t.datastore.Blocks().List(offset, limit, query).Items {
+	for _, block := range t.datastore.Blocks().List(offset, limit, query, sort).Items {
 		q := fmt.Sprintf("target='%s' and type=%d", block.Id, pb.Block_IGNORE)
-		ignored := t.datastore.Blocks().List("", -1, q)
+		ignored := t.datastore.Blocks().List("", -1, q, pb.Block_DATE)
 		if len(ignored.Items) == 0 {
 			filtered.Items = append(filtered.Items, block)
 		}
@@ -48,7 +49,7 @@ func (t *Textile) BlockByParent(parent string) (*pb.Block, error) {
 
 // BlocksByTarget returns block with parent
 func (t *Textile) BlocksByTarget(target string) *pb.BlockList {
-	return t.datastore.Blocks().List("", -1, "target='"+target+"'")
+	return t.datastore.Blocks().List("", -1, "target='"+target+"'", pb.Block_DATE)
 }
 
 // BlockView returns block with expanded view properties
@@ -61,3 +62,14 @@ func (t *Textile) BlockView(id string) (*pb.Block, error) {
 	block.User = t.PeerUser(block.Author)
 	return block, nil
 }
+
+// causallyNewer returns whether or not block a comes after block b in causal order
+func causallyNewer(a *pb.Block, b *pb.Block) bool {
+	if a.Clock != b.Clock {
+		return a.Clock > b.Clock
+	}
+	if util.ProtoNanos(a.Date) != util.ProtoNanos(b.Date) {
+		return util.ProtoTsIsNewer(a.Date, b.Date)
+	}
+	return a.Id > b.Id
+}
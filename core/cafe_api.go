@@ -92,13 +92,13 @@ func (c *cafeApi) start() {
 	// v1 routes
 	v1 := router.Group("/api/v1")
 
-	store := v1.Group("/store", c.validateToken)
+	store := v1.Group("/store", c.validateToken, c.validateScope(pb.CafeToken_STORE))
 	{
 		store.PUT("", c.store)
 		store.DELETE("/:cid", c.unstore)
 	}
 
-	threads := v1.Group("/threads", c.validateToken)
+	threads := v1.Group("/threads", c.validateToken, c.validateScope(pb.CafeToken_THREADS))
 	{
 		threads.PUT("/:id", c.storeThread)
 		threads.DELETE("/:id", c.unstoreThread)
@@ -109,7 +109,7 @@ func (c *cafeApi) start() {
 		inbox.POST("/:from/:to", c.deliverMessage)
 	}
 
-	search := v1.Group("/search", c.validateToken)
+	search := v1.Group("/search", c.validateToken, c.validateScope(pb.CafeToken_SEARCH))
 	{
 		search.POST("", c.search)
 	}
@@ -173,13 +173,29 @@ func (c *cafeApi) validateToken(g *gin.Context) {
 		}
 		return
 	}
+	if c.node.cafe.revoked(claims) {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
 
 	g.Set("from", claims.Subject)
+	g.Set("claims", claims)
+}
+
+// validateScope aborts the request if the validated token's session doesn't include a scope
+func (c *cafeApi) validateScope(scope pb.CafeToken_Scope) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		claims, ok := g.Get("claims")
+		if !ok || !claims.(*jwt.TextileClaims).Allows(scope.String()) {
+			c.abort(g, http.StatusForbidden, nil)
+			return
+		}
+	}
 }
 
 // verifyKeyFunc returns the correct key for token verification
 func (c *cafeApi) verifyKeyFunc(token *njwt.Token) (interface{}, error) {
-	return c.node.Ipfs().PrivateKey.GetPublic(), nil
+	return c.node.cafe.verifyKeyFunc(token)
 }
 
 // abort aborts the request with the given status code and error
@@ -21,6 +21,13 @@ func (c *cafeApi) store(g *gin.Context) {
 	var err error
 	var aid *cid.Cid
 
+	from := g.GetString("from")
+	client := c.node.datastore.CafeClients().Get(from)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+
 	form, err := g.MultipartForm()
 	if err != nil {
 		log.Warning(err)
@@ -43,10 +50,11 @@ func (c *cafeApi) store(g *gin.Context) {
 			return
 		}
 
-		aid, err = ipfs.AddObject(c.node.Ipfs(), f, true)
+		// the cid is only known once added, so data is pinned after the quota check
+		aid, err = ipfs.AddObject(c.node.Ipfs(), f, false)
 		if err != nil {
 			_, _ = f.Seek(0, 0)
-			aid, err = ipfs.AddData(c.node.Ipfs(), f, true, false)
+			aid, err = ipfs.AddData(c.node.Ipfs(), f, false, false)
 		}
 		if err != nil {
 			log.Warning(err)
@@ -54,7 +62,28 @@ func (c *cafeApi) store(g *gin.Context) {
 			return
 		}
 
-		log.Debugf("stored %s", aid.Hash().B58String())
+		hash := aid.Hash().B58String()
+		stored := c.node.datastore.CafeClientObjects().Get(hash, client.Id) != nil
+		if !stored && !c.node.cafe.withinQuota(client, file.Size, 1, 0) {
+			c.abort(g, http.StatusInsufficientStorage, ErrCafeQuotaExceeded)
+			return
+		}
+		err = c.node.cafe.pinObject(*aid)
+		if err != nil {
+			log.Warning(err)
+			c.abort(g, http.StatusBadRequest, err)
+			return
+		}
+		if !stored {
+			err = c.node.cafe.storeObject(client, hash, file.Size)
+			if err != nil {
+				c.abort(g, http.StatusInternalServerError, err)
+				return
+			}
+			c.node.cafe.replicate(client.Id, pb.CafeReplication_STORE, hash)
+		}
+
+		log.Debugf("stored %s", hash)
 
 		f.Close()
 		f = nil
@@ -64,6 +93,13 @@ func (c *cafeApi) store(g *gin.Context) {
 }
 
 func (c *cafeApi) unstore(g *gin.Context) {
+	from := g.GetString("from")
+	client := c.node.datastore.CafeClients().Get(from)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+
 	id, err := cid.Decode(g.Param("cid"))
 	if err != nil {
 		log.Warning(err)
@@ -80,12 +116,13 @@ func (c *cafeApi) unstore(g *gin.Context) {
 
 	for _, p := range pinned {
 		if p.Mode != pin.NotPinned {
-			err = ipfs.UnpinCid(c.node.Ipfs(), p.Key, true)
+			err = c.node.cafe.unstoreObject(client, p.Key)
 			if err != nil {
 				log.Warning(err)
 				c.abort(g, http.StatusBadRequest, err)
 				return
 			}
+			c.node.cafe.replicate(client.Id, pb.CafeReplication_UNSTORE, p.Key.Hash().B58String())
 
 			log.Debugf("unstored %s", p.Key.Hash().B58String())
 		}
@@ -104,6 +141,11 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		return
 	}
 
+	if !c.node.cafe.storedThread(client, id) && !c.node.cafe.withinQuota(client, 0, 0, 1) {
+		c.abort(g, http.StatusInsufficientStorage, ErrCafeQuotaExceeded)
+		return
+	}
+
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
 		buf.Reset()
@@ -128,6 +170,8 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		return
 	}
 
+	c.node.cafe.replicate(client.Id, pb.CafeReplication_STORE_THREAD, id)
+
 	log.Debugf("stored thread %s", id)
 
 	g.Status(http.StatusNoContent)
@@ -149,6 +193,8 @@ func (c *cafeApi) unstoreThread(g *gin.Context) {
 		return
 	}
 
+	c.node.cafe.replicate(client.Id, pb.CafeReplication_UNSTORE_THREAD, id)
+
 	log.Debugf("unstored thread %s", id)
 
 	g.Status(http.StatusNoContent)
@@ -164,6 +210,11 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		g.Status(http.StatusOK)
 		return
 	}
+	if !c.node.cafe.clientAllows(client, pb.CafeToken_INBOX) {
+		log.Warningf("received message for client %s without inbox scope", clientId)
+		g.Status(http.StatusOK)
+		return
+	}
 
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
@@ -178,59 +229,14 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		c.abort(g, http.StatusBadRequest, err)
 		return
 	}
-	body := buf.Bytes()
-
-	// pin inner node
-	nenv := new(pb.Envelope)
-	err = proto.Unmarshal(body, nenv)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	tenv := new(pb.ThreadEnvelope)
-	err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	oid, err := ipfs.AddObject(c.node.Ipfs(), bytes.NewReader(tenv.Node), true)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	node, err := ipfs.NodeAtCid(c.node.Ipfs(), *oid)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	if tenv.Block != nil {
-		_, err = ipfs.AddData(c.node.Ipfs(), bytes.NewReader(tenv.Block), true, false)
-		if err != nil {
-			log.Warning(err)
-			c.abort(g, http.StatusBadRequest, err)
-			return
-		}
-	}
-	_, err = extractNode(c.node.Ipfs(), node, tenv.Block == nil)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
 
-	// pin envelope
-	id, err := ipfs.AddData(c.node.Ipfs(), bytes.NewReader(body), true, false)
+	msgId, err := c.node.cafe.storeEnvelope(buf.Bytes())
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusBadRequest, err)
 		return
 	}
 
-	msgId := id.Hash().B58String()
 	err = c.node.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
 		Id:     msgId,
 		Peer:   from,
@@ -241,6 +247,7 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicate(client.Id, pb.CafeReplication_MESSAGE, msgId)
 
 	go func() {
 		err = c.node.cafe.notifyClient(client.Id)
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrCafeClientNotFound indicates a peer is not registered with this cafe
+var ErrCafeClientNotFound = fmt.Errorf("cafe client not found")
+
+// CafeClients lists the peers registered with this cafe, most recently seen first
+func (t *Textile) CafeClients() *pb.CafeClientList {
+	list := &pb.CafeClientList{Items: make([]*pb.CafeClient, 0)}
+	for _, client := range t.datastore.CafeClients().List() {
+		client := client
+		client.Usage = t.cafe.clientUsage(&client)
+		list.Items = append(list.Items, &client)
+	}
+	return list
+}
+
+// CafeClient returns a registered peer and its storage usage
+func (t *Textile) CafeClient(id string) (*pb.CafeClient, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+	client.Usage = t.cafe.clientUsage(client)
+	return client, nil
+}
+
+// CafeClientThreads lists the encrypted thread backups stored by a registered peer
+func (t *Textile) CafeClientThreads(id string) (*pb.CafeClientThreadList, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+
+	list := &pb.CafeClientThreadList{Items: make([]*pb.CafeClientThread, 0)}
+	for _, thrd := range t.datastore.CafeClientThreads().ListByClient(client.Id) {
+		thrd := thrd
+		list.Items = append(list.Items, &thrd)
+	}
+	return list, nil
+}
+
+// SetCafeClientQuota overrides the host default quota for a registered peer.
+// Zero values fall back to the default.
+func (t *Textile) SetCafeClientQuota(id string, quota *pb.CafeQuota) (*pb.CafeClient, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+
+	err := t.datastore.CafeClients().UpdateQuota(client.Id, quota)
+	if err != nil {
+		return nil, err
+	}
+	t.cafe.replicate(client.Id, pb.CafeReplication_CLIENT, "")
+	return t.CafeClient(client.Id)
+}
+
+// RevokeCafeClient removes a registered peer along with its pins, thread backups,
+// and inbox messages. Sessions issued to the peer are no longer accepted.
+func (t *Textile) RevokeCafeClient(id string) error {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return ErrCafeClientNotFound
+	}
+	err := t.cafe.removeClient(client)
+	if err != nil {
+		return err
+	}
+	t.cafe.replicate(client.Id, pb.CafeReplication_REMOVE_CLIENT, "")
+	return nil
+}
+
+// RevokeCafeToken revokes all peers registered with a token, returning the revoked peers.
+// The token itself is kept, use RemoveCafeToken to prevent new registrations.
+func (t *Textile) RevokeCafeToken(token string) (*pb.CafeClientList, error) {
+	id, err := cafeTokenId(token)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &pb.CafeClientList{Items: make([]*pb.CafeClient, 0)}
+	for _, client := range t.datastore.CafeClients().ListByToken(id) {
+		client := client
+		err = t.cafe.removeClient(&client)
+		if err != nil {
+			return nil, err
+		}
+		t.cafe.replicate(client.Id, pb.CafeReplication_REMOVE_CLIENT, "")
+		list.Items = append(list.Items, &client)
+	}
+	return list, nil
+}
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ipfs/go-ipfs/core/corerepo"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// kCafeGCFreq how often to apply the cafe client retention policy
+const kCafeGCFreq = time.Hour
+
+// ErrCafeGCDisabled indicates this cafe has no client retention policy
+var ErrCafeGCDisabled = fmt.Errorf("cafe gc is not enabled, see Cafe.Host.GC")
+
+// CafeGC applies the client retention policy. Clients unseen for longer than the configured
+// TTL are scheduled for deletion, which happens after the grace period unless they are seen
+// again. Content no longer pinned is reclaimed with an ipfs repo GC.
+// A dry run only reports what would happen.
+func (t *Textile) CafeGC(dryRun bool) (*pb.CafeGCReport, error) {
+	conf := t.config.Cafe.Host.GC
+	if conf.ClientTTL <= 0 {
+		return nil, ErrCafeGCDisabled
+	}
+
+	t.cafeGCLock.Lock()
+	defer t.cafeGCLock.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-time.Duration(conf.ClientTTL) * 24 * time.Hour).UnixNano()
+	expires := now.Add(time.Duration(conf.GracePeriod) * 24 * time.Hour)
+
+	report := &pb.CafeGCReport{
+		Items:  make([]*pb.CafeClientGC, 0),
+		DryRun: dryRun,
+	}
+	var deleted int
+	for _, client := range t.datastore.CafeClients().List() {
+		client := client
+		unseen := util.ProtoNanos(client.Seen) <= cutoff
+
+		item := &pb.CafeClientGC{Client: &client}
+		switch {
+		case client.Expires == nil && !unseen:
+			continue
+		case client.Expires == nil:
+			item.Action = pb.CafeClientGC_SCHEDULE
+			client.Expires, _ = ptypes.TimestampProto(expires)
+		case !unseen:
+			item.Action = pb.CafeClientGC_CANCEL
+		case util.ProtoNanos(client.Expires) > now.UnixNano():
+			item.Action = pb.CafeClientGC_PENDING
+		default:
+			item.Action = pb.CafeClientGC_DELETE
+		}
+		client.Usage = t.cafe.clientUsage(&client)
+		item.Messages = int32(t.datastore.CafeClientMessages().CountByClient(client.Id))
+		report.Items = append(report.Items, item)
+
+		if dryRun {
+			continue
+		}
+
+		var err error
+		switch item.Action {
+		case pb.CafeClientGC_SCHEDULE:
+			err = t.datastore.CafeClients().UpdateExpires(client.Id, expires)
+			if err != nil {
+				return nil, err
+			}
+			err = t.sendNotification(&pb.Notification{
+				Id:      ksuid.New().String(),
+				Date:    ptypes.TimestampNow(),
+				Actor:   client.Id,
+				Subject: client.Id,
+				Type:    pb.Notification_CAFE_CLIENT_EXPIRING,
+				Body:    "is scheduled for deletion on " + expires.Format(time.RFC1123),
+			})
+			if err != nil {
+				return nil, err
+			}
+			// best effort, unseen clients are likely offline
+			err = t.cafe.notifyClientExpiring(client.Id, expires)
+			if err != nil {
+				log.Warningf("unable to notify cafe client %s: %s", client.Id, err)
+				err = nil
+			}
+			log.Infof("scheduled cafe client %s for deletion on %s", client.Id, expires.String())
+		case pb.CafeClientGC_CANCEL:
+			err = t.datastore.CafeClients().UpdateExpires(client.Id, time.Time{})
+			log.Infof("cancelled deletion of cafe client %s", client.Id)
+		case pb.CafeClientGC_DELETE:
+			// not replicated, each cafe in a cluster applies its own policy
+			err = t.cafe.removeClient(&client)
+			deleted++
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if deleted > 0 {
+		err := corerepo.GarbageCollect(t.node, t.node.Context())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// runCafeGC periodically applies the cafe client retention policy
+func (t *Textile) runCafeGC() {
+	tick := time.NewTicker(kCafeGCFreq)
+	defer tick.Stop()
+
+	for {
+		_, err := t.CafeGC(false)
+		if err != nil {
+			log.Errorf("error running cafe gc: %s", err)
+		}
+
+		select {
+		case <-tick.C:
+		case <-t.done:
+			return
+		}
+	}
+}
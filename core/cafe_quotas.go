@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+)
+
+// ErrCafeQuotaExceeded indicates a client request would exceed a storage quota
+var ErrCafeQuotaExceeded = fmt.Errorf(errQuotaExceeded)
+
+// cafeQuota converts a config quota
+func cafeQuota(conf config.CafeQuota) *pb.CafeQuota {
+	return &pb.CafeQuota{
+		Bytes:   conf.Bytes,
+		Objects: conf.Objects,
+		Threads: conf.Threads,
+	}
+}
+
+// clientQuota returns the effective quota of a client, where each of
+// the client's own limits overrides the host default
+func (h *CafeService) clientQuota(client *pb.CafeClient) *pb.CafeQuota {
+	quota := &pb.CafeQuota{
+		Bytes:   h.quota.GetBytes(),
+		Objects: h.quota.GetObjects(),
+		Threads: h.quota.GetThreads(),
+	}
+	if client.Quota.GetBytes() > 0 {
+		quota.Bytes = client.Quota.Bytes
+	}
+	if client.Quota.GetObjects() > 0 {
+		quota.Objects = client.Quota.Objects
+	}
+	if client.Quota.GetThreads() > 0 {
+		quota.Threads = client.Quota.Threads
+	}
+	return quota
+}
+
+// clientUsage returns the usage of a client along with its effective quota
+func (h *CafeService) clientUsage(client *pb.CafeClient) *pb.CafeUsage {
+	usage := h.datastore.CafeClients().Usage(client.Id)
+	usage.Quota = h.clientQuota(client)
+	return usage
+}
+
+// withinQuota returns whether or not a client can store additional bytes, objects,
+// and threads, within both its own quota and the quota shared by its token.
+// Nothing more can be stored if the token is gone.
+func (h *CafeService) withinQuota(client *pb.CafeClient, bytes int64, objects int32, threads int32) bool {
+	usage := h.datastore.CafeClients().Usage(client.Id)
+	if exceedsQuota(usage, h.clientQuota(client), bytes, objects, threads) {
+		return false
+	}
+
+	if client.Token == "" {
+		return true
+	}
+	token := h.datastore.CafeTokens().Get(client.Token)
+	if token == nil {
+		return false
+	}
+	if token.Quota == nil {
+		return true
+	}
+	usage = h.datastore.CafeClients().UsageByToken(token.Id)
+	return !exceedsQuota(usage, token.Quota, bytes, objects, threads)
+}
+
+// storedThread returns whether or not a client already stores a thread
+func (h *CafeService) storedThread(client *pb.CafeClient, id string) bool {
+	return h.clientThread(client, id) != nil
+}
+
+// clientThread returns a thread stored by a client
+func (h *CafeService) clientThread(client *pb.CafeClient, id string) *pb.CafeClientThread {
+	for _, thrd := range h.datastore.CafeClientThreads().ListByClient(client.Id) {
+		if thrd.Id == id {
+			return &thrd
+		}
+	}
+	return nil
+}
+
+// storeObject records an object as stored by a client
+func (h *CafeService) storeObject(client *pb.CafeClient, hash string, size int64) error {
+	return h.datastore.CafeClientObjects().AddOrUpdate(&pb.CafeClientObject{
+		Id:     hash,
+		Client: client.Id,
+		Size:   size,
+		Date:   ptypes.TimestampNow(),
+	})
+}
+
+// pinObject directly pins a local object, as when added with pinning
+func (h *CafeService) pinObject(id icid.Cid) error {
+	node, err := ipfs.NodeAtCid(h.service.Node(), id)
+	if err != nil {
+		return err
+	}
+	return ipfs.PinNode(h.service.Node(), node, false)
+}
+
+// unstoreObject removes an object from a client, unpinning it if
+// no other client stores it
+func (h *CafeService) unstoreObject(client *pb.CafeClient, id icid.Cid) error {
+	hash := id.Hash().B58String()
+	err := h.datastore.CafeClientObjects().Delete(hash, client.Id)
+	if err != nil {
+		return err
+	}
+	if h.datastore.CafeClientObjects().Count(hash) > 0 {
+		return nil
+	}
+	return ipfs.UnpinCid(h.service.Node(), id, true)
+}
+
+// objectSize returns the size of a local object as clients send it,
+// i.e., the file size of data or the block size of other nodes
+func (h *CafeService) objectSize(hash string) int64 {
+	f, err := ipfs.FileAtPath(h.service.Node(), hash)
+	if err == nil {
+		defer f.Close()
+		size, err := f.Size()
+		if err == nil {
+			return size
+		}
+	}
+	stat, err := ipfs.StatObjectAtPath(h.service.Node(), hash)
+	if err != nil {
+		return 0
+	}
+	return int64(stat.BlockSize)
+}
+
+// updateUsage saves the usage reported by a cafe to its session
+func (h *CafeService) updateUsage(cafeId string, usage *pb.CafeUsage) {
+	if usage == nil {
+		return
+	}
+	err := h.datastore.CafeSessions().UpdateUsage(cafeId, usage)
+	if err != nil {
+		log.Errorf("error updating usage for %s: %s", cafeId, err)
+	}
+}
+
+// exceedsQuota returns whether or not the additions exceed a quota, zero limits are unlimited
+func exceedsQuota(usage *pb.CafeUsage, quota *pb.CafeQuota, bytes int64, objects int32, threads int32) bool {
+	if quota.Bytes > 0 && bytes > 0 && usage.Bytes+bytes > quota.Bytes {
+		return true
+	}
+	if quota.Objects > 0 && objects > 0 && usage.Objects+objects > quota.Objects {
+		return true
+	}
+	if quota.Threads > 0 && threads > 0 && usage.Threads+threads > quota.Threads {
+		return true
+	}
+	return false
+}
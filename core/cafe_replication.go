@@ -0,0 +1,387 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// replicationBatchSize is the max number of replications sent in one request
+const replicationBatchSize = 20
+
+// replicationBudget is how long a cluster peer applies replications before acking,
+// which keeps it under the request timeout while objects are fetched
+const replicationBudget = time.Second * 20
+
+// maxReplicationAttempts is the number of times a replication can fail before being deleted
+const maxReplicationAttempts = 5
+
+// ErrCafeClusterPeerNotFound indicates a peer is not in this cafe's cluster
+var ErrCafeClusterPeerNotFound = fmt.Errorf("cafe cluster peer not found")
+
+// CafeCluster lists the cafes in this cafe's cluster and their pending replications
+func (t *Textile) CafeCluster() *pb.CafeClusterPeerList {
+	list := &pb.CafeClusterPeerList{Items: make([]*pb.CafeClusterPeer, 0)}
+	for _, id := range t.cafe.cluster {
+		list.Items = append(list.Items, &pb.CafeClusterPeer{
+			Id:      id,
+			Pending: int32(t.datastore.CafeReplications().CountByPeer(id)),
+		})
+	}
+	return list
+}
+
+// SyncCafeClusterPeer queues everything stored by this cafe's clients for a cluster peer,
+// e.g., when it joins the cluster or lost its data
+func (t *Textile) SyncCafeClusterPeer(id string) (*pb.CafeClusterPeer, error) {
+	if !t.cafe.inCluster(id) {
+		return nil, ErrCafeClusterPeerNotFound
+	}
+
+	for _, client := range t.datastore.CafeClients().List() {
+		t.cafe.replicateTo(id, client.Id, pb.CafeReplication_CLIENT, "")
+		for _, obj := range t.datastore.CafeClientObjects().ListByClient(client.Id) {
+			t.cafe.replicateTo(id, client.Id, pb.CafeReplication_STORE, obj.Id)
+		}
+		for _, thrd := range t.datastore.CafeClientThreads().ListByClient(client.Id) {
+			t.cafe.replicateTo(id, client.Id, pb.CafeReplication_STORE_THREAD, thrd.Id)
+		}
+		for _, msg := range t.datastore.CafeClientMessages().ListByClient(client.Id, -1) {
+			t.cafe.replicateTo(id, client.Id, pb.CafeReplication_MESSAGE, msg.Id)
+		}
+	}
+
+	go t.cafe.flushReplications()
+
+	return &pb.CafeClusterPeer{
+		Id:      id,
+		Pending: int32(t.datastore.CafeReplications().CountByPeer(id)),
+	}, nil
+}
+
+// inCluster returns whether or not a peer is in this cafe's cluster
+func (h *CafeService) inCluster(peerId string) bool {
+	for _, id := range h.cluster {
+		if id == peerId {
+			return true
+		}
+	}
+	return false
+}
+
+// replicate queues a client change for each cafe in the cluster.
+// Only changes made by clients are replicated, not those received from the cluster.
+func (h *CafeService) replicate(clientId string, rtype pb.CafeReplication_Type, target string) {
+	for _, id := range h.cluster {
+		h.replicateTo(id, clientId, rtype, target)
+	}
+}
+
+// replicateTo queues a client change for a cluster peer
+func (h *CafeService) replicateTo(peerId string, clientId string, rtype pb.CafeReplication_Type, target string) {
+	id := ksuid.New().String()
+	if rtype == pb.CafeReplication_CLIENT {
+		// the client is read when sent, so one pending update is enough
+		id = peerId + "/" + clientId
+	}
+	err := h.datastore.CafeReplications().Add(&pb.CafeReplication{
+		Id:     id,
+		Peer:   peerId,
+		Client: clientId,
+		Type:   rtype,
+		Target: target,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		log.Errorf("error queuing replication for %s: %s", peerId, err)
+	}
+}
+
+// flushReplications sends queued replications to each cafe in the cluster
+func (h *CafeService) flushReplications() {
+	if !h.open || len(h.cluster) == 0 {
+		return
+	}
+
+	h.replicationLock.Lock()
+	defer h.replicationLock.Unlock()
+
+	for _, id := range h.cluster {
+		err := h.flushPeerReplications(id)
+		if err != nil {
+			log.Warningf("unable to replicate to %s: %s", id, err)
+		}
+	}
+}
+
+// flushPeerReplications sends replications to a cluster peer in batches, in order,
+// until none are left or the peer fails to apply the first of a batch
+func (h *CafeService) flushPeerReplications(peerId string) error {
+	for {
+		reps := h.datastore.CafeReplications().ListByPeer(peerId, replicationBatchSize)
+		if len(reps) == 0 {
+			return nil
+		}
+
+		req := &pb.CafeReplicate{}
+		clients := make(map[string]struct{})
+		tokens := make(map[string]struct{})
+		for i := range reps {
+			rep := &reps[i]
+			if !h.addReplicationPayload(rep) {
+				// superseded by a later replication
+				err := h.datastore.CafeReplications().Delete(rep.Id)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			req.Items = append(req.Items, rep)
+
+			if _, ok := clients[rep.Client]; ok || rep.Type == pb.CafeReplication_REMOVE_CLIENT {
+				continue
+			}
+			clients[rep.Client] = struct{}{}
+			client := h.datastore.CafeClients().Get(rep.Client)
+			if client == nil {
+				continue
+			}
+			req.Clients = append(req.Clients, client)
+
+			// scopes and quotas are read from the token
+			if _, ok := tokens[client.Token]; ok || client.Token == "" {
+				continue
+			}
+			tokens[client.Token] = struct{}{}
+			token := h.datastore.CafeTokens().Get(client.Token)
+			if token != nil {
+				req.Tokens = append(req.Tokens, token)
+			}
+		}
+		if len(req.Items) == 0 {
+			continue
+		}
+
+		env, err := h.service.NewEnvelope(pb.Message_CAFE_REPLICATE, req, nil, false)
+		if err != nil {
+			return err
+		}
+		renv, err := h.service.SendRequest(peerId, env)
+		if err != nil {
+			return err
+		}
+		ack := new(pb.CafeReplicateAck)
+		err = ptypes.UnmarshalAny(renv.Message.Payload, ack)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ack.Ids {
+			err = h.datastore.CafeReplications().Delete(id)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(ack.Ids) == 0 {
+			first := req.Items[0]
+			if first.Attempts+1 >= maxReplicationAttempts {
+				log.Warningf("replication %s to %s failed too many times, dropping", first.Id, peerId)
+				err = h.datastore.CafeReplications().Delete(first.Id)
+			} else {
+				err = h.datastore.CafeReplications().AddAttempt(first.Id)
+			}
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("replication %s was not applied", first.Id)
+		}
+	}
+}
+
+// addReplicationPayload adds the current client data to a replication,
+// returning false if the data is gone, i.e., a later replication removes it
+func (h *CafeService) addReplicationPayload(rep *pb.CafeReplication) bool {
+	if rep.Type == pb.CafeReplication_REMOVE_CLIENT {
+		return true
+	}
+	client := h.datastore.CafeClients().Get(rep.Client)
+	if client == nil {
+		return false
+	}
+
+	switch rep.Type {
+	case pb.CafeReplication_STORE:
+		rep.Object = h.datastore.CafeClientObjects().Get(rep.Target, client.Id)
+		return rep.Object != nil
+	case pb.CafeReplication_STORE_THREAD:
+		rep.Thread = h.clientThread(client, rep.Target)
+		return rep.Thread != nil
+	case pb.CafeReplication_MESSAGE:
+		for _, msg := range h.datastore.CafeClientMessages().ListByClient(client.Id, -1) {
+			if msg.Id == rep.Target {
+				msg := msg
+				rep.Message = &msg
+				break
+			}
+		}
+		if rep.Message == nil {
+			return false
+		}
+		env, err := ipfs.DataAtPath(h.service.Node(), rep.Target)
+		if err == nil {
+			rep.Env = env
+		}
+	}
+	return true
+}
+
+// handleReplicate receives client changes from a cluster peer, applying them in order
+// until one fails or the time budget is spent
+func (h *CafeService) handleReplicate(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	req := new(pb.CafeReplicate)
+	err := ptypes.UnmarshalAny(env.Message.Payload, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.open || !h.inCluster(pid.Pretty()) {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
+	for _, token := range req.Tokens {
+		if h.datastore.CafeTokens().Get(token.Id) != nil {
+			continue
+		}
+		err = h.datastore.CafeTokens().Add(token)
+		if err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.Request)
+		}
+	}
+
+	clients := make(map[string]*pb.CafeClient)
+	for _, client := range req.Clients {
+		clients[client.Id] = client
+		if h.datastore.CafeClients().Get(client.Id) != nil {
+			continue
+		}
+		err = h.datastore.CafeClients().Add(client)
+		if err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.Request)
+		}
+	}
+
+	start := time.Now()
+	res := &pb.CafeReplicateAck{}
+	for _, rep := range req.Items {
+		if time.Since(start) > replicationBudget {
+			break
+		}
+		err = h.applyReplication(rep, clients[rep.Client])
+		if err != nil {
+			log.Warningf("error applying replication %s from %s: %s", rep.Id, pid.Pretty(), err)
+			break
+		}
+		res.Ids = append(res.Ids, rep.Id)
+	}
+
+	return h.service.NewEnvelope(pb.Message_CAFE_REPLICATE_ACK, res, &env.Message.Request, true)
+}
+
+// applyReplication applies a client change from a cluster peer
+func (h *CafeService) applyReplication(rep *pb.CafeReplication, update *pb.CafeClient) error {
+	client := h.datastore.CafeClients().Get(rep.Client)
+	if rep.Type == pb.CafeReplication_REMOVE_CLIENT {
+		if client == nil {
+			return nil
+		}
+		return h.removeClient(client)
+	}
+	if client == nil {
+		return ErrCafeClientNotFound
+	}
+
+	switch rep.Type {
+	case pb.CafeReplication_CLIENT:
+		if update == nil {
+			return nil
+		}
+		seen, err := ptypes.Timestamp(update.Seen)
+		if err != nil {
+			return err
+		}
+		err = h.datastore.CafeClients().UpdateLastSeen(client.Id, seen)
+		if err != nil {
+			return err
+		}
+		return h.datastore.CafeClients().UpdateQuota(client.Id, update.Quota)
+
+	case pb.CafeReplication_STORE:
+		if rep.Object == nil {
+			return fmt.Errorf("missing object")
+		}
+		id, err := icid.Decode(rep.Target)
+		if err != nil {
+			return err
+		}
+		node, err := ipfs.NodeAtCid(h.service.Node(), id)
+		if err != nil {
+			return err
+		}
+		err = ipfs.PinNode(h.service.Node(), node, true)
+		if err != nil {
+			return err
+		}
+		return h.storeObject(client, rep.Target, rep.Object.Size)
+
+	case pb.CafeReplication_UNSTORE:
+		id, err := icid.Decode(rep.Target)
+		if err != nil {
+			return err
+		}
+		if h.datastore.CafeClientObjects().Get(id.Hash().B58String(), client.Id) == nil {
+			return nil
+		}
+		return h.unstoreObject(client, id)
+
+	case pb.CafeReplication_STORE_THREAD:
+		if rep.Thread == nil {
+			return fmt.Errorf("missing thread")
+		}
+		return h.datastore.CafeClientThreads().AddOrUpdate(&pb.CafeClientThread{
+			Id:         rep.Thread.Id,
+			Client:     client.Id,
+			Ciphertext: rep.Thread.Ciphertext,
+		})
+
+	case pb.CafeReplication_UNSTORE_THREAD:
+		return h.datastore.CafeClientThreads().Delete(rep.Target, client.Id)
+
+	case pb.CafeReplication_MESSAGE:
+		if rep.Message == nil {
+			return fmt.Errorf("missing message")
+		}
+		if rep.Env != nil {
+			_, err := h.storeEnvelope(rep.Env)
+			if err != nil {
+				return err
+			}
+		}
+		return h.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
+			Id:     rep.Message.Id,
+			Peer:   rep.Message.Peer,
+			Client: client.Id,
+			Date:   rep.Message.Date,
+		})
+
+	case pb.CafeReplication_DELETE_MESSAGE:
+		return h.datastore.CafeClientMessages().Delete(rep.Target, client.Id)
+	}
+	return nil
+}
@@ -30,7 +30,9 @@ import (
 	"github.com/textileio/go-textile/repo"
 	"github.com/textileio/go-textile/repo/config"
 	"github.com/textileio/go-textile/repo/db"
+	"github.com/textileio/go-textile/schema"
 	"github.com/textileio/go-textile/service"
+	"github.com/textileio/go-textile/util"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -62,6 +64,7 @@ const (
 	errUnauthorized   = "unauthorized"
 	errForbidden      = "forbidden"
 	errBadRequest     = "bad request"
+	errQuotaExceeded  = "quota exceeded"
 )
 
 // cafeServiceProtocol is the current protocol tag
@@ -69,14 +72,18 @@ const cafeServiceProtocol = protocol.ID("/textile/cafe/1.0.0")
 
 // CafeService is a libp2p pinning and offline message service
 type CafeService struct {
-	service         *service.Service
-	datastore       repo.Datastore
-	inbox           *CafeInbox
-	info            *pb.Cafe
-	online          bool
-	open            bool
-	queryResults    *broadcast.Broadcaster
-	inFlightQueries map[string]struct{}
+	service          *service.Service
+	datastore        repo.Datastore
+	inbox            *CafeInbox
+	info             *pb.Cafe
+	quota            *pb.CafeQuota
+	cluster          []string
+	replicationLock  sync.Mutex
+	online           bool
+	open             bool
+	queryResults     *broadcast.Broadcaster
+	inFlightQueries  map[string]struct{}
+	sendNotification func(*pb.Notification) error
 }
 
 // NewCafeService returns a new threads service
@@ -85,12 +92,14 @@ func NewCafeService(
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
 	inbox *CafeInbox,
+	sendNotification func(*pb.Notification) error,
 ) *CafeService {
 	handler := &CafeService{
-		datastore:       datastore,
-		inbox:           inbox,
-		queryResults:    broadcast.NewBroadcaster(10),
-		inFlightQueries: make(map[string]struct{}),
+		datastore:        datastore,
+		inbox:            inbox,
+		queryResults:     broadcast.NewBroadcaster(10),
+		inFlightQueries:  make(map[string]struct{}),
+		sendNotification: sendNotification,
 	}
 	handler.service = service.NewService(account, handler, node)
 	return handler
@@ -140,12 +149,16 @@ func (h *CafeService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error
 		return h.handleDeleteMessages(env, pid)
 	case pb.Message_CAFE_YOU_HAVE_MAIL:
 		return h.handleNotifyClient(env, pid)
+	case pb.Message_CAFE_CLIENT_EXPIRING:
+		return h.handleClientExpiring(env, pid)
 	case pb.Message_CAFE_PUBLISH_PEER:
 		return h.handlePublishPeer(env, pid)
 	case pb.Message_CAFE_PUBSUB_QUERY:
 		return h.handlePubSubQuery(env, pid)
 	case pb.Message_CAFE_PUBSUB_QUERY_RES:
 		return h.handlePubSubQueryResults(env, pid)
+	case pb.Message_CAFE_REPLICATE:
+		return h.handleReplicate(env, pid)
 	default:
 		return nil, nil
 	}
@@ -383,6 +396,29 @@ func (h *CafeService) notifyClient(peerId string) error {
 	return ipfs.Publish(h.service.Node(), client, payload)
 }
 
+// notifyClientExpiring attempts to warn a client that its stored content is scheduled for deletion
+func (h *CafeService) notifyClientExpiring(peerId string, expires time.Time) error {
+	pexpires, err := ptypes.TimestampProto(expires)
+	if err != nil {
+		return err
+	}
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_CLIENT_EXPIRING, &pb.CafeClientExpiring{
+		Expires: pexpires,
+	}, nil, false)
+	if err != nil {
+		return err
+	}
+	client := string(cafeServiceProtocol) + "/" + peerId
+
+	log.Debugf("sending pubsub %s to %s", env.Message.Type.String(), client)
+
+	payload, err := proto.Marshal(env)
+	if err != nil {
+		return err
+	}
+	return ipfs.Publish(h.service.Node(), client, payload)
+}
+
 // sendCafeRequest sends an authenticated request, retrying once after a session refresh
 func (h *CafeService) sendCafeRequest(cafeId string, envFactory func(*pb.CafeSession) (*pb.Envelope, error)) (*pb.Envelope, error) {
 	session := h.datastore.CafeSessions().Get(cafeId)
@@ -468,8 +504,8 @@ func (h *CafeService) refresh(session *pb.CafeSession) (*pb.CafeSession, error)
 	return refreshed, nil
 }
 
-// sendObject sends data or an object by cid to a cafe peer
-func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) error {
+// sendObject sends data or an object by cid to a cafe peer, returning the usage reported by the cafe
+func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) (*pb.CafeUsage, error) {
 	hash := id.Hash().B58String()
 	obj := &pb.CafeObject{
 		Token: token,
@@ -481,11 +517,11 @@ func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) error
 		if err == iface.ErrIsDir {
 			data, err := ipfs.ObjectAtPath(h.service.Node(), hash)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			obj.Node = data
 		} else {
-			return err
+			return nil, err
 		}
 	} else {
 		obj.Data = data
@@ -494,13 +530,19 @@ func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) error
 	// send over the raw object data
 	env, err := h.service.NewEnvelope(pb.Message_CAFE_OBJECT, obj, nil, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = h.service.SendRequest(cafeId, env)
+	renv, err := h.service.SendRequest(cafeId, env)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+
+	ack := new(pb.CafeStoreAck)
+	err = ptypes.UnmarshalAny(renv.Message.Payload, ack)
+	if err != nil {
+		return nil, err
+	}
+	return ack.Usage, nil
 }
 
 // searchLocal searches the local index based on the given query
@@ -744,6 +786,17 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
 
+	// is the token still accepting registrations?
+	if tokenExpired(encodedToken) {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
+	// registered clients keep the token they were registered with
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client != nil && client.Token != encodedToken.Id {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
 	// check nonce
 	snonce := h.datastore.CafeClientNonces().Get(reg.Value)
 	if snonce == nil {
@@ -768,21 +821,30 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
 
-	now := ptypes.TimestampNow()
-	client := &pb.CafeClient{
-		Id:      pid.Pretty(),
-		Address: reg.Address,
-		Created: now,
-		Seen:    now,
-		Token:   encodedToken.Id,
-	}
-	err = h.datastore.CafeClients().Add(client)
-	if err != nil {
-		// check if already exists
-		client = h.datastore.CafeClients().Get(pid.Pretty())
-		if client == nil {
-			return h.service.NewError(500, "get or create client failed", env.Message.Request)
+	if client == nil {
+		// take a registration from the token before adding the client so
+		// concurrent registrations can't exceed the max
+		ok, err := h.datastore.CafeTokens().AddRegistration(encodedToken.Id)
+		if err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.Request)
+		}
+		if !ok {
+			return h.service.NewError(403, errForbidden, env.Message.Request)
+		}
+
+		now := ptypes.TimestampNow()
+		client = &pb.CafeClient{
+			Id:      pid.Pretty(),
+			Address: reg.Address,
+			Created: now,
+			Seen:    now,
+			Token:   encodedToken.Id,
+		}
+		err = h.datastore.CafeClients().Add(client)
+		if err != nil {
+			return h.service.NewError(500, "create client failed", env.Message.Request)
 		}
+		h.replicate(client.Id, pb.CafeReplication_CLIENT, "")
 	}
 
 	session, err := jwt.NewSession(
@@ -790,11 +852,14 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 		pid,
 		h.Protocol(),
 		defaultSessionDuration,
+		tokenExpiry(encodedToken),
 		h.info,
+		tokenServices(encodedToken),
 	)
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	session.Usage = h.clientUsage(client)
 
 	err = h.datastore.CafeClientNonces().Delete(snonce.Value)
 	if err != nil {
@@ -814,17 +879,13 @@ func (h *CafeService) handleDeregistration(env *pb.Envelope, pid peer.ID) (*pb.E
 
 	// cleanup
 	peerId := pid.Pretty()
-	err = h.datastore.CafeClientThreads().DeleteByClient(peerId)
-	if err != nil {
-		return h.service.NewError(500, "delete client threads failed", env.Message.Request)
-	}
-	err = h.datastore.CafeClientMessages().DeleteByClient(peerId, -1)
-	if err != nil {
-		return h.service.NewError(500, "delete client messages failed", env.Message.Request)
-	}
-	err = h.datastore.CafeClients().Delete(peerId)
-	if err != nil {
-		return h.service.NewError(500, "delete client failed", env.Message.Request)
+	client := h.datastore.CafeClients().Get(peerId)
+	if client != nil {
+		err = h.removeClient(client)
+		if err != nil {
+			return h.service.NewError(500, "delete client failed", env.Message.Request)
+		}
+		h.replicate(client.Id, pb.CafeReplication_REMOVE_CLIENT, "")
 	}
 
 	res := &pb.CafeDeregistrationAck{
@@ -878,21 +939,38 @@ func (h *CafeService) handleRefreshSession(env *pb.Envelope, pid peer.ID) (*pb.E
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
 
-	// get a new session
+	// sessions don't outlive the registration token
 	spid, err := peer.IDB58Decode(accessClaims.Subject)
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	client := h.datastore.CafeClients().Get(spid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+	var until time.Time
+	if client.Token != "" {
+		token := h.datastore.CafeTokens().Get(client.Token)
+		if token == nil || tokenExpired(token) {
+			return h.service.NewError(403, errForbidden, env.Message.Request)
+		}
+		until = tokenExpiry(token)
+	}
+
+	// get a new session
 	session, err := jwt.NewSession(
 		h.service.Node().PrivateKey,
 		spid,
 		h.Protocol(),
 		defaultSessionDuration,
+		until,
 		h.info,
+		accessClaims.Services,
 	)
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	session.Usage = h.clientUsage(client)
 
 	return h.service.NewEnvelope(pb.Message_CAFE_SESSION, session, &env.Message.Request, true)
 }
@@ -905,7 +983,7 @@ func (h *CafeService) handleStore(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, store.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, store.Token, pb.CafeToken_STORE, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -913,17 +991,62 @@ func (h *CafeService) handleStore(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
 	// ignore cids for data already pinned
 	list, err := ipfs.NotPinned(h.service.Node(), store.Cids)
 	if err != nil {
 		return nil, err
 	}
+	needed := make(map[string]struct{})
 	var need []string
 	for _, p := range list {
-		need = append(need, p.Hash().B58String())
+		hash := p.Hash().B58String()
+		needed[hash] = struct{}{}
+		need = append(need, hash)
 	}
 
-	res := &pb.CafeObjectList{Cids: need}
+	// data already pinned counts towards the client's usage as well
+	var objects int32
+	var size int64
+	var pinned []string
+	for _, c := range store.Cids {
+		id, err := icid.Decode(c)
+		if err != nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		hash := id.Hash().B58String()
+		if h.datastore.CafeClientObjects().Get(hash, client.Id) != nil {
+			continue
+		}
+		objects++
+		if _, ok := needed[hash]; !ok {
+			pinned = append(pinned, hash)
+		}
+	}
+	sizes := make(map[string]int64)
+	for _, hash := range pinned {
+		sizes[hash] = h.objectSize(hash)
+		size += sizes[hash]
+	}
+	if !h.withinQuota(client, size, objects, 0) {
+		return h.service.NewError(507, errQuotaExceeded, env.Message.Request)
+	}
+	for _, hash := range pinned {
+		err = h.storeObject(client, hash, sizes[hash])
+		if err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.Request)
+		}
+		h.replicate(client.Id, pb.CafeReplication_STORE, hash)
+	}
+
+	res := &pb.CafeObjectList{
+		Cids:  need,
+		Usage: h.clientUsage(client),
+	}
 	return h.service.NewEnvelope(pb.Message_CAFE_OBJECT_LIST, res, &env.Message.Request, true)
 }
 
@@ -935,7 +1058,7 @@ func (h *CafeService) handleUnstore(env *pb.Envelope, pid peer.ID) (*pb.Envelope
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, unstore.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, unstore.Token, pb.CafeToken_STORE, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -943,6 +1066,11 @@ func (h *CafeService) handleUnstore(env *pb.Envelope, pid peer.ID) (*pb.Envelope
 		return rerr, nil
 	}
 
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
 	// ignore cids for data not pinned
 	list, err := ipfs.Pinned(h.service.Node(), unstore.Cids)
 	if err != nil {
@@ -950,11 +1078,13 @@ func (h *CafeService) handleUnstore(env *pb.Envelope, pid peer.ID) (*pb.Envelope
 	}
 	var unstored []string
 	for _, p := range list {
-		err := ipfs.UnpinCid(h.service.Node(), p, true)
+		err := h.unstoreObject(client, p)
 		if err != nil {
 			return nil, err
 		}
-		unstored = append(unstored, p.Hash().B58String())
+		hash := p.Hash().B58String()
+		h.replicate(client.Id, pb.CafeReplication_UNSTORE, hash)
+		unstored = append(unstored, hash)
 	}
 
 	res := &pb.CafeUnstoreAck{Cids: unstored}
@@ -969,7 +1099,7 @@ func (h *CafeService) handleObject(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, obj.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, obj.Token, pb.CafeToken_STORE, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -977,6 +1107,18 @@ func (h *CafeService) handleObject(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
+	size := int64(len(obj.Data) + len(obj.Node))
+	if h.datastore.CafeClientObjects().Get(obj.Cid, client.Id) == nil {
+		if !h.withinQuota(client, size, 1, 0) {
+			return h.service.NewError(507, errQuotaExceeded, env.Message.Request)
+		}
+	}
+
 	var aid *icid.Cid
 	if obj.Data != nil {
 		aid, err = ipfs.AddData(h.service.Node(), bytes.NewReader(obj.Data), true, false)
@@ -996,7 +1138,16 @@ func (h *CafeService) handleObject(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		log.Warningf("cids do not match (received %s, resolved %s)", obj.Cid, rhash)
 	}
 
-	res := &pb.CafeStoreAck{Id: obj.Cid}
+	err = h.storeObject(client, obj.Cid, size)
+	if err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.Request)
+	}
+	h.replicate(client.Id, pb.CafeReplication_STORE, obj.Cid)
+
+	res := &pb.CafeStoreAck{
+		Id:    obj.Cid,
+		Usage: h.clientUsage(client),
+	}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_ACK, res, &env.Message.Request, true)
 }
 
@@ -1008,7 +1159,7 @@ func (h *CafeService) handleStoreThread(env *pb.Envelope, pid peer.ID) (*pb.Enve
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, store.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, store.Token, pb.CafeToken_THREADS, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,6 +1172,10 @@ func (h *CafeService) handleStoreThread(env *pb.Envelope, pid peer.ID) (*pb.Enve
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
 
+	if !h.storedThread(client, store.Id) && !h.withinQuota(client, 0, 0, 1) {
+		return h.service.NewError(507, errQuotaExceeded, env.Message.Request)
+	}
+
 	thrd := &pb.CafeClientThread{
 		Id:         store.Id,
 		Client:     client.Id,
@@ -1030,8 +1185,12 @@ func (h *CafeService) handleStoreThread(env *pb.Envelope, pid peer.ID) (*pb.Enve
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicate(client.Id, pb.CafeReplication_STORE_THREAD, thrd.Id)
 
-	res := &pb.CafeStoreThreadAck{Id: store.Id}
+	res := &pb.CafeStoreThreadAck{
+		Id:    store.Id,
+		Usage: h.clientUsage(client),
+	}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_THREAD_ACK, res, &env.Message.Request, true)
 }
 
@@ -1043,7 +1202,7 @@ func (h *CafeService) handleUnstoreThread(env *pb.Envelope, pid peer.ID) (*pb.En
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, unstore.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, unstore.Token, pb.CafeToken_THREADS, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -1060,6 +1219,7 @@ func (h *CafeService) handleUnstoreThread(env *pb.Envelope, pid peer.ID) (*pb.En
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicate(client.Id, pb.CafeReplication_UNSTORE_THREAD, unstore.Id)
 
 	res := &pb.CafeUnstoreThreadAck{Id: unstore.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_THREAD_ACK, res, &env.Message.Request, true)
@@ -1078,51 +1238,17 @@ func (h *CafeService) handleDeliverMessage(env *pb.Envelope, pid peer.ID) (*pb.E
 		log.Warningf("received message from %s for unknown client %s", pid.Pretty(), msg.Client)
 		return nil, nil
 	}
+	if !h.clientAllows(client, pb.CafeToken_INBOX) {
+		log.Warningf("received message from %s for client %s without inbox scope", pid.Pretty(), msg.Client)
+		return nil, nil
+	}
 
 	if msg.Env != nil {
-		// pin inner node
-		nenv := new(pb.Envelope)
-		err = proto.Unmarshal(msg.Env, nenv)
-		if err != nil {
-			log.Warningf("error unmarshaling envelope: %s", err)
-			return nil, err
-		}
-		tenv := new(pb.ThreadEnvelope)
-		err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
-		if err != nil {
-			log.Warningf("error unmarshaling payload: %s", err)
-			return nil, err
-		}
-		oid, err := ipfs.AddObject(h.service.Node(), bytes.NewReader(tenv.Node), true)
-		if err != nil {
-			log.Warningf("error adding object: %s", err)
-			return nil, err
-		}
-		node, err := ipfs.NodeAtCid(h.service.Node(), *oid)
-		if err != nil {
-			log.Warningf("error getting node: %s", err)
-			return nil, err
-		}
-		if tenv.Block != nil {
-			_, err = ipfs.AddData(h.service.Node(), bytes.NewReader(tenv.Block), true, false)
-			if err != nil {
-				log.Warningf("error adding block: %s", err)
-				return nil, err
-			}
-		}
-		_, err = extractNode(h.service.Node(), node, tenv.Block == nil)
-		if err != nil {
-			log.Warningf("error extracting node: %s", err)
-			return nil, err
-		}
-
-		// pin envelope
-		id, err := ipfs.AddData(h.service.Node(), bytes.NewReader(msg.Env), true, false)
+		msg.Id, err = h.storeEnvelope(msg.Env)
 		if err != nil {
-			log.Warningf("error pinning envelope: %s", err)
+			log.Warningf("error storing envelope: %s", err)
 			return nil, err
 		}
-		msg.Id = id.Hash().B58String()
 	}
 
 	err = h.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
@@ -1136,6 +1262,7 @@ func (h *CafeService) handleDeliverMessage(env *pb.Envelope, pid peer.ID) (*pb.E
 		return nil, nil
 	}
 	log.Debugf("added message for %s: %s", client.Id, msg.Id)
+	h.replicate(client.Id, pb.CafeReplication_MESSAGE, msg.Id)
 
 	go func() {
 		err = h.notifyClient(client.Id)
@@ -1146,6 +1273,48 @@ func (h *CafeService) handleDeliverMessage(env *pb.Envelope, pid peer.ID) (*pb.E
 	return nil, nil
 }
 
+// storeEnvelope pins an inbox message envelope along with the thread node it carries,
+// returning the envelope id
+func (h *CafeService) storeEnvelope(env []byte) (string, error) {
+	nenv := new(pb.Envelope)
+	err := proto.Unmarshal(env, nenv)
+	if err != nil {
+		return "", err
+	}
+	tenv := new(pb.ThreadEnvelope)
+	err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
+	if err != nil {
+		return "", err
+	}
+
+	// pin inner node
+	oid, err := ipfs.AddObject(h.service.Node(), bytes.NewReader(tenv.Node), true)
+	if err != nil {
+		return "", err
+	}
+	node, err := ipfs.NodeAtCid(h.service.Node(), *oid)
+	if err != nil {
+		return "", err
+	}
+	if tenv.Block != nil {
+		_, err = ipfs.AddData(h.service.Node(), bytes.NewReader(tenv.Block), true, false)
+		if err != nil {
+			return "", err
+		}
+	}
+	_, err = extractNode(h.service.Node(), node, tenv.Block == nil)
+	if err != nil {
+		return "", err
+	}
+
+	// pin envelope
+	id, err := ipfs.AddData(h.service.Node(), bytes.NewReader(env), true, false)
+	if err != nil {
+		return "", err
+	}
+	return id.Hash().B58String(), nil
+}
+
 // handleCheckMessages receives a check inbox messages request
 func (h *CafeService) handleCheckMessages(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
 	check := new(pb.CafeCheckMessages)
@@ -1154,7 +1323,7 @@ func (h *CafeService) handleCheckMessages(env *pb.Envelope, pid peer.ID) (*pb.En
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, check.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, check.Token, pb.CafeToken_INBOX, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,6 +1340,7 @@ func (h *CafeService) handleCheckMessages(env *pb.Envelope, pid peer.ID) (*pb.En
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicate(client.Id, pb.CafeReplication_CLIENT, "")
 
 	res := &pb.CafeMessages{
 		Messages: make([]*pb.CafeMessage, 0),
@@ -1195,7 +1365,7 @@ func (h *CafeService) handleDeleteMessages(env *pb.Envelope, pid peer.ID) (*pb.E
 		return nil, err
 	}
 
-	rerr, err := h.authToken(pid, del.Token, false, env.Message.Request)
+	rerr, err := h.authScope(pid, del.Token, pb.CafeToken_INBOX, env.Message.Request)
 	if err != nil {
 		return nil, err
 	}
@@ -1209,10 +1379,14 @@ func (h *CafeService) handleDeleteMessages(env *pb.Envelope, pid peer.ID) (*pb.E
 	}
 
 	// delete the most recent page
+	msgs := h.datastore.CafeClientMessages().ListByClient(client.Id, inboxMessagePageSize)
 	err = h.datastore.CafeClientMessages().DeleteByClient(client.Id, inboxMessagePageSize)
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	for _, msg := range msgs {
+		h.replicate(client.Id, pb.CafeReplication_DELETE_MESSAGE, msg.Id)
+	}
 
 	// check for more
 	remaining := h.datastore.CafeClientMessages().CountByClient(client.Id)
@@ -1237,6 +1411,40 @@ func (h *CafeService) handleNotifyClient(env *pb.Envelope, pid peer.ID) (*pb.Env
 	return nil, nil
 }
 
+// handleClientExpiring receives a message informing this peer that a cafe
+// has scheduled its stored content for deletion
+func (h *CafeService) handleClientExpiring(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	session := h.datastore.CafeSessions().Get(pid.Pretty())
+	if session == nil {
+		log.Warningf("received message from unknown cafe %s", pid.Pretty())
+		return nil, nil
+	}
+
+	exp := new(pb.CafeClientExpiring)
+	err := ptypes.UnmarshalAny(env.Message.Payload, exp)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := ptypes.Timestamp(exp.Expires)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.sendNotification(&pb.Notification{
+		Id:      ksuid.New().String(),
+		Date:    ptypes.TimestampNow(),
+		Actor:   pid.Pretty(),
+		Subject: pid.Pretty(),
+		Type:    pb.Notification_CAFE_CLIENT_EXPIRING,
+		Body:    "will delete your stored content on " + expires.Format(time.RFC1123) + " unless you reconnect",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 // handlePublishPeer indexes a client's peer info for others to search
 func (h *CafeService) handlePublishPeer(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
 	pub := new(pb.CafePublishPeer)
@@ -1390,21 +1598,218 @@ func (h *CafeService) handlePubSubQueryResults(env *pb.Envelope, pid peer.ID) (*
 
 // authToken verifies a request token from a peer
 func (h *CafeService) authToken(pid peer.ID, token string, refreshing bool, requestId int32) (*pb.Envelope, error) {
+	_, rerr, err := h.validateToken(pid, token, refreshing, requestId)
+	return rerr, err
+}
+
+// authScope verifies an access token from a peer and that its session includes a scope
+func (h *CafeService) authScope(pid peer.ID, token string, scope pb.CafeToken_Scope, requestId int32) (*pb.Envelope, error) {
+	claims, rerr, err := h.validateToken(pid, token, false, requestId)
+	if rerr != nil || err != nil {
+		return rerr, err
+	}
+	if claims != nil && !claims.Allows(scope.String()) {
+		return h.service.NewError(403, errForbidden, requestId)
+	}
+	return nil, nil
+}
+
+// validateToken returns the claims of a valid request token from a peer
+func (h *CafeService) validateToken(pid peer.ID, token string, refreshing bool, requestId int32) (*jwt.TextileClaims, *pb.Envelope, error) {
 	subject := pid.Pretty()
-	_, err := jwt.Validate(token, h.verifyKeyFunc, refreshing, string(h.Protocol()), &subject)
+	claims, err := jwt.Validate(token, h.verifyKeyFunc, refreshing, string(h.Protocol()), &subject)
 	if err != nil {
 		switch err {
 		case jwt.ErrNoToken, jwt.ErrExpired:
-			return h.service.NewError(401, errUnauthorized, requestId)
+			rerr, err := h.service.NewError(401, errUnauthorized, requestId)
+			return nil, rerr, err
 		case jwt.ErrInvalid:
-			return h.service.NewError(403, errForbidden, requestId)
+			rerr, err := h.service.NewError(403, errForbidden, requestId)
+			return nil, rerr, err
 		}
 	}
-	return nil, nil
+	if claims != nil && h.revoked(claims) {
+		rerr, err := h.service.NewError(403, errForbidden, requestId)
+		return nil, rerr, err
+	}
+	return claims, nil, nil
+}
+
+// clientAllows returns whether or not the token a client registered with allows a scope.
+// Nothing is allowed if the token is gone. Clients registered before tokens were
+// recorded are not restricted.
+func (h *CafeService) clientAllows(client *pb.CafeClient, scope pb.CafeToken_Scope) bool {
+	if client.Token == "" {
+		return true
+	}
+	token := h.datastore.CafeTokens().Get(client.Token)
+	if token == nil {
+		return false
+	}
+	if len(token.Scopes) == 0 {
+		return true
+	}
+	for _, s := range token.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenExpiry returns when a token expires, zero if never
+func tokenExpiry(token *pb.CafeToken) time.Time {
+	if token.Expires == nil {
+		return time.Time{}
+	}
+	return util.ProtoTime(token.Expires)
+}
+
+// tokenExpired returns whether or not a token has expired
+func tokenExpired(token *pb.CafeToken) bool {
+	return token.Expires != nil && util.ProtoNanos(token.Expires) <= time.Now().UnixNano()
+}
+
+// tokenServices returns the cafe services a token allows as session claims, all if empty
+func tokenServices(token *pb.CafeToken) []string {
+	var services []string
+	for _, scope := range token.Scopes {
+		services = append(services, scope.String())
+	}
+	return services
+}
+
+// revoked returns whether or not a session was issued to a client that has since
+// been revoked, i.e., the client is gone or was registered again after the session
+func (h *CafeService) revoked(claims *jwt.TextileClaims) bool {
+	client := h.datastore.CafeClients().Get(claims.Subject)
+	if client == nil {
+		return true
+	}
+	return claims.IssuedAt < client.Created.GetSeconds()
+}
+
+// removeClient removes a client along with everything it stores, unpinning
+// objects that are not stored by another client
+func (h *CafeService) removeClient(client *pb.CafeClient) error {
+	for _, obj := range h.datastore.CafeClientObjects().ListByClient(client.Id) {
+		id, err := icid.Decode(obj.Id)
+		if err != nil {
+			return err
+		}
+		err = h.unstoreObject(client, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := h.datastore.CafeClientThreads().DeleteByClient(client.Id)
+	if err != nil {
+		return err
+	}
+	h.unpinMessages(client)
+	err = h.datastore.CafeClientMessages().DeleteByClient(client.Id, -1)
+	if err != nil {
+		return err
+	}
+	err = h.datastore.CafeClients().Delete(client.Id)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("removed cafe client %s", client.Id)
+
+	return nil
+}
+
+// unpinMessages unpins the envelopes of a client's inbox messages along with the thread
+// nodes and blocks pinned by storeEnvelope, unless other clients' messages still use them.
+// Objects also stored by a client are pinned recursively and are not affected.
+func (h *CafeService) unpinMessages(client *pb.CafeClient) {
+	msgs := h.datastore.CafeClientMessages().ListByClient(client.Id, -1)
+	if len(msgs) == 0 {
+		return
+	}
+
+	keep := make(map[string]struct{})
+	for _, other := range h.datastore.CafeClients().List() {
+		if other.Id == client.Id {
+			continue
+		}
+		for _, msg := range h.datastore.CafeClientMessages().ListByClient(other.Id, -1) {
+			for _, id := range h.envelopePins(msg.Id) {
+				keep[id.String()] = struct{}{}
+			}
+		}
+	}
+
+	for _, msg := range msgs {
+		for _, id := range h.envelopePins(msg.Id) {
+			if _, ok := keep[id.String()]; ok {
+				continue
+			}
+			err := ipfs.UnpinCid(h.service.Node(), id, false)
+			if err != nil {
+				log.Debugf("unable to unpin %s: %s", id.String(), err)
+			}
+		}
+	}
+}
+
+// envelopePins returns the cids pinned by storeEnvelope for a stored envelope
+func (h *CafeService) envelopePins(id string) []icid.Cid {
+	eid, err := icid.Decode(id)
+	if err != nil {
+		return nil
+	}
+	pins := []icid.Cid{eid}
+
+	data, err := ipfs.DataAtPath(h.service.Node(), id)
+	if err != nil {
+		return pins
+	}
+	nenv := new(pb.Envelope)
+	err = proto.Unmarshal(data, nenv)
+	if err != nil {
+		return pins
+	}
+	tenv := new(pb.ThreadEnvelope)
+	err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
+	if err != nil {
+		return pins
+	}
+
+	// the inner node is already local, adding it again just returns its cid
+	oid, err := ipfs.AddObject(h.service.Node(), bytes.NewReader(tenv.Node), false)
+	if err != nil {
+		return pins
+	}
+	pins = append(pins, *oid)
+	node, err := ipfs.NodeAtCid(h.service.Node(), *oid)
+	if err != nil {
+		return pins
+	}
+	links := node.Links()
+	if plink := schema.LinkByName(links, []string{parentsLinkName}); plink != nil {
+		pins = append(pins, plink.Cid)
+	}
+	if blink := schema.LinkByName(links, []string{blockLinkName}); blink != nil && tenv.Block != nil {
+		pins = append(pins, blink.Cid)
+	}
+	return pins
 }
 
-// verifyKeyFunc returns the correct key for token verification
+// verifyKeyFunc returns the correct key for token verification, which is the key of
+// the cluster peer that issued the token, if any, so that sessions work across the cluster
 func (h *CafeService) verifyKeyFunc(token *njwt.Token) (interface{}, error) {
+	claims, err := jwt.ParseClaims(token.Claims)
+	if err == nil && h.inCluster(claims.Issuer) {
+		pid, err := peer.IDB58Decode(claims.Issuer)
+		if err != nil {
+			return nil, err
+		}
+		return pid.ExtractPublicKey()
+	}
 	return h.service.Node().PrivateKey.GetPublic(), nil
 }
 
@@ -1466,6 +1871,8 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 
 	// process each cafe group concurrently
 	var toComplete, toFail, toUnpin []string
+	toReject := make(map[string][]string)
+	var lock sync.Mutex
 	wg := sync.WaitGroup{}
 	for cafeId, group := range groups {
 		wg.Add(1)
@@ -1480,15 +1887,20 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 				if err != nil {
 					log.Warningf("error handling requests of type %s: %s", t.String(), err)
 				}
+				lock.Lock()
 				for _, id := range handled {
 					toComplete = append(toComplete, id)
 					if t == pb.CafeRequest_INBOX {
 						toUnpin = append(toUnpin, id)
 					}
 				}
-				for _, id := range failed {
-					toFail = append(toFail, id)
+				if quotaExceeded(err) {
+					// retrying won't help until the quota changes
+					toReject[cafeId] = append(toReject[cafeId], failed...)
+				} else {
+					toFail = append(toFail, failed...)
 				}
+				lock.Unlock()
 			}
 			wg.Done()
 		}(cafeId, group)
@@ -1541,6 +1953,17 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 		}
 	}
 
+	for cafeId, ids := range toReject {
+		for _, id := range ids {
+			err = h.datastore.CafeRequests().Delete(id)
+			if err != nil {
+				log.Error(err.Error())
+				return
+			}
+		}
+		h.notifyQuotaExceeded(cafeId, len(ids))
+	}
+
 	var completed []string
 	for _, id := range toComplete {
 		err = h.datastore.CafeRequests().UpdateStatus(id, pb.CafeRequest_COMPLETE)
@@ -1555,6 +1978,28 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 	h.batchRequests(next)
 }
 
+// notifyQuotaExceeded lets the user know that a cafe rejected requests over their storage quota
+func (h *CafeService) notifyQuotaExceeded(cafeId string, rejected int) {
+	log.Warningf("cafe %s rejected %d requests: %s", cafeId, rejected, errQuotaExceeded)
+
+	err := h.sendNotification(&pb.Notification{
+		Id:      ksuid.New().String(),
+		Date:    ptypes.TimestampNow(),
+		Actor:   cafeId,
+		Subject: cafeId,
+		Type:    pb.Notification_CAFE_QUOTA_EXCEEDED,
+		Body:    fmt.Sprintf("rejected %d requests, storage quota exceeded", rejected),
+	})
+	if err != nil {
+		log.Errorf("error adding notification: %s", err)
+	}
+}
+
+// quotaExceeded returns whether or not a cafe request failed because it would exceed a quota
+func quotaExceeded(err error) bool {
+	return err != nil && err.Error() == errQuotaExceeded
+}
+
 // handleRequest handles a group of requests for a single cafe
 func (h *CafeService) handleRequests(reqs []*pb.CafeRequest, rtype pb.CafeRequest_Type, cafeId string) ([]string, []string, error) {
 	var handled, failed []string
@@ -1614,6 +2059,7 @@ func (h *CafeService) handleRequests(reqs []*pb.CafeRequest, rtype pb.CafeReques
 				handled = append(handled, req.Id)
 				continue
 			}
+			thrd.Keys = h.datastore.ThreadKeys().ListByThread(thrd.Id).Items
 
 			err := h.storeThread(thrd, cafeId)
 			if err != nil {
@@ -1679,6 +2125,7 @@ func (h *CafeService) store(cids []string, cafeId string) ([]string, error) {
 	if err != nil {
 		return stored, err
 	}
+	h.updateUsage(cafeId, req.Usage)
 	if len(req.Cids) == 0 {
 		log.Debugf("peer %s requested zero objects", cafeId)
 		return cids, nil
@@ -1703,10 +2150,11 @@ loop:
 		if err != nil {
 			return stored, err
 		}
-		err = h.sendObject(decoded, cafeId, accessToken)
+		usage, err := h.sendObject(decoded, cafeId, accessToken)
 		if err != nil {
 			return stored, err
 		}
+		h.updateUsage(cafeId, usage)
 		stored = append(stored, id)
 	}
 	return stored, nil
@@ -1743,7 +2191,7 @@ func (h *CafeService) storeThread(thrd *pb.Thread, cafeId string) error {
 		return err
 	}
 
-	_, err = h.sendCafeRequest(cafeId, func(session *pb.CafeSession) (*pb.Envelope, error) {
+	renv, err := h.sendCafeRequest(cafeId, func(session *pb.CafeSession) (*pb.Envelope, error) {
 		return h.service.NewEnvelope(pb.Message_CAFE_STORE_THREAD, &pb.CafeStoreThread{
 			Token:      session.Access,
 			Id:         thrd.Id,
@@ -1753,6 +2201,13 @@ func (h *CafeService) storeThread(thrd *pb.Thread, cafeId string) error {
 	if err != nil {
 		return err
 	}
+
+	ack := new(pb.CafeStoreThreadAck)
+	err = ptypes.UnmarshalAny(renv.Message.Payload, ack)
+	if err != nil {
+		return err
+	}
+	h.updateUsage(cafeId, ack.Usage)
 	return nil
 }
 
@@ -114,7 +114,7 @@ func (t *Textile) CafeSessions() *pb.CafeSessionList {
 // cafeRequestThreadContent sync the entire thread conents (blocks and files) to the given cafe
 func (t *Textile) cafeRequestThreadsContent(cafe string) error {
 	for _, thrd := range t.loadedThreads {
-		blocks := t.Blocks("", -1, fmt.Sprintf("threadId='%s'", thrd.Id))
+		blocks := t.Blocks("", -1, fmt.Sprintf("threadId='%s'", thrd.Id), pb.Block_DATE)
 		for _, b := range blocks.Items {
 
 			// store the block itself
@@ -55,7 +55,7 @@ func TestCore_SetupCafes(t *testing.T) {
 
 func TestTextile_CafeTokens(t *testing.T) {
 	var err error
-	cafeVars.token, err = cafeVars.cafe.CreateCafeToken("", true)
+	cafeVars.token, err = cafeVars.cafe.CreateCafeToken("", true, CafeTokenConfig{})
 	if err != nil {
 		t.Fatalf("error creating cafe token: %s", err)
 	}
@@ -92,7 +92,7 @@ func TestTextile_RemoveCafeToken(t *testing.T) {
 }
 
 func TestCore_RegisterCafe(t *testing.T) {
-	token, err := cafeVars.cafe.CreateCafeToken("", true)
+	token, err := cafeVars.cafe.CreateCafeToken("", true, CafeTokenConfig{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -135,7 +135,7 @@ func TestCore_HandleCafeRequests(t *testing.T) {
 	// check if blocks are pinned
 	var blocks []string
 	var datas []string
-	list := n.Blocks("", -1, "")
+	list := n.Blocks("", -1, "", pb.Block_DATE)
 	for _, b := range list.Items {
 		blocks = append(blocks, b.Id)
 		if b.Type == pb.Block_FILES {
@@ -212,7 +212,7 @@ func addTestData(n *Textile) error {
 	if err != nil {
 		return err
 	}
-	_, err = thrd.AddMessage("", "hi")
+	_, err = thrd.AddMessage("", "hi", 0)
 	if err != nil {
 		return err
 	}
@@ -232,7 +232,7 @@ func addTestData(n *Textile) error {
 	if err != nil {
 		return err
 	}
-	_, err = thrd.AddMessage("", "bye")
+	_, err = thrd.AddMessage("", "bye", 0)
 	if err != nil {
 		return err
 	}
@@ -108,6 +108,8 @@ type Textile struct {
 	cafeInbox         *CafeInbox
 	cancelSync        *broadcast.Broadcaster
 	lock              sync.Mutex
+	cafeGCLock        sync.Mutex
+	mills             map[string]config.Mill
 	writer            io.Writer
 }
 
@@ -231,6 +233,10 @@ func NewTextile(conf RunConfig) (*Textile, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = node.loadMills()
+	if err != nil {
+		return nil, err
+	}
 
 	logLevel := &pb.LogLevel{
 		Systems: make(map[string]pb.LogLevel_Level),
@@ -341,7 +347,8 @@ func (t *Textile) Start() error {
 		t.account,
 		t.Ipfs,
 		t.datastore,
-		t.cafeInbox)
+		t.cafeInbox,
+		t.sendNotification)
 
 	if t.cafeOutbox.handler == nil {
 		t.cafeOutbox.handler = t.cafe
@@ -375,7 +382,12 @@ func (t *Textile) Start() error {
 		if t.config.Cafe.Host.Open {
 			go func() {
 				t.cafe.setAddrs(t.config)
+				t.cafe.quota = cafeQuota(t.config.Cafe.Host.Quota)
+				t.cafe.cluster = t.config.Cafe.Host.Cluster
 				t.cafe.open = true
+				if t.config.Cafe.Host.GC.ClientTTL > 0 {
+					go t.runCafeGC()
+				}
 				t.startCafeApi(t.config.Addresses.CafeAPI)
 			}()
 		}
@@ -622,7 +634,7 @@ func (t *Textile) SetLogLevel(level *pb.LogLevel, color bool) error {
 // FlushBlocks flushes the block message outbox
 func (t *Textile) FlushBlocks() {
 	query := fmt.Sprintf("status=%d", pb.Block_QUEUED)
-	queued := t.datastore.Blocks().List("", -1, query)
+	queued := t.datastore.Blocks().List("", -1, query, pb.Block_DATE)
 	sort.SliceStable(queued.Items, func(i, j int) bool {
 		return util.ProtoTime(queued.Items[i].Date).Before(
 			util.ProtoTime(queued.Items[j].Date))
@@ -789,6 +801,7 @@ func (t *Textile) runJobs() {
 	tick := time.NewTicker(freq)
 	defer tick.Stop()
 
+	t.purgeThreads()
 	go t.flushQueues()
 	t.maybeSyncAccount()
 
@@ -801,7 +814,9 @@ func (t *Textile) runJobs() {
 	for {
 		select {
 		case <-tick.C:
+			t.purgeThreads()
 			go t.flushQueues()
+			go t.cafe.flushReplications()
 			t.maybeSyncAccount()
 
 		case <-t.done:
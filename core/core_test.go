@@ -7,7 +7,9 @@ import (
 
 	"github.com/textileio/go-textile/util"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/crypto"
 	"github.com/textileio/go-textile/keypair"
 	"github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
@@ -201,6 +203,175 @@ func TestTextile_AddFile(t *testing.T) {
 	}
 }
 
+func TestTextile_VerifyThread(t *testing.T) {
+	// unreachable indexes which aren't queued or pending are orphans
+	orphans := map[pb.Block_BlockStatus]string{
+		pb.Block_READY:   ksuid.New().String(),
+		pb.Block_QUEUED:  ksuid.New().String(),
+		pb.Block_PENDING: ksuid.New().String(),
+	}
+	for status, id := range orphans {
+		err := vars.node.datastore.Blocks().Add(&pb.Block{
+			Id:     id,
+			Thread: vars.thread.Id,
+			Author: vars.node.node.Identity.Pretty(),
+			Type:   pb.Block_TEXT,
+			Date:   ptypes.TimestampNow(),
+			Status: status,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := vars.node.VerifyThread(vars.thread.Id, true)
+	if err != nil {
+		t.Fatalf("verify thread failed: %s", err)
+	}
+	if len(res.Orphaned) != 1 || res.Orphaned[0] != orphans[pb.Block_READY] {
+		t.Fatalf("wrong orphans: %v", res.Orphaned)
+	}
+	if vars.node.datastore.Blocks().Get(orphans[pb.Block_READY]) != nil {
+		t.Fatal("ready orphan should have been removed")
+	}
+	for _, status := range []pb.Block_BlockStatus{pb.Block_QUEUED, pb.Block_PENDING} {
+		if vars.node.datastore.Blocks().Get(orphans[status]) == nil {
+			t.Fatalf("%s block should not have been removed", status)
+		}
+		_ = vars.node.datastore.Blocks().Delete(orphans[status])
+	}
+}
+
+func TestTextile_ThreadPermissions(t *testing.T) {
+	addr := keypair.Random().Address()
+
+	// roles extend the access granted by the thread type
+	_, err := vars.node.UpdateThreadMember(vars.thread.Id, addr, pb.ThreadMembership_GRANT, pb.ThreadMember_READER)
+	if err != nil {
+		t.Fatalf("grant failed: %s", err)
+	}
+	if vars.thread.role(addr) != pb.ThreadMember_WRITER {
+		t.Fatal("reader role should not demote an open thread member")
+	}
+
+	hash, err := vars.node.UpdateThreadMember(vars.thread.Id, addr, pb.ThreadMembership_REMOVE, pb.ThreadMember_DEFAULT)
+	if err != nil {
+		t.Fatalf("remove failed: %s", err)
+	}
+	removed := vars.node.datastore.Blocks().Get(hash.B58String())
+	if removed == nil {
+		t.Fatal("remove block not indexed")
+	}
+	if vars.thread.writable(addr) {
+		t.Fatal("removed member should not be able to write")
+	}
+
+	// blocks are authorized at their position in thread history
+	before := &pb.ThreadBlockHeader{Address: addr, Clock: removed.Clock - 1, Date: removed.Date}
+	if !vars.thread.permits(before, pb.ThreadMember_WRITER) {
+		t.Fatal("blocks from before a removal should be permitted")
+	}
+	after := &pb.ThreadBlockHeader{Address: addr, Clock: removed.Clock + 1, Date: removed.Date}
+	if vars.thread.permits(after, pb.ThreadMember_WRITER) {
+		t.Fatal("blocks from after a removal should not be permitted")
+	}
+
+	// a change handled late still applies to the blocks that follow it
+	admin := keypair.Random().Address()
+	header := &pb.ThreadBlockHeader{Address: admin, Clock: removed.Clock + 2, Date: ptypes.TimestampNow()}
+	if vars.thread.permits(header, pb.ThreadMember_ADMIN) {
+		t.Fatal("blocks from a non-admin should not be permitted")
+	}
+	err = vars.thread.applyMembership(&pb.ThreadMembership{
+		Address: admin,
+		Action:  pb.ThreadMembership_GRANT,
+		Role:    pb.ThreadMember_ADMIN,
+	}, removed.Clock+1, removed.Date, ksuid.New().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !vars.thread.permits(header, pb.ThreadMember_ADMIN) {
+		t.Fatal("blocks from a granted admin should be permitted")
+	}
+	if !vars.thread.admin(admin) {
+		t.Fatal("granted admin should be an admin")
+	}
+}
+
+func TestTextile_ThreadPermissionsNoAddress(t *testing.T) {
+	if vars.thread.permits(&pb.ThreadBlockHeader{}, pb.ThreadMember_ADMIN) {
+		t.Fatal("blocks without an address should not have admin rights")
+	}
+
+	payload, err := ptypes.MarshalAny(&pb.ThreadMembership{
+		Address: keypair.Random().Address(),
+		Action:  pb.ThreadMembership_GRANT,
+		Role:    pb.ThreadMember_ADMIN,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.ThreadBlock{
+		Header: &pb.ThreadBlockHeader{
+			Date:   ptypes.TimestampNow(),
+			Author: ksuid.New().String(),
+		},
+		Type:    pb.Block_MEMBER,
+		Payload: payload,
+	}
+	_, err = vars.thread.handleMemberBlock(&blockNode{hash: ksuid.New().String()}, block)
+	if err != ErrNotAdmin {
+		t.Fatalf("expected member block without an address to be rejected, got %v", err)
+	}
+}
+
+func TestTextile_ThreadKeyEpoch(t *testing.T) {
+	_, err := vars.node.RotateThreadKey(vars.thread.Id)
+	if err != nil {
+		t.Fatalf("rotate key failed: %s", err)
+	}
+	epoch := vars.thread.epoch()
+	if epoch == 0 {
+		t.Fatal("rotation should start a new epoch")
+	}
+
+	ciphertext, err := vars.thread.Encrypt([]byte("hi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, _ := splitEpoch(ciphertext); e != epoch {
+		t.Fatalf("ciphertext epoch %d, expected %d", e, epoch)
+	}
+	plaintext, err := vars.thread.Decrypt(ciphertext)
+	if err != nil || string(plaintext) != "hi" {
+		t.Fatalf("decrypt failed: %s", err)
+	}
+
+	// blocks from before any rotation aren't prefixed
+	ciphertext, err = crypto.Encrypt(vars.thread.PrivKey.GetPublic(), []byte("hi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err = vars.thread.Decrypt(ciphertext)
+	if err != nil || string(plaintext) != "hi" {
+		t.Fatalf("decrypt with initial key failed: %s", err)
+	}
+}
+
+func TestTextile_ThreadKeyRotator(t *testing.T) {
+	if vars.thread.rotator(map[string]struct{}{}) != vars.thread.initiator {
+		t.Fatal("initiator should re-key when no admins remain")
+	}
+	outsider := keypair.Random().Address()
+	if vars.thread.rotator(map[string]struct{}{outsider: {}}) != vars.thread.initiator {
+		t.Fatal("non-admins should never re-key")
+	}
+	self := vars.node.account.Address()
+	if vars.thread.rotator(map[string]struct{}{self: {}, outsider: {}}) != self {
+		t.Fatal("remaining admin should re-key")
+	}
+}
+
 func TestTextile_Stop(t *testing.T) {
 	err := vars.node.Stop()
 	if err != nil {
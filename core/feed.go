@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -37,6 +38,7 @@ type feedItemOpts struct {
 	annotations bool
 	comments    []*pb.Comment
 	likes       []*pb.Like
+	replies     []*pb.Text
 	target      *pb.FeedItem
 }
 
@@ -57,6 +59,10 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 		}
 	}
 	query = "(" + query + ")"
+	if req.Mode == pb.FeedRequest_ANNOTATED {
+		// replies are nested in their parent
+		query += fmt.Sprintf(" and not (type=%d and target!='')", pb.Block_TEXT)
+	}
 	if req.Thread != "" {
 		if t.Thread(req.Thread) == nil {
 			return nil, ErrThreadNotFound
@@ -64,7 +70,7 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 		query = fmt.Sprintf("(threadId='%s') and %s", req.Thread, query)
 	}
 
-	blocks := t.Blocks(req.Offset, int(req.Limit), query)
+	blocks := t.Blocks(req.Offset, int(req.Limit), query, req.Sort)
 	list := make([]*pb.FeedItem, 0)
 	var count int
 
@@ -119,7 +125,7 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 		nextOffset = blocks.Items[len(blocks.Items)-1].Id
 
 		// see if there's actually more
-		if len(t.datastore.Blocks().List(nextOffset, 1, query).Items) == 0 {
+		if len(t.datastore.Blocks().List(nextOffset, 1, query, req.Sort).Items) == 0 {
 			nextOffset = ""
 		}
 	}
@@ -167,6 +173,10 @@ func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, er
 		payload, err = t.comment(block, opts)
 	case pb.Block_LIKE:
 		payload, err = t.like(block, opts)
+	case pb.Block_EDIT:
+		payload, err = t.edit(block, opts)
+	case pb.Block_REACTION:
+		payload, err = t.reaction(block, opts)
 	default:
 		return nil, nil
 	}
@@ -186,12 +196,18 @@ func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, er
 func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 	var comments []*pb.Comment
 	var likes []*pb.Like
+	var replies []*pb.Text
+	var replyBlocks []*pb.Block
 
 	// Does the stack contain the initial target,
 	// or is it a continuation stack of just annotations?
 	// We'll need to load the target in the latter case.
 	var target *pb.Block
 	handleChild := func(child *pb.Block) error {
+		if isReply(child) {
+			replyBlocks = append(replyBlocks, child)
+			return nil
+		}
 		switch child.Type {
 		case pb.Block_COMMENT:
 			comment, err := t.comment(child, feedItemOpts{annotations: true})
@@ -235,9 +251,22 @@ func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 		}
 	}
 
+	// replies read oldest first, in the same order for all members
+	sort.SliceStable(replyBlocks, func(i, j int) bool {
+		return causallyNewer(replyBlocks[j], replyBlocks[i])
+	})
+	for _, block := range replyBlocks {
+		reply, err := t.message(block, feedItemOpts{annotations: true})
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+
 	targetItem, err := t.feedItem(target, feedItemOpts{
 		comments: comments,
 		likes:    likes,
+		replies:  replies,
 	})
 	if err != nil {
 		return nil, err
@@ -255,7 +284,7 @@ func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 
 func (t *Textile) blockIgnored(blockId string) bool {
 	query := fmt.Sprintf("target='%s' and type=%d", blockId, pb.Block_IGNORE)
-	return len(t.datastore.Blocks().List("", -1, query).Items) > 0
+	return len(t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items) > 0
 }
 
 func FeedItemType(item *pb.FeedItem) (pb.Block_BlockType, error) {
@@ -302,6 +331,10 @@ func GetFeedItemPayload(item *pb.FeedItem) (FeedItemPayload, error) {
 		payload = new(pb.Comment)
 	case pb.Block_LIKE:
 		payload = new(pb.Like)
+	case pb.Block_EDIT:
+		payload = new(pb.Edit)
+	case pb.Block_REACTION:
+		payload = new(pb.Reaction)
 	default:
 		return nil, fmt.Errorf("unable to parse payload")
 	}
@@ -313,6 +346,9 @@ func GetFeedItemPayload(item *pb.FeedItem) (FeedItemPayload, error) {
 }
 
 func getTargetId(block *pb.Block) string {
+	if isReply(block) {
+		return block.Target
+	}
 	switch block.Type {
 	case pb.Block_COMMENT, pb.Block_LIKE:
 		return block.Target
@@ -322,6 +358,9 @@ func getTargetId(block *pb.Block) string {
 }
 
 func isAnnotation(block *pb.Block) bool {
+	if isReply(block) {
+		return true
+	}
 	switch block.Type {
 	case pb.Block_COMMENT, pb.Block_LIKE:
 		return true
@@ -10,7 +10,7 @@ func (t *Textile) Comments(target string) (*pb.CommentList, error) {
 	comments := make([]*pb.Comment, 0)
 
 	query := fmt.Sprintf("type=%d and target='%s'", pb.Block_COMMENT, target)
-	for _, block := range t.Blocks("", -1, query).Items {
+	for _, block := range t.Blocks("", -1, query, pb.Block_DATE).Items {
 		info, err := t.comment(block, feedItemOpts{annotations: true})
 		if err != nil {
 			continue
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func (t *Textile) Edits(target string) (*pb.EditList, error) {
+	block, err := t.Block(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EditList{Items: t.revisions(block)}, nil
+}
+
+func (t *Textile) Edit(blockId string) (*pb.Edit, error) {
+	block, err := t.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.edit(block, feedItemOpts{annotations: true})
+}
+
+func (t *Textile) edit(block *pb.Block, opts feedItemOpts) (*pb.Edit, error) {
+	if block.Type != pb.Block_EDIT {
+		return nil, ErrBlockWrongType
+	}
+
+	item := &pb.Edit{
+		Id:   block.Id,
+		Date: block.Date,
+		User: t.PeerUser(block.Author),
+		Body: block.Body,
+		Data: block.Data,
+	}
+
+	if opts.target != nil {
+		item.Target = opts.target
+	} else if !opts.annotations {
+		target, err := t.feedItem(t.datastore.Blocks().Get(block.Target), feedItemOpts{})
+		if err != nil {
+			return nil, err
+		}
+		item.Target = target
+	}
+
+	return item, nil
+}
+
+// revisions returns the valid edits of a block, newest first
+func (t *Textile) revisions(block *pb.Block) []*pb.Edit {
+	edits := make([]*pb.Edit, 0)
+
+	thread := t.Thread(block.Thread)
+	if thread == nil {
+		return edits
+	}
+
+	query := fmt.Sprintf("type=%d and target='%s'", pb.Block_EDIT, block.Id)
+	for _, b := range t.Blocks("", -1, query, pb.Block_DATE).Items {
+		// edits may have been handled before their target
+		if !thread.editable(block, b.Author, thread.peerAddress(b.Author)) {
+			continue
+		}
+		if b.Data != "" && block.Type != pb.Block_FILES {
+			continue
+		}
+		info, err := t.edit(b, feedItemOpts{annotations: true})
+		if err != nil {
+			continue
+		}
+		edits = append(edits, info)
+	}
+
+	return edits
+}
@@ -2,12 +2,21 @@ package core
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/textileio/go-textile/ipfs"
+	m "github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
 )
 
+// ErrMissingPhash indicates a files block does not contain any perceptual hashes
+var ErrMissingPhash = fmt.Errorf("files do not have a perceptual hash")
+
+// DefaultSimilarDistance is the max number of bits that may differ
+// between the perceptual hashes of similar images
+const DefaultSimilarDistance = 10
+
 func (t *Textile) Files(offset string, limit int, threadId string) (*pb.FilesList, error) {
 	var query string
 	if threadId != "" {
@@ -21,7 +30,7 @@ func (t *Textile) Files(offset string, limit int, threadId string) (*pb.FilesLis
 
 	list := make([]*pb.Files, 0)
 
-	blocks := t.Blocks(offset, limit, query)
+	blocks := t.Blocks(offset, limit, query, pb.Block_DATE)
 	for _, block := range blocks.Items {
 		file, err := t.file(block, feedItemOpts{annotations: true})
 		if err != nil {
@@ -33,6 +42,114 @@ func (t *Textile) Files(offset string, limit int, threadId string) (*pb.FilesLis
 	return &pb.FilesList{Items: list}, nil
 }
 
+// SimilarFiles returns files blocks, across all threads, with images that are perceptually
+// similar to those in the given block, ordered by similarity. Only images with an
+// /image/phash link can be compared.
+func (t *Textile) SimilarFiles(blockId string, distance int) (*pb.FilesList, error) {
+	block, err := t.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != pb.Block_FILES {
+		return nil, ErrBlockWrongType
+	}
+
+	data := filesData(block, t.revisions(block))
+	files, err := t.fileAtData(data)
+	if err != nil {
+		return nil, err
+	}
+	var hashes []string
+	for _, f := range files {
+		if hash := filePhash(f.File); hash != "" {
+			hashes = append(hashes, hash)
+		}
+		for _, link := range f.Links {
+			if hash := filePhash(link); hash != "" {
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+	if len(hashes) == 0 {
+		return nil, ErrMissingPhash
+	}
+
+	// closest distance to each matching target
+	targets := make(map[string]int)
+	for _, file := range t.datastore.Files().ListByMill("/image/phash") {
+		hash := filePhash(&file)
+		if hash == "" {
+			continue
+		}
+		for _, h := range hashes {
+			dist, err := m.PhashDistance(h, hash)
+			if err != nil || dist > distance {
+				continue
+			}
+			for _, target := range file.Targets {
+				if target == data {
+					continue
+				}
+				if d, ok := targets[target]; !ok || dist < d {
+					targets[target] = dist
+				}
+			}
+		}
+	}
+
+	var blocks []*pb.Block
+	dists := make(map[string]int)
+	for target, dist := range targets {
+		query := fmt.Sprintf("data='%s' and (type=%d or type=%d)", target, pb.Block_FILES, pb.Block_EDIT)
+		for _, b := range t.Blocks("", -1, query, pb.Block_DATE).Items {
+			// replaced files belong to the edited block
+			if b.Type == pb.Block_EDIT {
+				b = t.datastore.Blocks().Get(b.Target)
+				if b == nil || b.Type != pb.Block_FILES || b.Id == block.Id {
+					continue
+				}
+			}
+			if d, ok := dists[b.Id]; ok {
+				if dist < d {
+					dists[b.Id] = dist
+				}
+				continue
+			}
+			blocks = append(blocks, b)
+			dists[b.Id] = dist
+		}
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		if dists[blocks[i].Id] != dists[blocks[j].Id] {
+			return dists[blocks[i].Id] < dists[blocks[j].Id]
+		}
+		return blocks[i].Date.Seconds > blocks[j].Date.Seconds
+	})
+
+	list := make([]*pb.Files, 0)
+	for _, b := range blocks {
+		file, err := t.file(b, feedItemOpts{annotations: true})
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, file)
+	}
+
+	return &pb.FilesList{Items: list}, nil
+}
+
+// filePhash returns the perceptual hash in the meta of a phash file
+func filePhash(file *pb.FileIndex) string {
+	if file == nil || file.Mill != "/image/phash" || file.Meta == nil {
+		return ""
+	}
+	val := file.Meta.Fields["phash"]
+	if val == nil {
+		return ""
+	}
+	return val.GetStringValue()
+}
+
 func (t *Textile) File(blockId string) (*pb.Files, error) {
 	block, err := t.Block(blockId)
 	if err != nil {
@@ -97,21 +214,29 @@ func (t *Textile) file(block *pb.Block, opts feedItemOpts) (*pb.Files, error) {
 		return nil, ErrBlockWrongType
 	}
 
-	files, err := t.fileAtData(block.Data)
+	edits := t.revisions(block)
+	data := filesData(block, edits)
+	files, err := t.fileAtData(data)
 	if err != nil {
 		return nil, err
 	}
 
 	item := &pb.Files{
 		Block:   block.Id,
-		Data:    block.Data,
+		Data:    data,
 		Date:    block.Date,
 		User:    t.PeerUser(block.Author),
 		Caption: block.Body,
 		Files:   files,
-		Threads: t.fileThreads(block.Data),
+		Threads: t.fileThreads(data),
+		Edits:   edits,
 	}
 
+	if len(item.Edits) > 0 {
+		item.Caption = item.Edits[0].Body
+	}
+	item.Reactions = t.reactionGroups(block.Id)
+
 	if opts.annotations {
 		comments, err := t.Comments(block.Id)
 		if err != nil {
@@ -124,21 +249,34 @@ func (t *Textile) file(block *pb.Block, opts feedItemOpts) (*pb.Files, error) {
 			return nil, err
 		}
 		item.Likes = likes.Items
+
+		item.Replies = t.replies(block.Id)
 	} else {
 		item.Comments = opts.comments
 		item.Likes = opts.likes
+		item.Replies = opts.replies
 	}
 
 	return item, nil
 }
 
+// filesData returns the data of a files block, which may have been replaced by an edit
+func filesData(block *pb.Block, edits []*pb.Edit) string {
+	for _, edit := range edits {
+		if edit.Data != "" {
+			return edit.Data
+		}
+	}
+	return block.Data
+}
+
 // fileThreads lists threads that have blocks which link a file
 // @todo This should be a distinct db query, if it's even needed?
 func (t *Textile) fileThreads(data string) []string {
 	unique := make([]string, 0)
 	threads := make(map[string]struct{})
 
-	for _, b := range t.datastore.Blocks().List("", -1, "data='"+data+"'").Items {
+	for _, b := range t.datastore.Blocks().List("", -1, "data='"+data+"'", pb.Block_DATE).Items {
 		if _, ok := threads[b.Thread]; !ok {
 			threads[b.Thread] = struct{}{}
 			unique = append(unique, b.Thread)
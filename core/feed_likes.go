@@ -10,7 +10,7 @@ func (t *Textile) Likes(target string) (*pb.LikeList, error) {
 	likes := make([]*pb.Like, 0)
 
 	query := fmt.Sprintf("type=%d and target='%s'", pb.Block_LIKE, target)
-	for _, block := range t.Blocks("", -1, query).Items {
+	for _, block := range t.Blocks("", -1, query, pb.Block_DATE).Items {
 		info, err := t.like(block, feedItemOpts{annotations: true})
 		if err != nil {
 			continue
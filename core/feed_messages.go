@@ -6,7 +6,7 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func (t *Textile) Messages(offset string, limit int, threadId string) (*pb.TextList, error) {
+func (t *Textile) Messages(offset string, limit int, threadId string, sort pb.Block_BlockSort) (*pb.TextList, error) {
 	var query string
 	if threadId != "" {
 		if t.Thread(threadId) == nil {
@@ -19,7 +19,7 @@ func (t *Textile) Messages(offset string, limit int, threadId string) (*pb.TextL
 
 	list := make([]*pb.Text, 0)
 
-	blocks := t.Blocks(offset, limit, query)
+	blocks := t.Blocks(offset, limit, query, sort)
 	for _, block := range blocks.Items {
 		msg, err := t.message(block, feedItemOpts{annotations: true})
 		if err != nil {
@@ -52,6 +52,13 @@ func (t *Textile) message(block *pb.Block, opts feedItemOpts) (*pb.Text, error)
 		Body:  block.Body,
 	}
 
+	item.Edits = t.revisions(block)
+	if len(item.Edits) > 0 {
+		item.Body = item.Edits[0].Body
+	}
+	item.Reactions = t.reactionGroups(block.Id)
+	item.Mentions = mentions(item.Body)
+
 	if opts.annotations {
 		comments, err := t.Comments(block.Id)
 		if err != nil {
@@ -64,10 +71,42 @@ func (t *Textile) message(block *pb.Block, opts feedItemOpts) (*pb.Text, error)
 			return nil, err
 		}
 		item.Likes = likes.Items
+
+		item.Replies = t.replies(block.Id)
 	} else {
 		item.Comments = opts.comments
 		item.Likes = opts.likes
+		item.Replies = opts.replies
+	}
+
+	if isReply(block) {
+		if opts.target != nil {
+			item.Target = opts.target
+		} else if !opts.annotations {
+			target, err := t.feedItem(t.datastore.Blocks().Get(block.Target), feedItemOpts{})
+			if err != nil {
+				return nil, err
+			}
+			item.Target = target
+		}
 	}
 
 	return item, nil
 }
+
+// replies returns the replies to a block, oldest first, each with their own replies
+func (t *Textile) replies(target string) []*pb.Text {
+	list := make([]*pb.Text, 0)
+
+	query := fmt.Sprintf("type=%d and target='%s'", pb.Block_TEXT, target)
+	blocks := t.Blocks("", -1, query, pb.Block_CAUSAL).Items
+	for i := len(blocks) - 1; i >= 0; i-- {
+		reply, err := t.message(blocks[i], feedItemOpts{annotations: true})
+		if err != nil {
+			continue
+		}
+		list = append(list, reply)
+	}
+
+	return list
+}
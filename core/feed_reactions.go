@@ -0,0 +1,112 @@
+package core
+
+import (
+	"sort"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func (t *Textile) Reactions(target string) (*pb.ReactionGroupList, error) {
+	if _, err := t.Block(target); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReactionGroupList{Items: t.reactionGroups(target)}, nil
+}
+
+func (t *Textile) Reaction(blockId string) (*pb.Reaction, error) {
+	block, err := t.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.reaction(block, feedItemOpts{annotations: true})
+}
+
+func (t *Textile) reaction(block *pb.Block, opts feedItemOpts) (*pb.Reaction, error) {
+	if block.Type != pb.Block_REACTION {
+		return nil, ErrBlockWrongType
+	}
+
+	item := &pb.Reaction{
+		Id:    block.Id,
+		Date:  block.Date,
+		User:  t.PeerUser(block.Author),
+		Emoji: block.Body,
+	}
+
+	if opts.target != nil {
+		item.Target = opts.target
+	} else if !opts.annotations {
+		target, err := t.feedItem(t.datastore.Blocks().Get(block.Target), feedItemOpts{})
+		if err != nil {
+			return nil, err
+		}
+		item.Target = target
+	}
+
+	return item, nil
+}
+
+// reactionGroups aggregates the applied reactions on a block by emoji,
+// most used first. Each reaction block toggles its emoji for the author's account,
+// which is shown as the peer that last reacted.
+func (t *Textile) reactionGroups(target string) []*pb.ReactionGroup {
+	groups := make([]*pb.ReactionGroup, 0)
+	tblock := t.datastore.Blocks().Get(target)
+	if tblock == nil {
+		return groups
+	}
+	thread := t.Thread(tblock.Thread)
+	if thread == nil {
+		return groups
+	}
+
+	applied := make(map[string]map[string]string)
+	var order []string
+
+	// ignored reactions are skipped the same way as for notifications
+	blocks := t.datastore.Blocks().List("", -1, reactionsQuery(target), pb.Block_DATE).Items
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block := blocks[i]
+		reactors, ok := applied[block.Body]
+		if !ok {
+			reactors = make(map[string]string)
+			applied[block.Body] = reactors
+			order = append(order, block.Body)
+		}
+		reactor := thread.reactor(block.Author)
+		if _, ok := reactors[reactor]; ok {
+			delete(reactors, reactor)
+		} else {
+			reactors[reactor] = block.Author
+		}
+	}
+
+	for _, emoji := range order {
+		reactors := applied[emoji]
+		if len(reactors) == 0 {
+			continue
+		}
+		var keys []string
+		for reactor := range reactors {
+			keys = append(keys, reactor)
+		}
+		sort.Strings(keys)
+
+		group := &pb.ReactionGroup{
+			Emoji: emoji,
+			Count: int32(len(keys)),
+		}
+		for _, reactor := range keys {
+			group.Users = append(group.Users, t.PeerUser(reactors[reactor]))
+		}
+		groups = append(groups, group)
+	}
+
+	// stable keeps first use order for ties
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Count > groups[j].Count
+	})
+	return groups
+}
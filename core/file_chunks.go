@@ -0,0 +1,342 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	chunker "github.com/ipfs/go-ipfs-chunker"
+	ipld "github.com/ipfs/go-ipld-format"
+	uio "github.com/ipfs/go-unixfs/io"
+	"github.com/mr-tron/base58/base58"
+	"github.com/textileio/go-textile/crypto"
+	"github.com/textileio/go-textile/ipfs"
+	m "github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
+)
+
+// ChunksLinkName is the link to the encrypted chunk list of chunked file content
+const ChunksLinkName = "chunks"
+
+// rolling hash chunk sizes, max must fit in a single cafe object message
+const minChunkSize = 256 * 1024
+const avgChunkSize = 1024 * 1024
+const maxChunkSize = 2 * 1024 * 1024
+
+// addFileIndexChunked splits milled output into content-defined chunks, each sealed
+// with a key derived from its content and a convergence secret, so that files which
+// share content also share chunks. The file content is a directory linking each chunk,
+// along with the chunk list, which is sealed with the file key.
+func (t *Textile) addFileIndexChunked(mill m.ChunkedMill, conf AddFileConfig) (*pb.FileIndex, error) {
+	reader := conf.Reader
+	if reader == nil {
+		reader = bytes.NewReader(conf.Input)
+	}
+	defer func() {
+		if closer, ok := reader.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}()
+
+	opts, err := mill.Options(map[string]interface{}{
+		"plaintext": conf.Plaintext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Use != "" {
+		if efile := t.datastore.Files().GetBySource(mill.ID(), conf.Use, opts); efile != nil {
+			return efile, nil
+		}
+	}
+
+	secret, err := t.convergenceSecret(conf.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	input := newChecksumWriter()
+	res, err := mill.MillStream(io.TeeReader(reader, input), conf.Name)
+	if err != nil {
+		return nil, err
+	}
+	output := newChecksumWriter()
+
+	dir := uio.NewDirectory(t.node.DAG)
+	list := &pb.FileChunkList{}
+	var chunks []string
+	var reused int
+
+	splitter := chunker.NewRabinMinMax(io.TeeReader(res.File, output), minChunkSize, avgChunkSize, maxChunkSize)
+	for {
+		data, err := splitter.NextBytes()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key := crypto.DeriveAESKey(secret, data)
+		ciphertext, err := crypto.EncryptAES(data, key)
+		if err != nil {
+			return nil, err
+		}
+		id, err := ipfs.AddData(t.node, bytes.NewReader(ciphertext), false, false)
+		if err != nil {
+			return nil, err
+		}
+		hash := id.Hash().B58String()
+
+		err = ipfs.AddLinkToDirectory(t.node, dir, strconv.Itoa(len(list.Items)), hash)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, &pb.FileChunk{
+			Hash: hash,
+			Key:  base58.FastBase58Encoding(key),
+			Size: int64(len(data)),
+		})
+		chunks = append(chunks, hash)
+
+		if t.datastore.Files().CountChunkFiles(hash) > 0 {
+			reused++
+		}
+	}
+
+	fkey, err := crypto.GenerateAESKey()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := proto.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := crypto.EncryptAES(plaintext, fkey)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ipfs.AddDataToDirectory(t.node, dir, ChunksLinkName, bytes.NewReader(ciphertext))
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := dir.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	if mill.Pin() {
+		err = ipfs.PinNode(t.node, node, true)
+	} else {
+		err = t.node.DAG.Add(t.node.Context(), node)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	model := &pb.FileIndex{
+		Mill:       mill.ID(),
+		Checksum:   output.checksum(conf.Plaintext),
+		Opts:       opts,
+		Hash:       node.Cid().Hash().B58String(),
+		Key:        base58.FastBase58Encoding(fkey),
+		Media:      conf.Media,
+		Name:       conf.Name,
+		Size:       output.size,
+		Added:      ptypes.TimestampNow(),
+		Meta:       pb.ToStruct(res.Meta),
+		Encryption: pb.FileIndex_AES_GCM_CHUNKS,
+	}
+	if conf.Use != "" {
+		model.Source = conf.Use
+	} else {
+		model.Source = input.checksum(conf.Plaintext)
+	}
+
+	existing := func() *pb.FileIndex {
+		efile := t.datastore.Files().GetBySource(mill.ID(), model.Source, opts)
+		if efile == nil {
+			efile = t.datastore.Files().GetByPrimary(mill.ID(), model.Checksum)
+		}
+		if efile != nil {
+			t.removeDuplicateContent(node, mill.Pin())
+		}
+		return efile
+	}
+	if efile := existing(); efile != nil {
+		return efile, nil
+	}
+
+	log.Debugf("added %s with %d chunks (%d reused)", model.Hash, len(chunks), reused)
+
+	err = t.datastore.Files().Add(model)
+	if err != nil {
+		if db.ConflictError(err) {
+			// we may have lost the race
+			if efile := existing(); efile != nil {
+				return efile, nil
+			}
+		}
+		return nil, err
+	}
+	err = t.datastore.Files().AddChunks(model.Hash, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.datastore.Files().Get(model.Hash), nil
+}
+
+// removeDuplicateContent drops chunked content that turned out to duplicate an
+// existing file, keeping chunks which are shared with other files
+func (t *Textile) removeDuplicateContent(node ipld.Node, pinned bool) {
+	hash := node.Cid().Hash().B58String()
+	if pinned {
+		if err := ipfs.UnpinNode(t.node, node, true); err != nil {
+			log.Warningf("error unpinning duplicate %s: %s", hash, err)
+			return
+		}
+	}
+
+	for _, link := range node.Links() {
+		if link.Name != ChunksLinkName &&
+			t.datastore.Files().CountChunkFiles(link.Cid.Hash().B58String()) > 0 {
+			continue
+		}
+		if err := ipfs.RemoveLocalCid(t.node, link.Cid, true); err != nil {
+			log.Warningf("error removing duplicate chunk %s: %s", link.Cid.Hash().B58String(), err)
+		}
+	}
+	if err := ipfs.RemoveLocalCid(t.node, node.Cid(), false); err != nil {
+		log.Warningf("error removing duplicate %s: %s", hash, err)
+	}
+}
+
+// convergenceSecret returns the secret used to derive chunk keys,
+// which is scoped to a thread if given, otherwise to the account
+func (t *Textile) convergenceSecret(threadId string) ([]byte, error) {
+	if threadId == "" {
+		return []byte(t.account.Seed()), nil
+	}
+
+	thrd := t.Thread(threadId)
+	if thrd == nil {
+		return nil, ErrThreadNotFound
+	}
+	return thrd.PrivKey.Bytes()
+}
+
+// chunkedFileContent returns a reader of chunked file content, which
+// fetches and decrypts chunks as they're needed
+func (t *Textile) chunkedFileContent(file *pb.FileIndex) (FileReader, error) {
+	data, err := ipfs.DataAtPath(t.node, file.Hash+"/"+ChunksLinkName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file index chunks for hash %s with error: %s", file.Hash, err)
+	}
+	key, err := base58.Decode(file.Key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := crypto.DecryptAES(data, key)
+	if err != nil {
+		return nil, err
+	}
+
+	list := new(pb.FileChunkList)
+	err = proto.Unmarshal(plaintext, list)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := &chunkReader{
+		textile: t,
+		chunks:  list.Items,
+		ends:    make([]int64, len(list.Items)),
+		index:   -1,
+	}
+	for i, c := range list.Items {
+		reader.size += c.Size
+		reader.ends[i] = reader.size
+	}
+	return reader, nil
+}
+
+type chunkReader struct {
+	textile *Textile
+	chunks  []*pb.FileChunk
+	ends    []int64
+	size    int64
+	offset  int64
+	index   int
+	plain   []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	index := sort.Search(len(r.ends), func(i int) bool {
+		return r.ends[i] > r.offset
+	})
+	if index != r.index {
+		if err := r.open(index); err != nil {
+			return 0, err
+		}
+	}
+
+	start := r.ends[index] - r.chunks[index].Size
+	n := copy(p, r.plain[r.offset-start:])
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *chunkReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence")
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+// Close is a no-op, chunks are read whole
+func (r *chunkReader) Close() error {
+	return nil
+}
+
+// open fetches and decrypts the chunk at index
+func (r *chunkReader) open(index int) error {
+	chunk := r.chunks[index]
+	data, err := ipfs.DataAtPath(r.textile.node, chunk.Hash)
+	if err != nil {
+		return err
+	}
+	key, err := base58.Decode(chunk.Key)
+	if err != nil {
+		return err
+	}
+	r.plain, err = crypto.DecryptAES(data, key)
+	if err != nil {
+		return err
+	}
+	if int64(len(r.plain)) != chunk.Size {
+		return fmt.Errorf("chunk %s has wrong size", chunk.Hash)
+	}
+	r.index = index
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
@@ -38,9 +40,35 @@ type AddFileConfig struct {
 	Media     string `json:"media"`
 	Name      string `json:"name"`
 	Plaintext bool   `json:"plaintext"`
+	Thread    string `json:"thread"`
+
+	// Reader is used in place of Input by stream mills, and is closed
+	// when consumed if it's also an io.Closer
+	Reader io.Reader `json:"-"`
 }
 
 func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex, error) {
+	if mmill, ok := mill.(m.MediaMill); ok {
+		conf.Media = mmill.OutputMedia(conf.Media)
+	}
+
+	cmill, ok := mill.(m.ChunkedMill)
+	if ok && cmill.Chunked() && mill.Encrypt() && !conf.Plaintext {
+		return t.addFileIndexChunked(cmill, conf)
+	}
+
+	if conf.Reader != nil {
+		smill, ok := mill.(m.StreamMill)
+		if ok {
+			return t.addFileIndexStream(smill, conf)
+		}
+		var err error
+		conf.Input, err = readAllAndClose(conf.Reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var source string
 	if conf.Use != "" {
 		source = conf.Use
@@ -118,6 +146,97 @@ func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex,
 	return t.datastore.Files().Get(model.Hash), nil
 }
 
+// addFileIndexStream mills and encrypts conf.Reader without holding it in memory.
+// Because the input checksum isn't known until it has been read, duplicates are
+// detected after the output has been added.
+func (t *Textile) addFileIndexStream(mill m.StreamMill, conf AddFileConfig) (*pb.FileIndex, error) {
+	defer func() {
+		if closer, ok := conf.Reader.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}()
+
+	opts, err := mill.Options(map[string]interface{}{
+		"plaintext": conf.Plaintext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Use != "" {
+		if efile := t.datastore.Files().GetBySource(mill.ID(), conf.Use, opts); efile != nil {
+			return efile, nil
+		}
+	}
+
+	input := newChecksumWriter()
+	res, err := mill.MillStream(io.TeeReader(conf.Reader, input), conf.Name)
+	if err != nil {
+		return nil, err
+	}
+	output := newChecksumWriter()
+	reader := io.TeeReader(res.File, output)
+
+	model := &pb.FileIndex{
+		Mill:  mill.ID(),
+		Opts:  opts,
+		Media: conf.Media,
+		Name:  conf.Name,
+		Added: ptypes.TimestampNow(),
+		Meta:  pb.ToStruct(res.Meta),
+	}
+
+	if mill.Encrypt() && !conf.Plaintext {
+		key, err := crypto.GenerateAESKey()
+		if err != nil {
+			return nil, err
+		}
+		reader, err = crypto.EncryptAESStream(reader, key)
+		if err != nil {
+			return nil, err
+		}
+		model.Key = base58.FastBase58Encoding(key)
+		model.Encryption = pb.FileIndex_AES_GCM_STREAM
+	}
+
+	hash, err := ipfs.AddStream(t.node, reader, mill.Pin())
+	if err != nil {
+		return nil, err
+	}
+	model.Hash = hash.Hash().B58String()
+	model.Checksum = output.checksum(conf.Plaintext)
+	model.Size = output.size
+	if conf.Use != "" {
+		model.Source = conf.Use
+	} else {
+		model.Source = input.checksum(conf.Plaintext)
+	}
+
+	efile := t.datastore.Files().GetBySource(mill.ID(), model.Source, opts)
+	if efile == nil {
+		efile = t.datastore.Files().GetByPrimary(mill.ID(), model.Checksum)
+	}
+	if efile != nil {
+		if mill.Pin() && efile.Hash != model.Hash {
+			if err := ipfs.UnpinCid(t.node, *hash, false); err != nil {
+				log.Warningf("error unpinning duplicate %s: %s", model.Hash, err)
+			}
+		}
+		return efile, nil
+	}
+
+	err = t.datastore.Files().Add(model)
+	if err != nil {
+		if db.ConflictError(err) {
+			// we may have lost the race
+			return t.datastore.Files().Get(model.Hash), nil
+		}
+		return nil, err
+	}
+
+	return t.datastore.Files().Get(model.Hash), nil
+}
+
 func (t *Textile) GetMedia(reader io.Reader) (string, error) {
 	buffer := make([]byte, 512)
 	n, err := reader.Read(buffer)
@@ -125,6 +244,9 @@ func (t *Textile) GetMedia(reader io.Reader) (string, error) {
 		return "", err
 	}
 
+	if media := sniffMedia(buffer[:n]); media != "" {
+		return media, nil
+	}
 	return http.DetectContentType(buffer[:n]), nil
 }
 
@@ -149,7 +271,7 @@ func (t *Textile) AddSchema(jsonstr string, name string) (*pb.FileIndex, error)
 		return nil, err
 	}
 
-	return t.AddFileIndex(&m.Schema{}, AddFileConfig{
+	return t.AddFileIndex(t.SchemaMill(), AddFileConfig{
 		Input: []byte(data),
 		Media: "application/json",
 		Name:  name,
@@ -233,10 +355,16 @@ func (t *Textile) FileMeta(hash string) (*pb.FileIndex, error) {
 	return file, nil
 }
 
-func (t *Textile) FileContent(hash string) (io.ReadSeeker, *pb.FileIndex, error) {
+// FileReader is a seekable reader of file content, which should be closed when done
+type FileReader interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+func (t *Textile) FileContent(hash string) (FileReader, *pb.FileIndex, error) {
 	var err error
 	var file *pb.FileIndex
-	var reader io.ReadSeeker
+	var reader FileReader
 	file, err = t.FileMeta(hash)
 	if err != nil {
 		return nil, nil, err
@@ -245,7 +373,33 @@ func (t *Textile) FileContent(hash string) (io.ReadSeeker, *pb.FileIndex, error)
 	return reader, file, err
 }
 
-func (t *Textile) FileIndexContent(file *pb.FileIndex) (io.ReadSeeker, error) {
+func (t *Textile) FileIndexContent(file *pb.FileIndex) (FileReader, error) {
+	if file.Encryption == pb.FileIndex_AES_GCM_CHUNKS {
+		return t.chunkedFileContent(file)
+	}
+
+	if file.Key == "" || file.Encryption == pb.FileIndex_AES_GCM_STREAM {
+		fd, err := ipfs.FileAtPath(t.node, file.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file index content for hash %s with error: %s", file.Hash, err)
+		}
+		if file.Key == "" {
+			return fd, nil
+		}
+
+		key, err := base58.Decode(file.Key)
+		if err != nil {
+			_ = fd.Close()
+			return nil, err
+		}
+		dec, err := crypto.DecryptAESStream(fd, key)
+		if err != nil {
+			_ = fd.Close()
+			return nil, err
+		}
+		return &fileReader{ReadSeeker: dec, closer: fd}, nil
+	}
+
 	fd, err := ipfs.DataAtPath(t.node, file.Hash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file index content for hash %s with error: %s", file.Hash, err)
@@ -265,7 +419,20 @@ func (t *Textile) FileIndexContent(file *pb.FileIndex) (io.ReadSeeker, error) {
 		plaintext = fd
 	}
 
-	return bytes.NewReader(plaintext), nil
+	return &fileReader{ReadSeeker: bytes.NewReader(plaintext)}, nil
+}
+
+// fileReader pairs a reader with the closer of its source, if any
+type fileReader struct {
+	io.ReadSeeker
+	closer io.Closer
+}
+
+func (r *fileReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
 }
 
 func (t *Textile) TargetNodeKeys(node ipld.Node) (*pb.Keys, error) {
@@ -359,6 +526,39 @@ func (t *Textile) checksum(plaintext []byte, wontEncrypt bool) string {
 	return base58.FastBase58Encoding(sum[:])
 }
 
+// checksumWriter computes the same checksum as Textile.checksum over written data.
+// checksum finalizes the hash, so should only be called once.
+type checksumWriter struct {
+	hash hash.Hash
+	size int64
+}
+
+func newChecksumWriter() *checksumWriter {
+	return &checksumWriter{hash: sha256.New()}
+}
+
+func (w *checksumWriter) Write(p []byte) (int, error) {
+	w.size += int64(len(p))
+	return w.hash.Write(p)
+}
+
+func (w *checksumWriter) checksum(wontEncrypt bool) string {
+	var add int
+	if wontEncrypt {
+		add = 1
+	}
+	_, _ = w.hash.Write([]byte{byte(add)})
+	return base58.FastBase58Encoding(w.hash.Sum(nil))
+}
+
+// readAllAndClose reads all of reader, closing it if it's also an io.Closer
+func readAllAndClose(reader io.Reader) ([]byte, error) {
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	return ioutil.ReadAll(reader)
+}
+
 func (t *Textile) fileNodeKeys(node ipld.Node, index int, keys *map[string]string) error {
 	vkeys := *keys
 
@@ -402,6 +602,32 @@ func (t *Textile) fileLinkKey(inode ipld.Node) (string, error) {
 	return file.Key, nil
 }
 
+// sniffMedia detects image, audio and video types that http.DetectContentType
+// does not recognize or reports as generic video
+func sniffMedia(data []byte) string {
+	if len(data) < 12 {
+		return ""
+	}
+	switch m.HeifFormat(data) {
+	case m.AVIF:
+		return "image/avif"
+	case m.HEIC:
+		return "image/heic"
+	}
+	switch {
+	case string(data[4:12]) == "ftypqt  ":
+		return "video/quicktime"
+	case string(data[4:11]) == "ftypM4A", string(data[4:11]) == "ftypM4B", string(data[4:11]) == "ftypM4P":
+		return "audio/mp4"
+	case string(data[0:4]) == "fLaC":
+		return "audio/flac"
+	case data[0] == 0xff && data[1]&0xe0 == 0xe0 && (data[1]>>1)&0x03 == 1:
+		// mpeg layer III frame sync without a leading ID3 tag
+		return "audio/mpeg"
+	}
+	return ""
+}
+
 // looksLikeFileNode returns whether or not a node appears to
 // be a textile node. It doesn't inspect the actual data.
 func looksLikeFileNode(node ipld.Node) bool {
@@ -215,6 +215,10 @@ func (t *Textile) handleThreadAdd(plaintext []byte, parents []string) (mh.Multih
 	if err != nil {
 		return nil, err
 	}
+	err = thread.addKeys(msg.Thread.Keys)
+	if err != nil {
+		return nil, err
+	}
 
 	// mark welcomed, sending a join soon
 	err = thread.addOrUpdatePeer(msg.Inviter, true)
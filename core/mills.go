@@ -0,0 +1,140 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	m "github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/schema"
+)
+
+// millIdPattern matches mill ids that can be routed under /mills, i.e., without wildcards
+var millIdPattern = regexp.MustCompile(`^(/[A-Za-z0-9._~-]+)+$`)
+
+// loadMills validates the external mills in config and keeps them so they can be used in schemas
+func (t *Textile) loadMills() error {
+	t.mills = make(map[string]config.Mill)
+	for _, mill := range t.config.Mills {
+		if !millIdPattern.MatchString(mill.ID) {
+			return fmt.Errorf("invalid mill id: %s", mill.ID)
+		}
+		if schema.BuiltinMill(mill.ID) {
+			return fmt.Errorf("mill %s is built in", mill.ID)
+		}
+		if _, ok := t.mills[mill.ID]; ok {
+			return fmt.Errorf("mill %s is defined more than once", mill.ID)
+		}
+
+		if (len(mill.Command) > 0) == (mill.URL != "") {
+			return fmt.Errorf("mill %s requires a command or a url", mill.ID)
+		}
+		if mill.Timeout != "" {
+			if _, err := time.ParseDuration(mill.Timeout); err != nil {
+				return fmt.Errorf("mill %s has an invalid timeout: %s", mill.ID, err)
+			}
+		}
+
+		t.mills[mill.ID] = mill
+	}
+	return nil
+}
+
+// ExternalMill returns the external mill with id, or nil if it's not in config
+func (t *Textile) ExternalMill(id string, opts map[string]string) m.Mill {
+	mill, ok := t.mills[id]
+	if !ok {
+		return nil
+	}
+	return &m.External{Conf: mill, Opts: opts}
+}
+
+// SchemaMill returns a schema mill that allows this node's external mills
+func (t *Textile) SchemaMill() *m.Schema {
+	ids := make([]string, 0, len(t.mills))
+	for id := range t.mills {
+		ids = append(ids, id)
+	}
+	return &m.Schema{External: ids}
+}
+
+// Mill returns the builtin or external mill with id configured by opts,
+// or nil if no such mill exists
+func (t *Textile) Mill(id string, opts map[string]string) (m.Mill, error) {
+	switch id {
+	case "/blob":
+		return &m.Blob{
+			Opts: m.BlobOpts{
+				Chunked: opts["chunked"],
+			},
+		}, nil
+	case "/image/resize":
+		width := opts["width"]
+		if width == "" {
+			return nil, fmt.Errorf("missing width")
+		}
+		quality := opts["quality"]
+		if quality == "" {
+			quality = "75"
+		}
+		return &m.ImageResize{
+			Opts: m.ImageResizeOpts{
+				Width:   width,
+				Quality: quality,
+				Format:  opts["format"],
+			},
+		}, nil
+	case "/image/exif":
+		return &m.ImageExif{
+			Opts: m.ImageExifOpts{
+				Location: opts["location"],
+			},
+		}, nil
+	case "/image/phash":
+		return &m.ImagePhash{}, nil
+	case "/video/poster":
+		width := opts["width"]
+		if width == "" {
+			return nil, fmt.Errorf("missing width")
+		}
+		quality := opts["quality"]
+		if quality == "" {
+			quality = "75"
+		}
+		return &m.VideoPoster{
+			Opts: m.VideoPosterOpts{
+				Width:   width,
+				Quality: quality,
+			},
+		}, nil
+	case "/video/probe":
+		return &m.VideoProbe{}, nil
+	case "/audio/meta":
+		return &m.AudioMeta{}, nil
+	case "/audio/cover":
+		width := opts["width"]
+		if width == "" {
+			return nil, fmt.Errorf("missing width")
+		}
+		quality := opts["quality"]
+		if quality == "" {
+			quality = "75"
+		}
+		return &m.AudioCover{
+			Opts: m.ImageResizeOpts{
+				Width:   width,
+				Quality: quality,
+				Format:  opts["format"],
+			},
+		}, nil
+	case "/doc/meta":
+		return &m.DocMeta{}, nil
+	case "/doc/text":
+		return &m.DocText{}, nil
+	case "/json":
+		return &m.Json{}, nil
+	default:
+		return t.ExternalMill(id, opts), nil
+	}
+}
@@ -2,7 +2,9 @@ package core
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"time"
@@ -38,6 +40,12 @@ const targetLinkName = "target"
 // dataLinkName is the name of the link used to reference a data node
 const dataLinkName = "data"
 
+// epochMagic prefixes block ciphertext encrypted with a rotated thread key. It's
+// followed by the key's epoch as a uvarint, which mirrors the block header so that
+// the key can be looked up before decrypting. Blocks encrypted with the initial key
+// aren't prefixed, keeping them readable by older peers.
+var epochMagic = []byte("\x00epk")
+
 // ErrInvalidNode indicates the thread node is not valid
 var ErrInvalidNode = fmt.Errorf("thread node is not valid")
 
@@ -53,6 +61,9 @@ var ErrNotAnnotatable = fmt.Errorf("thread is not annotatable")
 // ErrNotWritable indicates the thread is not writable (files/messages)
 var ErrNotWritable = fmt.Errorf("thread is not writable")
 
+// ErrKeyNotFound indicates a block is encrypted with a thread key that isn't known
+var ErrKeyNotFound = fmt.Errorf("thread key not found")
+
 // ErrThreadSchemaRequired indicates files where added without a thread schema
 var ErrThreadSchemaRequired = fmt.Errorf("thread schema required to add files")
 
@@ -158,7 +169,7 @@ func (t *Thread) Heads() ([]string, error) {
 // LatestFiles returns the most recent files block
 func (t *Thread) LatestFiles() *pb.Block {
 	query := fmt.Sprintf("threadId='%s' and type=%d", t.Id, pb.Block_FILES)
-	list := t.datastore.Blocks().List("", 1, query)
+	list := t.datastore.Blocks().List("", 1, query, pb.Block_DATE)
 	if len(list.Items) == 0 {
 		return nil
 	}
@@ -170,24 +181,80 @@ func (t *Thread) Peers() []pb.ThreadPeer {
 	return t.datastore.ThreadPeers().ListByThread(t.Id)
 }
 
-// Encrypt data with thread public key
+// Encrypt data with the current thread public key
 func (t *Thread) Encrypt(data []byte) ([]byte, error) {
-	return crypto.Encrypt(t.PrivKey.GetPublic(), data)
+	return t.encryptEpoch(t.epoch(), data)
 }
 
-// Decrypt data with thread secret key
+// Decrypt data with the thread secret key of the epoch it was encrypted in
 func (t *Thread) Decrypt(data []byte) ([]byte, error) {
-	return crypto.Decrypt(t.PrivKey, data)
+	epoch, ciphertext := splitEpoch(data)
+	if epoch == 0 {
+		return crypto.Decrypt(t.PrivKey, data)
+	}
+
+	// concurrent rotations by different admins may share an epoch
+	err := ErrKeyNotFound
+	for _, key := range t.Keys().Items {
+		if key.Epoch != epoch {
+			continue
+		}
+		sk, kerr := ipfs.UnmarshalPrivateKey(key.Sk)
+		if kerr != nil {
+			return nil, kerr
+		}
+		var plaintext []byte
+		plaintext, err = crypto.Decrypt(sk, ciphertext)
+		if err == nil {
+			return plaintext, nil
+		}
+	}
+
+	// the prefix may just be the start of a block encrypted with the initial key
+	if plaintext, ierr := crypto.Decrypt(t.PrivKey, data); ierr == nil {
+		return plaintext, nil
+	}
+	return nil, err
 }
 
-// UpdateSchema sets a new schema hash on the model and loads its node
-func (t *Thread) UpdateSchema(hash string) error {
-	err := t.datastore.Threads().UpdateSchema(t.Id, hash)
-	if err != nil {
-		return err
+// encryptEpoch encrypts data with the thread key of the given epoch.
+// Ciphertext of rotated keys is prefixed with the epoch, see epochMagic.
+func (t *Thread) encryptEpoch(epoch int32, data []byte) ([]byte, error) {
+	if epoch == 0 {
+		return crypto.Encrypt(t.PrivKey.GetPublic(), data)
+	}
+
+	for _, key := range t.Keys().Items {
+		if key.Epoch != epoch {
+			continue
+		}
+		sk, err := ipfs.UnmarshalPrivateKey(key.Sk)
+		if err != nil {
+			return nil, err
+		}
+		ciphertext, err := crypto.Encrypt(sk.GetPublic(), data)
+		if err != nil {
+			return nil, err
+		}
+		prefix := make([]byte, len(epochMagic)+binary.MaxVarintLen32)
+		n := copy(prefix, epochMagic)
+		n += binary.PutUvarint(prefix[n:], uint64(epoch))
+		return append(prefix[:n], ciphertext...), nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+// splitEpoch returns the key epoch of block ciphertext and the ciphertext without
+// its prefix. The epoch is 0 for ciphertext without a prefix.
+func splitEpoch(data []byte) (int32, []byte) {
+	if !bytes.HasPrefix(data, epochMagic) {
+		return 0, data
+	}
+	epoch, n := binary.Uvarint(data[len(epochMagic):])
+	if n <= 0 || epoch == 0 || epoch > math.MaxInt32 {
+		return 0, data
 	}
-	t.Schema = nil
-	return t.loadSchema()
+	return int32(epoch), data[len(epochMagic)+n:]
 }
 
 // followParents follows a list of node links, queueing block downloads along the way
@@ -274,7 +341,7 @@ func (t *Thread) followParent(parent string) ([]string, error) {
 			return nil, err
 		}
 	} else {
-		// old block, handle now
+		// old block, handle now, expired blocks are skipped but history is still followed
 		_, err = t.handle(bnode, false)
 		if err != nil {
 			return nil, err
@@ -301,12 +368,29 @@ type handleResult struct {
 	oldData   string
 }
 
-// handle receives a downloaded block allowing w/ it node links
+// handle receives a downloaded block allowing w/ it node links.
+// The returned index is nil if the block has expired, and deferred if its author
+// was not permitted to add it, or its files are not valid, as far as is known.
 func (t *Thread) handle(bnode *blockNode, replace bool) (*pb.Block, error) {
 	block, err := t.unmarshalBlock(bnode.ciphertext)
 	if err != nil {
 		return nil, err
 	}
+
+	// handle old block fields
+	if len(block.Header.Parents) > 0 {
+		bnode.parents = block.Header.Parents
+	}
+
+	// expired blocks and blocks outside of the retention policy are not kept
+	if t.expired(block.Type, block.Header.Date, block.Header.Expires) {
+		log.Debugf("%s expired, skipping", bnode.hash)
+		if replace {
+			return nil, t.datastore.Blocks().Delete(bnode.hash)
+		}
+		return nil, nil
+	}
+
 	_, err = t.addBlock(bnode.ciphertext, false)
 	if err != nil {
 		return nil, err
@@ -340,17 +424,34 @@ func (t *Thread) handle(bnode *blockNode, replace bool) (*pb.Block, error) {
 		res, err = t.handleCommentBlock(block)
 	case pb.Block_LIKE:
 		res, err = t.handleLikeBlock(block)
+	case pb.Block_MEMBER:
+		res, err = t.handleMemberBlock(bnode, block)
+	case pb.Block_KEY:
+		res, err = t.handleKeyBlock(bnode, block)
+	case pb.Block_EDIT:
+		res, err = t.handleEditBlock(bnode, block)
+	case pb.Block_REACTION:
+		res, err = t.handleReactionBlock(block)
+	case pb.Block_RETENTION:
+		res, err = t.handleRetentionBlock(block)
+	case pb.Block_SCHEMA:
+		res, err = t.handleSchemaBlock(block)
 	default:
 		err = fmt.Errorf("invalid type: %s", block.Type)
 	}
 	if err != nil {
+		if unauthorized(err) {
+			log.Debugf("%s not permitted yet, deferring: %s", bnode.hash, err)
+			return t.deferBlock(bnode, replace)
+		}
+		if err == schema.ErrFileValidationFailed {
+			// the schema version it was added under may not be known yet
+			log.Debugf("%s not valid yet, deferring: %s", bnode.hash, err)
+			return t.deferBlock(bnode, replace)
+		}
 		return nil, err
 	}
 
-	// handle old block fields
-	if len(block.Header.Parents) > 0 {
-		bnode.parents = block.Header.Parents
-	}
 	if res.oldTarget != "" {
 		bnode.target = res.oldTarget
 	}
@@ -369,15 +470,99 @@ func (t *Thread) handle(bnode *blockNode, replace bool) (*pb.Block, error) {
 		Data:    bnode.data,
 		Body:    res.body,
 		Status:  pb.Block_READY,
+		Expires: block.Header.Expires,
+		Clock:   block.Header.Clock,
 	}
 	err = t.indexBlock(index, replace)
 	if err != nil {
 		return nil, err
 	}
 
+	// the change may permit or validate blocks that were handled before it
+	if block.Type == pb.Block_MEMBER || block.Type == pb.Block_SCHEMA {
+		t.handleDeferred()
+	}
+
+	return index, nil
+}
+
+// deferBlock indexes a block whose author was not permitted to add it as far as
+// the locally known membership changes go, or whose files don't match a locally known
+// schema version. Like pending downloads, deferred blocks
+// are indexed without content.
+func (t *Thread) deferBlock(bnode *blockNode, replace bool) (*pb.Block, error) {
+	index := &pb.Block{
+		Id:      bnode.hash,
+		Thread:  t.Id,
+		Parents: bnode.parents,
+		Target:  bnode.target,
+		Data:    bnode.data,
+		Status:  pb.Block_DEFERRED,
+	}
+
+	var err error
+	if replace {
+		err = t.datastore.Blocks().Replace(index)
+	} else {
+		err = t.datastore.Blocks().Add(index)
+	}
+	if err != nil {
+		return nil, err
+	}
 	return index, nil
 }
 
+// handleDeferred handles deferred blocks again until no more are permitted or valid
+func (t *Thread) handleDeferred() {
+	query := fmt.Sprintf("threadId='%s' and status=%d", t.Id, pb.Block_DEFERRED)
+	for {
+		var handled int
+		for _, deferred := range t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items {
+			// may have been handled by a nested membership change
+			index := t.datastore.Blocks().Get(deferred.Id)
+			if index == nil || index.Status != pb.Block_DEFERRED {
+				continue
+			}
+
+			ciphertext, err := ipfs.DataAtPath(t.node(), deferred.Id)
+			if err != nil {
+				log.Warningf("failed to get deferred block %s: %s", deferred.Id, err)
+				continue
+			}
+			index, err = t.handle(&blockNode{
+				hash:       deferred.Id,
+				ciphertext: ciphertext,
+				parents:    deferred.Parents,
+				target:     deferred.Target,
+				data:       deferred.Data,
+			}, true)
+			if err != nil {
+				log.Warningf("deferred block %s failed: %s", deferred.Id, err)
+				if err := t.datastore.Blocks().Delete(deferred.Id); err != nil {
+					log.Errorf("error deleting deferred block %s: %s", deferred.Id, err)
+				}
+				continue
+			}
+			if index == nil || index.Status != pb.Block_DEFERRED {
+				handled++
+			}
+		}
+		if handled == 0 {
+			return
+		}
+	}
+}
+
+// unauthorized returns whether or not a handle error is due to the block author's permissions
+func unauthorized(err error) bool {
+	switch err {
+	case ErrNotReadable, ErrNotAnnotatable, ErrNotWritable, ErrNotAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
 // addOrUpdatePeer collects and saves thread peers
 func (t *Thread) addOrUpdatePeer(peer *pb.Peer, welcomed bool) error {
 	if peer.Id == t.node().Identity.Pretty() {
@@ -409,9 +594,21 @@ func (t *Thread) newBlockHeader() (*pb.ThreadBlockHeader, error) {
 		Date:    pdate,
 		Author:  t.node().Identity.Pretty(),
 		Address: t.account.Address(),
+		Clock:   t.clock() + 1,
+		Epoch:   t.epoch(),
 	}, nil
 }
 
+// clock returns the greatest lamport clock of the locally known blocks
+func (t *Thread) clock() int64 {
+	query := fmt.Sprintf("threadId='%s'", t.Id)
+	list := t.datastore.Blocks().List("", 1, query, pb.Block_CAUSAL)
+	if len(list.Items) == 0 {
+		return 0
+	}
+	return list.Items[0].Clock
+}
+
 // commitResult wraps the results of a block commit
 type commitResult struct {
 	hash       mh.Multihash
@@ -421,10 +618,21 @@ type commitResult struct {
 
 // commitBlock encrypts a block with thread key (or custom method if provided) and adds it to ipfs
 func (t *Thread) commitBlock(msg proto.Message, mtype pb.Block_BlockType, add bool, encrypt func(plaintext []byte) ([]byte, error)) (*commitResult, error) {
+	return t.commitExpiringBlock(msg, mtype, add, encrypt, 0)
+}
+
+// commitExpiringBlock commits a block which should be purged after ttl, zero means never
+func (t *Thread) commitExpiringBlock(msg proto.Message, mtype pb.Block_BlockType, add bool, encrypt func(plaintext []byte) ([]byte, error), ttl time.Duration) (*commitResult, error) {
 	header, err := t.newBlockHeader()
 	if err != nil {
 		return nil, err
 	}
+	if ttl > 0 {
+		header.Expires, err = ptypes.TimestampProto(time.Now().Add(ttl))
+		if err != nil {
+			return nil, err
+		}
+	}
 	block := &pb.ThreadBlock{
 		Header: header,
 		Type:   mtype,
@@ -441,9 +649,11 @@ func (t *Thread) commitBlock(msg proto.Message, mtype pb.Block_BlockType, add bo
 		return nil, err
 	}
 
-	// encrypt, falling back to thread key
+	// encrypt, falling back to the thread key of the header's epoch
 	if encrypt == nil {
-		encrypt = t.Encrypt
+		encrypt = func(plaintext []byte) ([]byte, error) {
+			return t.encryptEpoch(header.Epoch, plaintext)
+		}
 	}
 	ciphertext, err := encrypt(plaintext)
 	if err != nil {
@@ -495,6 +705,9 @@ func (t *Thread) unmarshalBlock(ciphertext []byte) (*pb.ThreadBlock, error) {
 		if err != nil {
 			return nil, err
 		}
+		if epoch, _ := splitEpoch(ciphertext); epoch != 0 && epoch != block.Header.Epoch {
+			return nil, ErrInvalidThreadBlock
+		}
 	}
 
 	return block, nil
@@ -580,6 +793,8 @@ func (t *Thread) commitNode(index *pb.Block, additionalParents []string, addInde
 			Body:     index.Body,
 			Status:   pb.Block_READY,
 			Attempts: index.Attempts,
+			Expires:  index.Expires,
+			Clock:    index.Clock,
 		})
 		if err != nil {
 			return nil, err
@@ -792,14 +1007,8 @@ func (t *Thread) readable(addr string) bool {
 	switch t.ttype {
 	case pb.Thread_PRIVATE:
 		return false // should not happen
-	case pb.Thread_READ_ONLY:
-		return t.member(addr)
-	case pb.Thread_PUBLIC:
-		return t.member(addr)
-	case pb.Thread_OPEN:
-		return t.member(addr)
 	default:
-		return false
+		return t.member(addr) && t.role(addr) >= pb.ThreadMember_READER
 	}
 }
 
@@ -812,14 +1021,8 @@ func (t *Thread) annotatable(addr string) bool {
 	switch t.ttype {
 	case pb.Thread_PRIVATE:
 		return false // should not happen
-	case pb.Thread_READ_ONLY:
-		return false
-	case pb.Thread_PUBLIC:
-		return t.member(addr)
-	case pb.Thread_OPEN:
-		return t.member(addr)
 	default:
-		return false
+		return t.member(addr) && t.role(addr) >= pb.ThreadMember_ANNOTATOR
 	}
 }
 
@@ -832,14 +1035,8 @@ func (t *Thread) writable(addr string) bool {
 	switch t.ttype {
 	case pb.Thread_PRIVATE:
 		return false // should not happen
-	case pb.Thread_READ_ONLY:
-		return false
-	case pb.Thread_PUBLIC:
-		return false
-	case pb.Thread_OPEN:
-		return t.member(addr)
 	default:
-		return false
+		return t.member(addr) && t.role(addr) >= pb.ThreadMember_WRITER
 	}
 }
 
@@ -863,8 +1060,24 @@ func (t *Thread) shareable(from string, to string) bool {
 // member returns whether or not the given address is a thread member
 // NOTE: Thread whitelist are a fixed set of textile addresses specified
 // when a thread is created. If empty, _everyone_ is a member.
+// Member blocks may add or remove addresses afterwards, unless the thread
+// is a detached dummy used to check an invite.
 func (t *Thread) member(addr string) bool {
-	if len(t.whitelist) == 0 || addr == t.initiator {
+	if addr == t.initiator {
+		return true
+	}
+	if t.datastore != nil {
+		if m := t.datastore.ThreadMembers().Get(t.Id, addr); m != nil {
+			return !m.Removed
+		}
+	}
+	return t.whitelisted(addr)
+}
+
+// whitelisted returns whether or not the given address is in the thread whitelist,
+// which includes everyone if empty
+func (t *Thread) whitelisted(addr string) bool {
+	if len(t.whitelist) == 0 {
 		return true
 	}
 	for _, m := range t.whitelist {
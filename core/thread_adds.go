@@ -18,8 +18,13 @@ func (t *Thread) AddInvite(p *pb.Peer) (mh.Multihash, error) {
 	}
 
 	self := t.datastore.Peers().Get(t.node().Identity.Pretty())
+	mod := t.datastore.Threads().Get(t.Id)
+	if mod == nil {
+		return nil, errThreadReload
+	}
+	mod.Keys = t.Keys().Items
 	msg := &pb.ThreadAdd{
-		Thread:  t.datastore.Threads().Get(t.Id),
+		Thread:  mod,
 		Inviter: self,
 		Invitee: p.Id,
 	}
@@ -48,6 +53,7 @@ func (t *Thread) AddInvite(p *pb.Peer) (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_ADD,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Body:   msg.Invitee, // ugly and tmp way to retain invitee address when posting
 		Status: pb.Block_QUEUED,
 	})
@@ -67,8 +73,13 @@ func (t *Thread) AddExternalInvite() (mh.Multihash, []byte, error) {
 	defer t.lock.Unlock()
 
 	self := t.datastore.Peers().Get(t.node().Identity.Pretty())
+	mod := t.datastore.Threads().Get(t.Id)
+	if mod == nil {
+		return nil, nil, errThreadReload
+	}
+	mod.Keys = t.Keys().Items
 	msg := &pb.ThreadAdd{
-		Thread:  t.datastore.Threads().Get(t.Id),
+		Thread:  mod,
 		Inviter: self,
 	}
 
@@ -98,6 +109,7 @@ func (t *Thread) AddExternalInvite() (mh.Multihash, []byte, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_ADD,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Status: pb.Block_QUEUED,
 	})
 	if err != nil {
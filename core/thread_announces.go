@@ -44,6 +44,7 @@ func (t *Thread) annouce(msg *pb.ThreadAnnounce) (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_ANNOUNCE,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Status: pb.Block_QUEUED,
 	}, false)
 	if err != nil {
@@ -68,7 +69,7 @@ func (t *Thread) handleAnnounceBlock(block *pb.ThreadBlock) (handleResult, error
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.readable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_READER) {
 		return res, ErrNotReadable
 	}
 
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	icid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// threadArchiveVersion is the current thread archive format
+const threadArchiveVersion = 1
+
+// ThreadArchiveExt is the suggested filename extension of thread archives
+const ThreadArchiveExt = ".thread"
+
+// maxArchiveMessageSize is the largest header or block accepted from an archive
+const maxArchiveMessageSize = 32 << 20
+
+// ErrInvalidThreadArchive indicates an archive could not be read
+var ErrInvalidThreadArchive = fmt.Errorf("invalid thread archive")
+
+// ErrThreadKeyRequired indicates an archive without a key is for an unknown thread
+var ErrThreadKeyRequired = fmt.Errorf("archive does not include the thread key")
+
+// ExportThread writes a thread archive, which includes the thread DAG, the files and schemas
+// it references, and optionally the thread key. The archive format is specific to textile,
+// it's not a CAR file: a ThreadArchive header is followed by a ThreadArchiveBlock for each
+// ipfs block, all protobufs with uvarint length prefixes.
+// Note: Blocks that are no longer stored locally, e.g., purged or pending download, are skipped.
+func (t *Textile) ExportThread(id string, key bool, w io.Writer) error {
+	thread := t.Thread(id)
+	if thread == nil {
+		return ErrThreadNotFound
+	}
+	mod := t.datastore.Threads().Get(thread.Id)
+	if mod == nil {
+		return errThreadReload
+	}
+	if key {
+		mod.Keys = thread.Keys().Items
+	} else {
+		mod.Sk = nil
+	}
+
+	schemas := t.datastore.ThreadSchemas().List(thread.Id).Items
+	err := writeArchiveMessage(w, &pb.ThreadArchive{
+		Version: threadArchiveVersion,
+		Thread:  mod,
+		Schemas: schemas,
+	})
+	if err != nil {
+		return err
+	}
+
+	// older blocks aren't linked to their parents, so each indexed block is a root
+	roots := util.SplitString(mod.Head, ",")
+	for _, schema := range schemas {
+		roots = append(roots, schema.Hash)
+	}
+	query := fmt.Sprintf("threadId='%s' and status!=%d", thread.Id, pb.Block_PENDING)
+	for _, block := range t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items {
+		roots = append(roots, block.Id)
+		if block.Data != "" {
+			roots = append(roots, block.Data)
+		}
+	}
+
+	visited := make(map[icid.Cid]struct{})
+	var stack []icid.Cid
+	for _, root := range roots {
+		id, err := icid.Decode(root)
+		if err != nil {
+			return err
+		}
+		stack = append(stack, id)
+	}
+
+	var count, skipped int
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+
+		node, err := ipfs.LocalNodeAtCid(t.node, id)
+		if err != nil {
+			log.Debugf("skipping %s: %s", id.String(), err)
+			skipped++
+			continue
+		}
+		err = writeArchiveMessage(w, &pb.ThreadArchiveBlock{
+			Cid:  id.Bytes(),
+			Data: node.RawData(),
+		})
+		if err != nil {
+			return err
+		}
+		count++
+
+		for _, link := range node.Links() {
+			stack = append(stack, link.Cid)
+		}
+	}
+
+	log.Debugf("exported %d blocks from %s, skipped %d", count, thread.Id, skipped)
+
+	return nil
+}
+
+// ImportThread restores a thread from an archive. The ipfs blocks are added locally
+// before the thread is added or updated, so that blocks and files are indexed without
+// network access. An archive without a key can only update a known thread.
+func (t *Textile) ImportThread(r io.Reader) (*pb.Thread, error) {
+	reader := bufio.NewReader(r)
+
+	archive := new(pb.ThreadArchive)
+	err := readArchiveMessage(reader, archive)
+	if err != nil {
+		return nil, err
+	}
+	if archive.Version != threadArchiveVersion || archive.Thread == nil {
+		return nil, ErrInvalidThreadArchive
+	}
+	mod := archive.Thread
+
+	existing := t.datastore.Threads().Get(mod.Id)
+	if len(mod.Sk) == 0 {
+		if existing == nil {
+			return nil, ErrThreadKeyRequired
+		}
+		mod.Sk = existing.Sk
+	}
+
+	var count int
+	for {
+		block := new(pb.ThreadArchiveBlock)
+		err = readArchiveMessage(reader, block)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := icid.Cast(block.Cid)
+		if err != nil {
+			return nil, err
+		}
+		err = ipfs.PutLocalNode(t.node, id, block.Data)
+		if err != nil {
+			return nil, err
+		}
+		count++
+	}
+
+	// older schema versions are needed to validate older files
+	if existing == nil {
+		for _, schema := range archive.Schemas {
+			err = t.datastore.ThreadSchemas().Put(&pb.ThreadSchema{
+				Thread:  mod.Id,
+				Version: schema.Version,
+				Hash:    schema.Hash,
+				Date:    schema.Date,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = t.AddOrUpdateThread(mod)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("imported %d blocks to %s", count, mod.Id)
+
+	return t.ThreadView(mod.Id)
+}
+
+// ThreadArchiveHeader reads the header of a thread archive
+func ThreadArchiveHeader(r io.Reader) (*pb.ThreadArchive, error) {
+	archive := new(pb.ThreadArchive)
+	err := readArchiveMessage(bufio.NewReader(r), archive)
+	if err != nil {
+		return nil, err
+	}
+	if archive.Version != threadArchiveVersion || archive.Thread == nil {
+		return nil, ErrInvalidThreadArchive
+	}
+	return archive, nil
+}
+
+// writeArchiveMessage writes a length prefixed message
+func writeArchiveMessage(w io.Writer, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	prefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, uint64(len(data)))
+	_, err = w.Write(prefix[:n])
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readArchiveMessage reads a length prefixed message, returning io.EOF at the end
+func readArchiveMessage(r *bufio.Reader, msg proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return ErrInvalidThreadArchive
+	}
+	if size > maxArchiveMessageSize {
+		return ErrInvalidThreadArchive
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return ErrInvalidThreadArchive
+	}
+	return proto.Unmarshal(data, msg)
+}
@@ -34,6 +34,7 @@ func (t *Thread) AddComment(target string, body string) (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_COMMENT,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Target: target,
 		Body:   body,
 		Status: pb.Block_QUEUED,
@@ -62,7 +63,7 @@ func (t *Thread) handleCommentBlock(block *pb.ThreadBlock) (handleResult, error)
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.annotatable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_ANNOTATOR) {
 		return res, ErrNotAnnotatable
 	}
 
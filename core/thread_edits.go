@@ -0,0 +1,200 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	ipld "github.com/ipfs/go-ipld-format"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrNotEditable indicates a block can't be edited by the sender
+var ErrNotEditable = fmt.Errorf("only messages and files can be edited by their author")
+
+// AddEdit adds an outgoing edit block, which replaces the body of a message
+// or the caption of a files block.
+// Note: Ignoring an edit block retracts it, restoring the previous revision.
+func (t *Thread) AddEdit(target string, body string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, err := t.editTarget(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.addEdit(target, &pb.ThreadEdit{
+		Body: strings.TrimSpace(body),
+	}, nil)
+}
+
+// AddFilesEdit adds an outgoing edit block, which replaces the caption and files of a
+// files block while keeping its position and annotations.
+func (t *Thread) AddFilesEdit(target string, caption string, node ipld.Node, keys map[string]string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	tblock, err := t.editTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	if tblock.Type != pb.Block_FILES {
+		return nil, ErrNotEditable
+	}
+	if t.Schema == nil {
+		return nil, ErrThreadSchemaRequired
+	}
+	if node == nil {
+		return nil, ErrInvalidFileNode
+	}
+
+	// validate and apply schema directives
+	err = t.processFileData(t.Schema, node, keys, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.addEdit(target, &pb.ThreadEdit{
+		Body: strings.TrimSpace(caption),
+		Data: node.Cid().Hash().B58String(),
+		Keys: keys,
+	}, node)
+}
+
+// editTarget returns the block to edit if the local peer is allowed to edit it
+func (t *Thread) editTarget(target string) (*pb.Block, error) {
+	if !t.writable(t.config.Account.Address) {
+		return nil, ErrNotWritable
+	}
+
+	tblock := t.datastore.Blocks().Get(target)
+	if tblock == nil || tblock.Thread != t.Id {
+		return nil, ErrBlockNotFound
+	}
+	if !t.editable(tblock, t.node().Identity.Pretty(), t.config.Account.Address) {
+		return nil, ErrNotEditable
+	}
+	return tblock, nil
+}
+
+// addEdit commits and indexes an edit block, along with the files node of a files edit
+func (t *Thread) addEdit(target string, msg *pb.ThreadEdit, node ipld.Node) (mh.Multihash, error) {
+	res, err := t.commitBlock(msg, pb.Block_EDIT, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if node != nil {
+		// add cafe store requests for the entire graph
+		err = t.cafeReqFileData(node, res.hash.B58String(), "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_EDIT,
+		Date:   res.header.Date,
+		Clock:  res.header.Clock,
+		Target: target,
+		Data:   msg.Data,
+		Body:   msg.Body,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if node != nil {
+		err = t.indexFileData(node, msg.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debugf("added EDIT to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleEditBlock handles an incoming edit block.
+// Blocks are handled newest first, so the target may not be known yet. In that case
+// the edit is indexed and authorship is checked again when revisions are loaded.
+func (t *Thread) handleEditBlock(bnode *blockNode, block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadEdit)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.permits(block.Header, pb.ThreadMember_WRITER) {
+		return res, ErrNotWritable
+	}
+	if bnode.target == "" {
+		return res, ErrNotEditable
+	}
+
+	tblock := t.datastore.Blocks().Get(bnode.target)
+	if tblock != nil && !t.editable(tblock, block.Header.Author, block.Header.Address) {
+		return res, ErrNotEditable
+	}
+
+	if msg.Data != "" {
+		if tblock != nil && tblock.Type != pb.Block_FILES {
+			return res, ErrNotEditable
+		}
+		if t.Schema == nil {
+			return res, ErrThreadSchemaRequired
+		}
+		err = t.handleFileData(msg.Data, msg.Keys)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	res.oldData = msg.Data
+	res.body = msg.Body
+	return res, nil
+}
+
+// editable returns whether or not a block can be edited by the given peer and account
+func (t *Thread) editable(target *pb.Block, author string, address string) bool {
+	switch target.Type {
+	case pb.Block_TEXT, pb.Block_FILES:
+	default:
+		return false
+	}
+	if target.Author == author {
+		return true
+	}
+	return address != "" && t.peerAddress(target.Author) == address
+}
+
+// peerAddress returns the account address of a peer, if known
+func (t *Thread) peerAddress(id string) string {
+	if id == t.node().Identity.Pretty() {
+		return t.config.Account.Address
+	}
+	peer := t.datastore.Peers().Get(id)
+	if peer == nil {
+		return ""
+	}
+	return peer.Address
+}
+
+// interacted returns whether or not this peer has annotated or replied to a block
+func (t *Thread) interacted(blockId string) bool {
+	query := fmt.Sprintf("target='%s' and authorId='%s' and type!=%d",
+		blockId, t.node().Identity.Pretty(), pb.Block_EDIT)
+	return len(t.datastore.Blocks().List("", 1, query, pb.Block_DATE).Items) > 0
+}
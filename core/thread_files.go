@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
 	icid "github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
@@ -22,8 +22,9 @@ import (
 	"github.com/xeipuuv/gojsonschema"
 )
 
-// AddFile adds an outgoing files block
-func (t *Thread) AddFiles(node ipld.Node, target string, caption string, keys map[string]string) (mh.Multihash, error) {
+// AddFile adds an outgoing files block. A non-zero ttl makes the block expire,
+// after which it and its files are purged by all peers.
+func (t *Thread) AddFiles(node ipld.Node, target string, caption string, keys map[string]string, ttl time.Duration) (mh.Multihash, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
@@ -46,7 +47,7 @@ func (t *Thread) AddFiles(node ipld.Node, target string, caption string, keys ma
 
 	// pre-hash the block, we only want to add it if validation passes,
 	// but we need the hash for the sync group
-	res, err := t.commitBlock(msg, pb.Block_FILES, false, nil)
+	res, err := t.commitExpiringBlock(msg, pb.Block_FILES, false, nil, ttl)
 	if err != nil {
 		return nil, err
 	}
@@ -71,15 +72,17 @@ func (t *Thread) AddFiles(node ipld.Node, target string, caption string, keys ma
 
 	data := node.Cid().Hash().B58String()
 	err = t.indexBlock(&pb.Block{
-		Id:     res.hash.B58String(),
-		Thread: t.Id,
-		Author: res.header.Author,
-		Type:   pb.Block_FILES,
-		Date:   res.header.Date,
-		Target: target,
-		Data:   data,
-		Body:   msg.Body,
-		Status: pb.Block_QUEUED,
+		Id:      res.hash.B58String(),
+		Thread:  t.Id,
+		Author:  res.header.Author,
+		Type:    pb.Block_FILES,
+		Date:    res.header.Date,
+		Clock:   res.header.Clock,
+		Target:  target,
+		Data:    data,
+		Body:    msg.Body,
+		Status:  pb.Block_QUEUED,
+		Expires: res.header.Expires,
 	}, false)
 	if err != nil {
 		return nil, err
@@ -108,7 +111,7 @@ func (t *Thread) handleFilesBlock(bnode *blockNode, block *pb.ThreadBlock) (hand
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.writable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_WRITER) {
 		return res, ErrNotWritable
 	}
 
@@ -116,7 +119,6 @@ func (t *Thread) handleFilesBlock(bnode *blockNode, block *pb.ThreadBlock) (hand
 		return res, ErrThreadSchemaRequired
 	}
 
-	var node ipld.Node
 	var data string
 	if msg.Target != "" {
 		data = msg.Target
@@ -126,7 +128,7 @@ func (t *Thread) handleFilesBlock(bnode *blockNode, block *pb.ThreadBlock) (hand
 
 	var ignore bool
 	query := fmt.Sprintf("target='%s' and type=%d", bnode.hash, pb.Block_IGNORE)
-	ignored := t.datastore.Blocks().List("", -1, query).Items
+	ignored := t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items
 	if len(ignored) > 0 {
 		// ignore if the first (latest) ignore came after (could happen during back prop)
 		if util.ProtoTsIsNewer(ignored[0].Date, block.Header.Date) {
@@ -134,74 +136,77 @@ func (t *Thread) handleFilesBlock(bnode *blockNode, block *pb.ThreadBlock) (hand
 		}
 	}
 	if !ignore {
-		tcid, err := icid.Parse(data)
-		if err != nil {
-			return res, err
-		}
-		node, err = ipfs.NodeAtCid(t.node(), tcid)
-		if err != nil {
-			return res, err
-		}
-		err = ipfs.PinNode(t.node(), node, false)
+		err = t.handleFileData(data, msg.Keys)
 		if err != nil {
 			return res, err
 		}
+	}
 
-		// validate and apply schema directives
-		err = t.processFileData(t.Schema, node, msg.Keys, true)
+	res.oldData = msg.Target // not a typo, old target is now data
+	res.body = msg.Body
+	return res, nil
+}
+
+// handleFileData validates and indexes inbound files data, decrypting file indexes with keys
+func (t *Thread) handleFileData(data string, keys map[string]string) error {
+	tcid, err := icid.Parse(data)
+	if err != nil {
+		return err
+	}
+	node, err := ipfs.NodeAtCid(t.node(), tcid)
+	if err != nil {
+		return err
+	}
+	err = ipfs.PinNode(t.node(), node, false)
+	if err != nil {
+		return err
+	}
+
+	// validate and apply schema directives
+	err = t.processFileData(t.Schema, node, keys, true)
+	if err != nil {
+		return err
+	}
+
+	// use keys to decrypt each file
+	for pth, key := range keys {
+		fd, err := ipfs.DataAtPath(t.node(), data+pth+MetaLinkName)
 		if err != nil {
-			return res, err
+			return err
 		}
 
-		// use msg keys to decrypt each file
-		for pth, key := range msg.Keys {
-			fd, err := ipfs.DataAtPath(t.node(), data+pth+MetaLinkName)
+		var plaintext []byte
+		if key != "" {
+			keyb, err := base58.Decode(key)
 			if err != nil {
-				return res, err
-			}
-
-			var plaintext []byte
-			if key != "" {
-				keyb, err := base58.Decode(key)
-				if err != nil {
-					return res, err
-				}
-				plaintext, err = crypto.DecryptAES(fd, keyb)
-				if err != nil {
-					return res, err
-				}
-			} else {
-				plaintext = fd
+				return err
 			}
-
-			var file pb.FileIndex
-			err = jsonpb.Unmarshal(bytes.NewReader(plaintext), &file)
+			plaintext, err = crypto.DecryptAES(fd, keyb)
 			if err != nil {
-				return res, err
+				return err
 			}
+		} else {
+			plaintext = fd
+		}
 
-			log.Debugf("received file: %s", file.Hash)
-
-			err = t.datastore.Files().Add(&file)
-			if err != nil {
-				if !db.ConflictError(err) {
-					return res, err
-				}
-				log.Debugf("file exists: %s", file.Hash)
-			}
+		var file pb.FileIndex
+		err = pbUnmarshaler.Unmarshal(bytes.NewReader(plaintext), &file)
+		if err != nil {
+			return err
 		}
-	}
 
-	if !ignore {
-		err = t.indexFileData(node, data)
+		log.Debugf("received file: %s", file.Hash)
+
+		err = t.datastore.Files().Add(&file)
 		if err != nil {
-			return res, err
+			if !db.ConflictError(err) {
+				return err
+			}
+			log.Debugf("file exists: %s", file.Hash)
 		}
 	}
 
-	res.oldData = msg.Target // not a typo, old target is now data
-	res.body = msg.Body
-	return res, nil
+	return t.indexFileData(node, data)
 }
 
 // removeFiles unpins and removes linked files unless they are used by another block
@@ -211,7 +216,7 @@ func (t *Thread) removeFiles(node ipld.Node) error {
 	}
 
 	data := node.Cid().Hash().B58String()
-	blocks := t.datastore.Blocks().List("", -1, "data='"+data+"'").Items
+	blocks := t.datastore.Blocks().List("", -1, "data='"+data+"'", pb.Block_DATE).Items
 	if len(blocks) == 1 { // safe to unpin data node
 		err := ipfs.UnpinNode(t.node(), node, false)
 		if err != nil {
@@ -240,14 +245,31 @@ func (t *Thread) removeFiles(node ipld.Node) error {
 	return nil
 }
 
-// processFileData ensures each link points to a dag described by the thread schema
+// processFileData ensures each link points to a dag described by the thread schema.
+// Inbound data may have been added under an older schema version, so those are
+// tried in turn if validation against the current schema fails.
 func (t *Thread) processFileData(node *pb.Node, inode ipld.Node, keys map[string]string, inbound bool) error {
+	err := t.processFileDataWithSchema(node, inode, keys, inbound)
+	if err != schema.ErrFileValidationFailed || !inbound {
+		return err
+	}
+
+	for _, prev := range t.previousSchemas() {
+		if t.processFileDataWithSchema(prev, inode, keys, inbound) == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// processFileDataWithSchema ensures each link points to a dag described by node
+func (t *Thread) processFileDataWithSchema(node *pb.Node, inode ipld.Node, keys map[string]string, inbound bool) error {
 	for i, link := range inode.Links() {
 		nd, err := ipfs.NodeAtLink(t.node(), link)
 		if err != nil {
 			return err
 		}
-		err = t.processFileNode(t.Schema, nd, i, keys, inbound)
+		err = t.processFileNode(node, nd, i, keys, inbound)
 		if err != nil {
 			return err
 		}
@@ -267,6 +289,9 @@ func (t *Thread) processFileNode(node *pb.Node, inode ipld.Node, index int, keys
 		// ensure link is present
 		link := schema.LinkByName(inode.Links(), []string{name})
 		if link == nil {
+			if l.Optional {
+				continue
+			}
 			return schema.ErrFileValidationFailed
 		}
 
@@ -563,5 +588,20 @@ func (t *Thread) cafeReqFileLink(inode ipld.Node, settings *CafeRequestSettings)
 		return err
 	}
 
+	// chunked content is stored link by link so that cafes only receive new chunks
+	file := t.datastore.Files().Get(dlink.Cid.Hash().B58String())
+	if file != nil && file.Encryption == pb.FileIndex_AES_GCM_CHUNKS {
+		dnode, err := ipfs.NodeAtLink(t.node(), dlink)
+		if err != nil {
+			return err
+		}
+		for _, l := range dnode.Links() {
+			err = t.cafeOutbox.Add(l.Cid.Hash().B58String(), pb.CafeRequest_STORE, opts...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
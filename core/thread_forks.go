@@ -0,0 +1,211 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipld "github.com/ipfs/go-ipld-format"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/mr-tron/base58/base58"
+	"github.com/textileio/go-textile/crypto"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
+	"github.com/textileio/go-textile/util"
+)
+
+// ForkThread adds a new thread from the history of an existing one.
+// Messages and files blocks between from and to (inclusive, defaulting to the whole
+// thread) are re-published to the fork, oldest first, by the local peer. Message
+// bodies are encrypted with the fork's key and files are re-encrypted under fresh keys,
+// so the fork shares no keys with its source. The fork is removed if any block fails.
+// The fork keeps the source schema, while the name, type, sharing, and whitelist
+// come from conf.
+// Note: Annotations are not carried over. Replies keep their parent if it was forked.
+func (t *Textile) ForkThread(id string, conf pb.AddThreadConfig, sk libp2pc.PrivKey, from string, to string) (*Thread, error) {
+	source := t.Thread(id)
+	if source == nil {
+		return nil, ErrThreadNotFound
+	}
+	if !source.readable(t.account.Address()) {
+		return nil, ErrNotReadable
+	}
+
+	query := fmt.Sprintf("threadId='%s' and (type=%d or type=%d)"+
+		" and id not in (select target from blocks where type=%d)",
+		source.Id, pb.Block_TEXT, pb.Block_FILES, pb.Block_IGNORE)
+	for _, bound := range []struct {
+		id string
+		op string
+	}{{from, ">="}, {to, "<="}} {
+		if bound.id == "" {
+			continue
+		}
+		block := t.datastore.Blocks().Get(bound.id)
+		if block == nil || block.Thread != source.Id {
+			return nil, ErrBlockNotFound
+		}
+		query += fmt.Sprintf(" and date%s%d", bound.op, util.ProtoNanos(block.Date))
+	}
+	blocks := t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items
+
+	conf.Schema = nil
+	if source.schemaId != "" {
+		conf.Schema = &pb.AddThreadConfig_Schema{Id: source.schemaId}
+	}
+	fork, err := t.AddThread(conf, sk, t.account.Address(), true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	forked, err := t.forkBlocks(fork, blocks)
+	if err != nil {
+		if _, rerr := t.RemoveThread(fork.Id); rerr != nil {
+			log.Errorf("error removing partial fork %s: %s", fork.Id, rerr)
+		}
+		return nil, err
+	}
+
+	log.Debugf("forked %d blocks from %s to %s", len(forked), source.Id, fork.Id)
+
+	return fork, nil
+}
+
+// forkBlocks re-publishes blocks (listed newest first) to fork, oldest first,
+// returning a map of source block ids to their forked ids
+func (t *Textile) forkBlocks(fork *Thread, blocks []*pb.Block) (map[string]string, error) {
+	// maps source blocks to their fork so that replies keep their parent
+	forked := make(map[string]string)
+	now := time.Now().UnixNano()
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block := blocks[i]
+
+		// keep the remaining lifetime of expiring blocks
+		var ttl time.Duration
+		if block.Expires != nil {
+			ttl = time.Duration(util.ProtoNanos(block.Expires) - now)
+			if ttl <= 0 {
+				continue
+			}
+		}
+
+		body := block.Body
+		revs := t.revisions(block)
+		if len(revs) > 0 {
+			body = revs[0].Body
+		}
+		target := forked[block.Target]
+
+		switch block.Type {
+		case pb.Block_TEXT:
+			hash, err := fork.AddMessage(target, body, ttl)
+			if err != nil {
+				return nil, err
+			}
+			forked[block.Id] = hash.B58String()
+
+		case pb.Block_FILES:
+			node, keys, err := t.forkFiles(filesData(block, revs))
+			if err != nil {
+				return nil, err
+			}
+			hash, err := fork.AddFiles(node, target, body, keys.Files, ttl)
+			if err != nil {
+				return nil, err
+			}
+			forked[block.Id] = hash.B58String()
+		}
+	}
+
+	return forked, nil
+}
+
+// forkFiles re-encrypts the files at data under fresh keys, returning a new node
+func (t *Textile) forkFiles(data string) (ipld.Node, *pb.Keys, error) {
+	files, err := t.fileAtData(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var singles []*pb.FileIndex
+	dirs := &pb.DirectoryList{}
+	for _, f := range files {
+		if f == nil || (f.File == nil && len(f.Links) == 0) {
+			return nil, nil, ErrFileNotFound
+		}
+
+		if f.File != nil {
+			file, err := t.rekeyFile(f.File)
+			if err != nil {
+				return nil, nil, err
+			}
+			singles = append(singles, file)
+			continue
+		}
+
+		dir := &pb.Directory{Files: make(map[string]*pb.FileIndex)}
+		for name, link := range f.Links {
+			file, err := t.rekeyFile(link)
+			if err != nil {
+				return nil, nil, err
+			}
+			dir.Files[name] = file
+		}
+		dirs.Items = append(dirs.Items, dir)
+	}
+
+	if len(dirs.Items) == 0 {
+		return t.AddNodeFromFiles(singles)
+	}
+	if len(singles) > 0 {
+		return nil, nil, ErrInvalidFileNode
+	}
+	return t.AddNodeFromDirs(dirs)
+}
+
+// rekeyFile indexes a copy of file's content encrypted under a fresh key.
+// Plaintext files are returned as is.
+func (t *Textile) rekeyFile(file *pb.FileIndex) (*pb.FileIndex, error) {
+	if file.Key == "" {
+		return file, nil
+	}
+
+	content, err := t.FileIndexContent(file)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	key, err := crypto.GenerateAESKey()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := crypto.EncryptAESStream(content, key)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := ipfs.AddStream(t.node, reader, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// the copy gets its own identity so it never dedupes with the original
+	// or other adds of the same content
+	check := t.checksum(append([]byte(file.Checksum), key...), false)
+	model := proto.Clone(file).(*pb.FileIndex)
+	model.Checksum = check
+	model.Source = check
+	model.Hash = hash.Hash().B58String()
+	model.Key = base58.FastBase58Encoding(key)
+	model.Encryption = pb.FileIndex_AES_GCM_STREAM
+	model.Added = ptypes.TimestampNow()
+	model.Targets = nil
+
+	err = t.datastore.Files().Add(model)
+	if err != nil && !db.ConflictError(err) {
+		return nil, err
+	}
+	return t.datastore.Files().Get(model.Hash), nil
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes"
@@ -29,6 +30,7 @@ func (t *Thread) AddIgnore(block string) (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_IGNORE,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Target: block,
 		Status: pb.Block_QUEUED,
 	}, false)
@@ -67,7 +69,7 @@ func (t *Thread) handleIgnoreBlock(bnode *blockNode, block *pb.ThreadBlock) (han
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.annotatable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_ANNOTATOR) {
 		return res, ErrNotAnnotatable
 	}
 
@@ -102,6 +104,16 @@ func (t *Thread) ignoreBlockTarget(block *pb.Block) error {
 
 	switch block.Type {
 	case pb.Block_FILES:
+		// replaced files go with the block
+		query := fmt.Sprintf("type=%d and target='%s' and data!=''", pb.Block_EDIT, block.Id)
+		for _, edit := range t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items {
+			err := t.ignoreBlockTarget(edit)
+			if err != nil {
+				return err
+			}
+		}
+		fallthrough
+	case pb.Block_EDIT:
 		if block.Data == "" {
 			return nil
 		}
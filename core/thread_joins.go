@@ -36,6 +36,7 @@ func (t *Thread) join(inviter string) (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_JOIN,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Status: pb.Block_QUEUED,
 	}, false)
 	if err != nil {
@@ -60,7 +61,7 @@ func (t *Thread) handleJoinBlock(block *pb.ThreadBlock) (handleResult, error) {
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.readable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_READER) {
 		return res, ErrNotReadable
 	}
 
@@ -0,0 +1,282 @@
+package core
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
+	"github.com/textileio/go-textile/util"
+)
+
+// RotateKey adds an outgoing key block, which re-keys the thread. The new key is
+// encrypted for each remaining member account, except those excluded, and all
+// following blocks are encrypted with it.
+// Note: The key block itself is encrypted with the previous key.
+func (t *Thread) RotateKey(exclude ...string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.admin(t.config.Account.Address) {
+		return nil, ErrNotAdmin
+	}
+
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	skb, err := sk.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.ThreadRekey{
+		Epoch: t.epoch() + 1,
+		Keys:  make(map[string][]byte),
+	}
+	for _, addr := range t.keyRecipients(exclude) {
+		kp, err := keypair.Parse(addr)
+		if err != nil {
+			return nil, err
+		}
+		ciphertext, err := kp.Encrypt(skb)
+		if err != nil {
+			return nil, err
+		}
+		msg.Keys[addr] = ciphertext
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_KEY, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	id := res.hash.B58String()
+	err = t.indexBlock(&pb.Block{
+		Id:     id,
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_KEY,
+		Date:   res.header.Date,
+		Clock:  res.header.Clock,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.addKey(&pb.ThreadKey{
+		Thread: t.Id,
+		Epoch:  msg.Epoch,
+		Sk:     skb,
+		Block:  id,
+		Date:   res.header.Date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added KEY to %s: %s", t.Id, id)
+
+	return res.hash, nil
+}
+
+// handleKeyBlock handles an incoming key block
+func (t *Thread) handleKeyBlock(bnode *blockNode, block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadRekey)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.permits(block.Header, pb.ThreadMember_ADMIN) {
+		return res, ErrNotAdmin
+	}
+
+	ciphertext, ok := msg.Keys[t.config.Account.Address]
+	if !ok {
+		log.Warningf("key block %s does not include a key for this account", bnode.hash)
+		return res, nil
+	}
+	skb, err := t.account.Decrypt(ciphertext)
+	if err != nil {
+		return res, err
+	}
+	if _, err := ipfs.UnmarshalPrivateKey(skb); err != nil {
+		return res, err
+	}
+
+	err = t.addKey(&pb.ThreadKey{
+		Thread: t.Id,
+		Epoch:  msg.Epoch,
+		Sk:     skb,
+		Block:  bnode.hash,
+		Date:   block.Header.Date,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// Keys returns the rotated keys of this thread, newest first
+func (t *Thread) Keys() *pb.ThreadKeyList {
+	return t.datastore.ThreadKeys().ListByThread(t.Id)
+}
+
+// addKey saves a rotated key, ignoring keys that are already known
+func (t *Thread) addKey(key *pb.ThreadKey) error {
+	err := t.datastore.ThreadKeys().Add(key)
+	if err != nil && !db.ConflictError(err) {
+		return err
+	}
+	return nil
+}
+
+// addKeys saves rotated keys received with an invite or snapshot
+func (t *Thread) addKeys(keys []*pb.ThreadKey) error {
+	for _, key := range keys {
+		if _, err := ipfs.UnmarshalPrivateKey(key.Sk); err != nil {
+			return err
+		}
+		err := t.addKey(&pb.ThreadKey{
+			Thread: t.Id,
+			Epoch:  key.Epoch,
+			Sk:     key.Sk,
+			Block:  key.Block,
+			Date:   key.Date,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// epoch returns the current key epoch
+func (t *Thread) epoch() int32 {
+	keys := t.Keys().Items
+	if len(keys) == 0 {
+		return 0
+	}
+	return keys[0].Epoch
+}
+
+// keyRecipients returns the member accounts that should receive a new key
+func (t *Thread) keyRecipients(exclude []string) []string {
+	excluded := make(map[string]struct{})
+	for _, addr := range exclude {
+		excluded[addr] = struct{}{}
+	}
+	set := make(map[string]struct{})
+	add := func(addr string) {
+		if _, ok := excluded[addr]; ok {
+			return
+		}
+		if addr != "" && t.readable(addr) {
+			set[addr] = struct{}{}
+		}
+	}
+
+	add(t.config.Account.Address)
+	add(t.initiator)
+	for _, tp := range t.Peers() {
+		if p := t.datastore.Peers().Get(tp.Id); p != nil {
+			add(p.Address)
+		}
+	}
+	for _, m := range t.Members().Items {
+		if !m.Removed {
+			add(m.Address)
+		}
+	}
+
+	var list []string
+	for addr := range set {
+		list = append(list, addr)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// rotateKeyOnLeave re-keys the thread once the last peer of an account has left,
+// so that it can't read what follows. Only one admin re-keys: the lowest admin
+// address still in the thread, or the initiator if there is none. Leaves from
+// before the latest key block are assumed to have been handled by whoever added it.
+func (t *Thread) rotateKeyOnLeave() error {
+	self := t.config.Account.Address
+	if !t.admin(self) {
+		return nil
+	}
+
+	query := fmt.Sprintf("threadId='%s' and type=%d", t.Id, pb.Block_LEAVE)
+	kquery := fmt.Sprintf("threadId='%s' and type=%d", t.Id, pb.Block_KEY)
+	if keys := t.datastore.Blocks().List("", 1, kquery, pb.Block_DATE).Items; len(keys) > 0 {
+		query += fmt.Sprintf(" and date>%d", util.ProtoNanos(keys[0].Date))
+	}
+	leaves := t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	remaining := map[string]struct{}{self: {}}
+	for _, tp := range t.Peers() {
+		if p := t.datastore.Peers().Get(tp.Id); p != nil {
+			remaining[p.Address] = struct{}{}
+		}
+	}
+	if t.rotator(remaining) != self {
+		return nil
+	}
+
+	departed := make(map[string]struct{})
+	for _, leave := range leaves {
+		peer := t.datastore.Peers().Get(leave.Author)
+		if peer == nil || peer.Address == "" || peer.Address == t.initiator ||
+			peer.Address == self {
+			continue
+		}
+		if _, ok := remaining[peer.Address]; !ok {
+			departed[peer.Address] = struct{}{}
+		}
+	}
+	if len(departed) == 0 {
+		return nil
+	}
+
+	var exclude []string
+	for addr := range departed {
+		exclude = append(exclude, addr)
+	}
+	sort.Strings(exclude)
+	_, err := t.RotateKey(exclude...)
+	return err
+}
+
+// rotator returns the admin that should re-key the thread after leaves, the lowest
+// remaining admin address, falling back to the initiator
+func (t *Thread) rotator(remaining map[string]struct{}) string {
+	var admins []string
+	for addr := range remaining {
+		if addr != "" && t.admin(addr) {
+			admins = append(admins, addr)
+		}
+	}
+	if len(admins) == 0 {
+		return t.initiator
+	}
+	sort.Strings(admins)
+	return admins[0]
+}
@@ -27,6 +27,7 @@ func (t *Thread) leave() (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_LEAVE,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Status: pb.Block_QUEUED,
 	}, false)
 	if err != nil {
@@ -35,7 +36,7 @@ func (t *Thread) leave() (mh.Multihash, error) {
 
 	// cleanup
 	query := fmt.Sprintf("threadId='%s'", t.Id)
-	for _, block := range t.datastore.Blocks().List("", -1, query).Items {
+	for _, block := range t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items {
 		err = t.ignoreBlockTarget(block)
 		if err != nil {
 			return nil, err
@@ -66,7 +67,7 @@ func (t *Thread) handleLeaveBlock(block *pb.ThreadBlock) (handleResult, error) {
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.readable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_READER) {
 		return res, ErrNotReadable
 	}
 
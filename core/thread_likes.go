@@ -26,6 +26,7 @@ func (t *Thread) AddLike(target string) (mh.Multihash, error) {
 		Author: res.header.Author,
 		Type:   pb.Block_LIKE,
 		Date:   res.header.Date,
+		Clock:  res.header.Clock,
 		Target: target,
 		Status: pb.Block_QUEUED,
 	}, false)
@@ -53,7 +54,7 @@ func (t *Thread) handleLikeBlock(block *pb.ThreadBlock) (handleResult, error) {
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.annotatable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_ANNOTATOR) {
 		return res, ErrNotAnnotatable
 	}
 
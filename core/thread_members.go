@@ -0,0 +1,286 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
+	"github.com/textileio/go-textile/util"
+)
+
+// ErrNotAdmin indicates the sender is not allowed to change thread membership or policy
+var ErrNotAdmin = fmt.Errorf("thread membership and policy can only be changed by an admin")
+
+// ErrInvalidMember indicates a membership change is not valid
+var ErrInvalidMember = fmt.Errorf("invalid membership change")
+
+// AddMember adds an outgoing member block which changes the membership or role of an address
+func (t *Thread) AddMember(address string, action pb.ThreadMembership_Action, role pb.ThreadMember_Role) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.admin(t.config.Account.Address) {
+		return nil, ErrNotAdmin
+	}
+
+	msg := &pb.ThreadMembership{
+		Address: address,
+		Action:  action,
+		Role:    role,
+	}
+	err := t.validateMembership(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_MEMBER, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	id := res.hash.B58String()
+	err = t.indexBlock(&pb.Block{
+		Id:     id,
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_MEMBER,
+		Date:   res.header.Date,
+		Clock:  res.header.Clock,
+		Target: address,
+		Body:   membershipBody(msg),
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.applyMembership(msg, res.header.Clock, res.header.Date, id)
+	if err != nil {
+		return nil, err
+	}
+	t.handleDeferred()
+
+	log.Debugf("added MEMBER to %s: %s", t.Id, id)
+
+	return res.hash, nil
+}
+
+// Members returns the explicit membership state of this thread
+func (t *Thread) Members() *pb.ThreadMemberList {
+	return t.datastore.ThreadMembers().ListByThread(t.Id)
+}
+
+// handleMemberBlock handles an incoming member block
+func (t *Thread) handleMemberBlock(bnode *blockNode, block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadMembership)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.permits(block.Header, pb.ThreadMember_ADMIN) {
+		return res, ErrNotAdmin
+	}
+	err = t.validateMembership(msg)
+	if err != nil {
+		return res, err
+	}
+
+	err = t.applyMembership(msg, block.Header.Clock, block.Header.Date, bnode.hash)
+	if err != nil {
+		return res, err
+	}
+
+	res.oldTarget = msg.Address
+	res.body = membershipBody(msg)
+	return res, nil
+}
+
+// validateMembership ensures a membership change can be applied to this thread
+func (t *Thread) validateMembership(msg *pb.ThreadMembership) error {
+	if t.ttype == pb.Thread_PRIVATE {
+		return ErrInvalidMember
+	}
+	if msg.Address == t.initiator {
+		return ErrInvalidMember
+	}
+	if _, err := keypair.Parse(msg.Address); err != nil {
+		return ErrInvalidMember
+	}
+	if msg.Action == pb.ThreadMembership_GRANT && msg.Role == pb.ThreadMember_DEFAULT {
+		return ErrInvalidMember
+	}
+	return nil
+}
+
+// applyMembership records a membership change at its position in thread history.
+// Blocks may be handled out of order, so the latest state is folded from all changes.
+func (t *Thread) applyMembership(msg *pb.ThreadMembership, clock int64, date *timestamp.Timestamp, block string) error {
+	change := &pb.ThreadMemberChange{
+		Block:   block,
+		Thread:  t.Id,
+		Address: msg.Address,
+		Clock:   clock,
+		Date:    date,
+	}
+	switch msg.Action {
+	case pb.ThreadMembership_ADD:
+		change.Membership = pb.ThreadMemberChange_ADDED
+		change.Role = msg.Role
+	case pb.ThreadMembership_REMOVE:
+		change.Membership = pb.ThreadMemberChange_REMOVED
+	case pb.ThreadMembership_GRANT:
+		change.Role = msg.Role
+	case pb.ThreadMembership_REVOKE:
+	default:
+		return ErrInvalidMember
+	}
+
+	err := t.datastore.ThreadMemberChanges().Add(change)
+	if err != nil && !db.ConflictError(err) {
+		return err
+	}
+
+	member := t.membershipAt(msg.Address, 0, nil)
+	err = t.datastore.ThreadMembers().Put(member)
+	if err != nil {
+		return err
+	}
+
+	// stop sending updates to the removed account's peers
+	if member.Removed {
+		for _, tp := range t.Peers() {
+			peer := t.datastore.Peers().Get(tp.Id)
+			if peer != nil && peer.Address == member.Address {
+				err = t.datastore.ThreadPeers().Delete(tp.Id, t.Id)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// membershipAt folds the membership changes of an address that come before the given
+// clock and date in thread history, or all of them if date is nil.
+// The result is nil if there are no such changes.
+func (t *Thread) membershipAt(addr string, clock int64, date *timestamp.Timestamp) *pb.ThreadMember {
+	var member *pb.ThreadMember
+	for _, change := range t.datastore.ThreadMemberChanges().ListByAddress(t.Id, addr).Items {
+		if date != nil && !changeBefore(change, clock, date) {
+			break
+		}
+		if member == nil {
+			member = &pb.ThreadMember{
+				Thread:  t.Id,
+				Address: addr,
+			}
+		}
+		switch change.Membership {
+		case pb.ThreadMemberChange_ADDED:
+			member.Removed = false
+		case pb.ThreadMemberChange_REMOVED:
+			member.Removed = true
+		}
+		member.Role = change.Role
+		member.Date = change.Date
+		member.Block = change.Block
+	}
+	return member
+}
+
+// permits returns whether or not the author of a block had at least the given role
+// at the block's position in thread history, regardless of what has been handled since
+func (t *Thread) permits(header *pb.ThreadBlockHeader, role pb.ThreadMember_Role) bool {
+	addr := header.Address
+	if addr == t.initiator {
+		return true
+	}
+	if t.ttype == pb.Thread_PRIVATE {
+		return false // should not happen
+	}
+	if addr == "" {
+		// legacy blocks without an account address are never members,
+		// they only get the default access of an open whitelist
+		return len(t.whitelist) == 0 && t.effectiveRole(pb.ThreadMember_DEFAULT) >= role
+	}
+
+	member := t.membershipAt(addr, header.Clock, header.Date)
+	if member == nil {
+		return t.whitelisted(addr) && t.effectiveRole(pb.ThreadMember_DEFAULT) >= role
+	}
+	return !member.Removed && t.effectiveRole(member.Role) >= role
+}
+
+// role returns the effective role of a member address
+func (t *Thread) role(addr string) pb.ThreadMember_Role {
+	if addr == t.initiator {
+		return pb.ThreadMember_ADMIN
+	}
+	var role pb.ThreadMember_Role
+	if member := t.datastore.ThreadMembers().Get(t.Id, addr); member != nil {
+		role = member.Role
+	}
+	return t.effectiveRole(role)
+}
+
+// effectiveRole extends the access granted by the thread type with an explicit role
+func (t *Thread) effectiveRole(role pb.ThreadMember_Role) pb.ThreadMember_Role {
+	var base pb.ThreadMember_Role
+	switch t.ttype {
+	case pb.Thread_READ_ONLY:
+		base = pb.ThreadMember_READER
+	case pb.Thread_PUBLIC:
+		base = pb.ThreadMember_ANNOTATOR
+	case pb.Thread_OPEN:
+		base = pb.ThreadMember_WRITER
+	}
+	if role > base {
+		return role
+	}
+	return base
+}
+
+// admin returns whether or not the given address can change thread membership
+func (t *Thread) admin(addr string) bool {
+	if addr == t.initiator {
+		return true
+	}
+	return t.member(addr) && t.role(addr) == pb.ThreadMember_ADMIN
+}
+
+// changeBefore returns whether or not a membership change comes before the given
+// clock and date in thread history
+func changeBefore(change *pb.ThreadMemberChange, clock int64, date *timestamp.Timestamp) bool {
+	if change.Clock != clock {
+		return change.Clock < clock
+	}
+	return util.ProtoTsIsNewer(date, change.Date)
+}
+
+// membershipBody describes a membership change
+func membershipBody(msg *pb.ThreadMembership) string {
+	switch msg.Action {
+	case pb.ThreadMembership_ADD:
+		return "added"
+	case pb.ThreadMembership_REMOVE:
+		return "removed"
+	case pb.ThreadMembership_GRANT:
+		return "granted " + msg.Role.String()
+	case pb.ThreadMembership_REVOKE:
+		return "revoked role"
+	default:
+		return ""
+	}
+}
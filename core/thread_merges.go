@@ -12,7 +12,7 @@ func (t *Thread) handleMergeBlock(block *pb.ThreadBlock) (handleResult, error) {
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.readable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_READER) {
 		return res, ErrNotReadable
 	}
 
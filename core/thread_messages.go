@@ -1,15 +1,27 @@
 package core
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/keypair"
 	"github.com/textileio/go-textile/pb"
 )
 
-// AddMessage adds an outgoing message block
-func (t *Thread) AddMessage(target string, body string) (mh.Multihash, error) {
+// ErrInvalidReply indicates a message reply target is not a message or files block
+var ErrInvalidReply = fmt.Errorf("replies must target a message or files block")
+
+// mentionRx matches @address mentions in a message body
+var mentionRx = regexp.MustCompile(`@(\w+)`)
+
+// AddMessage adds an outgoing message block. An optional target makes the
+// message a reply to another message or files block. A non-zero ttl makes the
+// message expire, after which it's purged by all peers.
+func (t *Thread) AddMessage(target string, body string, ttl time.Duration) (mh.Multihash, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
@@ -17,25 +29,37 @@ func (t *Thread) AddMessage(target string, body string) (mh.Multihash, error) {
 		return nil, ErrNotWritable
 	}
 
+	if target != "" {
+		parent := t.datastore.Blocks().Get(target)
+		if parent == nil || parent.Thread != t.Id {
+			return nil, ErrBlockNotFound
+		}
+		if !replyable(parent) {
+			return nil, ErrInvalidReply
+		}
+	}
+
 	body = strings.TrimSpace(body)
 	msg := &pb.ThreadMessage{
 		Body: body,
 	}
 
-	res, err := t.commitBlock(msg, pb.Block_TEXT, true, nil)
+	res, err := t.commitExpiringBlock(msg, pb.Block_TEXT, true, nil, ttl)
 	if err != nil {
 		return nil, err
 	}
 
 	err = t.indexBlock(&pb.Block{
-		Id:     res.hash.B58String(),
-		Thread: t.Id,
-		Author: res.header.Author,
-		Type:   pb.Block_TEXT,
-		Date:   res.header.Date,
-		Target: target,
-		Body:   msg.Body,
-		Status: pb.Block_QUEUED,
+		Id:      res.hash.B58String(),
+		Thread:  t.Id,
+		Author:  res.header.Author,
+		Type:    pb.Block_TEXT,
+		Date:    res.header.Date,
+		Clock:   res.header.Clock,
+		Target:  target,
+		Body:    msg.Body,
+		Status:  pb.Block_QUEUED,
+		Expires: res.header.Expires,
 	}, false)
 	if err != nil {
 		return nil, err
@@ -59,10 +83,47 @@ func (t *Thread) handleMessageBlock(block *pb.ThreadBlock) (handleResult, error)
 	if !t.readable(t.config.Account.Address) {
 		return res, ErrNotReadable
 	}
-	if !t.writable(block.Header.Address) {
+	if !t.permits(block.Header, pb.ThreadMember_WRITER) {
 		return res, ErrNotWritable
 	}
 
 	res.body = msg.Body
 	return res, nil
 }
+
+// replyable returns whether or not a block can be the target of a reply
+func replyable(block *pb.Block) bool {
+	switch block.Type {
+	case pb.Block_TEXT, pb.Block_FILES:
+		return true
+	default:
+		return false
+	}
+}
+
+// isReply returns whether or not a block is a message reply
+func isReply(block *pb.Block) bool {
+	return block.Type == pb.Block_TEXT && block.Target != ""
+}
+
+// mentions returns the unique account addresses mentioned in a body, e.g., "hi @P8rW..."
+func mentions(body string) []string {
+	var list []string
+	seen := make(map[string]struct{})
+	for _, match := range mentionRx.FindAllStringSubmatch(body, -1) {
+		addr := match[1]
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		kp, err := keypair.Parse(addr)
+		if err != nil {
+			continue
+		}
+		if _, ok := kp.(*keypair.FromAddress); !ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		list = append(list, addr)
+	}
+	return list
+}
@@ -0,0 +1,233 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// maxReactionLength is the max number of code points in a reaction, enough for
+// long emoji sequences, e.g., families joined by zero width joiners
+const maxReactionLength = 32
+
+// ErrInvalidReaction indicates a reaction is not an emoji or short code
+var ErrInvalidReaction = fmt.Errorf("reaction must be a single emoji or short code, e.g., :thumbsup:")
+
+// shortCodePattern matches emoji short codes, e.g., :thumbsup:
+var shortCodePattern = regexp.MustCompile(`^:[a-z0-9_+\-]{1,32}:$`)
+
+// emoji code points used in reactions, including skin tone modifiers
+// and regional indicators, excluding keycap bases
+var emojiTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f170, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f202, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+	},
+}
+
+const (
+	zeroWidthJoiner = 0x200d
+	textSelector    = 0xfe0e
+	emojiSelector   = 0xfe0f
+	keycapMark      = 0x20e3
+)
+
+// AddReaction adds an outgoing reaction block.
+// Reacting again with the same emoji removes the reaction.
+func (t *Thread) AddReaction(target string, emoji string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.annotatable(t.config.Account.Address) {
+		return nil, ErrNotAnnotatable
+	}
+
+	emoji = strings.TrimSpace(emoji)
+	if !validReaction(emoji) {
+		return nil, ErrInvalidReaction
+	}
+	msg := &pb.ThreadReaction{
+		Emoji: emoji,
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_REACTION, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_REACTION,
+		Date:   res.header.Date,
+		Clock:  res.header.Clock,
+		Target: target,
+		Body:   msg.Emoji,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added REACTION to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleReactionBlock handles an incoming reaction block
+func (t *Thread) handleReactionBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadReaction)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.permits(block.Header, pb.ThreadMember_ANNOTATOR) {
+		return res, ErrNotAnnotatable
+	}
+	if !validReaction(msg.Emoji) {
+		return res, ErrInvalidReaction
+	}
+
+	res.body = msg.Emoji
+	return res, nil
+}
+
+// reactionApplied returns whether or not a reaction block leaves its emoji applied,
+// i.e., the author's account has reacted to the target with it an odd number of times
+// up to and including this block
+func (t *Thread) reactionApplied(block *pb.Block) bool {
+	query := reactionsQuery(block.Target) + fmt.Sprintf(" and date<=%d", util.ProtoNanos(block.Date))
+
+	reactor := t.reactor(block.Author)
+	var count int
+	for _, b := range t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items {
+		if b.Body == block.Body && t.reactor(b.Author) == reactor {
+			count++
+		}
+	}
+	return count%2 == 1
+}
+
+// reactor returns the account address of a reaction author, or the peer id if unknown,
+// so that reactions from each of an account's peers toggle the same emoji
+func (t *Thread) reactor(author string) string {
+	if address := t.peerAddress(author); address != "" {
+		return address
+	}
+	return author
+}
+
+// validReaction returns whether or not a reaction is a short code or a single emoji,
+// which may be a sequence joined by zero width joiners, a flag, or a keycap
+func validReaction(emoji string) bool {
+	if shortCodePattern.MatchString(emoji) {
+		return true
+	}
+	if emoji == "" || utf8.RuneCountInString(emoji) > maxReactionLength {
+		return false
+	}
+	runes := []rune(emoji)
+
+	// keycaps, e.g., 1️⃣
+	if runes[len(runes)-1] == keycapMark {
+		base := runes[0]
+		if !(base >= '0' && base <= '9') && base != '#' && base != '*' {
+			return false
+		}
+		return len(runes) == 2 || (len(runes) == 3 && runes[1] == emojiSelector)
+	}
+
+	expectEmoji := true
+	var indicators int
+	for _, r := range runes {
+		switch {
+		case expectEmoji:
+			if !unicode.Is(emojiTable, r) {
+				return false
+			}
+			expectEmoji = false
+		case r == zeroWidthJoiner:
+			expectEmoji = true
+		case r == emojiSelector || r == textSelector:
+		case r >= 0x1f3fb && r <= 0x1f3ff: // skin tone modifiers
+		case r >= 0xe0020 && r <= 0xe007f: // tags of subdivision flags
+		case isRegionalIndicator(r) && indicators == 1:
+		default:
+			return false
+		}
+		if isRegionalIndicator(r) {
+			indicators++
+		}
+	}
+	return !expectEmoji && (indicators == 0 || (indicators == 2 && len(runes) == 2))
+}
+
+// isRegionalIndicator returns whether or not a rune is one of a flag's pair of letters
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// reactionsQuery matches the reaction blocks on a target that have not been ignored
+func reactionsQuery(target string) string {
+	return fmt.Sprintf("target='%s' and type=%d and id not in (select target from blocks where type=%d)",
+		target, pb.Block_REACTION, pb.Block_IGNORE)
+}
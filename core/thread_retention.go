@@ -0,0 +1,301 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	icid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// retentionTypes are the block types that can expire or be purged by a retention policy
+var retentionTypes = []pb.Block_BlockType{
+	pb.Block_TEXT,
+	pb.Block_FILES,
+	pb.Block_COMMENT,
+	pb.Block_LIKE,
+	pb.Block_EDIT,
+	pb.Block_REACTION,
+}
+
+// ThreadRetention returns the retention policy of a thread
+func (t *Textile) ThreadRetention(id string) (*pb.ThreadRetention, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	retention := t.datastore.ThreadRetentions().Get(thread.Id)
+	if retention == nil {
+		retention = &pb.ThreadRetention{Thread: thread.Id}
+	}
+	return retention, nil
+}
+
+// SetThreadRetention shares a new retention policy with thread members and purges
+// blocks that fall outside of it. Zero values remove the limit.
+func (t *Textile) SetThreadRetention(id string, maxAge time.Duration, maxBlocks int) (*pb.ThreadRetention, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	if maxAge < 0 || maxBlocks < 0 {
+		return nil, fmt.Errorf("retention limits must not be negative")
+	}
+
+	count, err := thread.AddRetention(&pb.ThreadRetentionPolicy{
+		MaxAge:    int64(maxAge / time.Second),
+		MaxBlocks: int32(maxBlocks),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		log.Debugf("purged %d blocks from %s", count, thread.Id)
+	}
+	t.FlushCafes()
+
+	return t.ThreadRetention(thread.Id)
+}
+
+// AddRetention adds an outgoing retention block, which replaces the retention policy
+// of the thread, and purges blocks that fall outside of the new policy.
+func (t *Thread) AddRetention(msg *pb.ThreadRetentionPolicy) (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.admin(t.config.Account.Address) {
+		return 0, ErrNotAdmin
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_RETENTION, true, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_RETENTION,
+		Date:   res.header.Date,
+		Clock:  res.header.Clock,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Debugf("added RETENTION to %s: %s", t.Id, res.hash.B58String())
+
+	err = t.applyRetention(msg)
+	if err != nil {
+		return 0, err
+	}
+	return t.purgeBlocks()
+}
+
+// handleRetentionBlock handles an incoming retention block.
+// Blocks are handled newest first, so the policy is only applied if the block comes
+// after the newest known retention block in thread history.
+func (t *Thread) handleRetentionBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadRetentionPolicy)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.permits(block.Header, pb.ThreadMember_ADMIN) {
+		return res, ErrNotAdmin
+	}
+
+	query := fmt.Sprintf("threadId='%s' and type=%d", t.Id, pb.Block_RETENTION)
+	latest := t.datastore.Blocks().List("", 1, query, pb.Block_CAUSAL).Items
+	if len(latest) > 0 && !blockBefore(latest[0], block.Header.Clock, block.Header.Date) {
+		return res, nil
+	}
+
+	err = t.applyRetention(msg)
+	if err != nil {
+		return res, err
+	}
+	_, err = t.purgeBlocks()
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// applyRetention stores the retention policy of the thread
+func (t *Thread) applyRetention(msg *pb.ThreadRetentionPolicy) error {
+	if msg.MaxAge < 0 || msg.MaxBlocks < 0 {
+		return fmt.Errorf("retention limits must not be negative")
+	}
+	if msg.MaxAge == 0 && msg.MaxBlocks == 0 {
+		return t.datastore.ThreadRetentions().Delete(t.Id)
+	}
+	return t.datastore.ThreadRetentions().Put(&pb.ThreadRetention{
+		Thread:    t.Id,
+		MaxAge:    msg.MaxAge,
+		MaxBlocks: msg.MaxBlocks,
+	})
+}
+
+// purgeThreads purges expired blocks from all threads
+func (t *Textile) purgeThreads() {
+	for _, thread := range t.loadedThreads {
+		count, err := thread.purge()
+		if err != nil {
+			log.Errorf("error purging thread %s: %s", thread.Id, err)
+			continue
+		}
+		if count > 0 {
+			log.Debugf("purged %d blocks from %s", count, thread.Id)
+		}
+	}
+}
+
+// purge removes blocks which have expired or fall outside of the retention policy
+func (t *Thread) purge() (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.purgeBlocks()
+}
+
+// purgeBlocks is the lock-free version of purge
+func (t *Thread) purgeBlocks() (int, error) {
+	now := time.Now()
+	types := retentionQuery()
+	query := fmt.Sprintf("threadId='%s' and %s and expires>0 and expires<=%d",
+		t.Id, types, now.UnixNano())
+	blocks := t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items
+
+	retention := t.datastore.ThreadRetentions().Get(t.Id)
+	if retention != nil {
+		if retention.MaxAge > 0 {
+			cutoff := now.Add(-time.Duration(retention.MaxAge) * time.Second)
+			query = fmt.Sprintf("threadId='%s' and %s and date<=%d", t.Id, types, cutoff.UnixNano())
+			blocks = append(blocks, t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items...)
+		}
+		if retention.MaxBlocks > 0 {
+			query = fmt.Sprintf("threadId='%s' and (type=%d or type=%d)",
+				t.Id, pb.Block_TEXT, pb.Block_FILES)
+			posts := t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items
+			if len(posts) > int(retention.MaxBlocks) {
+				blocks = append(blocks, posts[retention.MaxBlocks:]...)
+			}
+		}
+	}
+
+	purged := make(map[string]struct{})
+	for _, block := range blocks {
+		err := t.purgeBlock(block, purged)
+		if err != nil {
+			return len(purged), err
+		}
+	}
+	return len(purged), nil
+}
+
+// purgeBlock removes a block, its files, and any blocks targeting it
+func (t *Thread) purgeBlock(block *pb.Block, purged map[string]struct{}) error {
+	if _, ok := purged[block.Id]; ok {
+		return nil
+	}
+	purged[block.Id] = struct{}{}
+
+	// annotations and replies go with their target
+	for _, child := range t.datastore.Blocks().List("", -1, "target='"+block.Id+"'", pb.Block_DATE).Items {
+		err := t.purgeBlock(child, purged)
+		if err != nil {
+			return err
+		}
+	}
+
+	if (block.Type == pb.Block_FILES || block.Type == pb.Block_EDIT) && block.Data != "" {
+		node, err := ipfs.NodeAtPath(t.node(), block.Data, ipfs.CatTimeout)
+		if err != nil {
+			log.Warningf("unable to load files for %s: %s", block.Id, err)
+		} else {
+			err = t.removeFiles(node)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// the node wrapping the block and its parents stay pinned so history can be followed
+	id, err := icid.Decode(block.Id)
+	if err != nil {
+		return err
+	}
+	err = ipfs.UnpinCid(t.node(), id, false)
+	if err != nil {
+		return err
+	}
+	err = t.cafeOutbox.Add(block.Id, pb.CafeRequest_UNSTORE)
+	if err != nil {
+		return err
+	}
+
+	err = t.datastore.Notifications().DeleteByBlock(block.Id)
+	if err != nil {
+		return err
+	}
+	return t.datastore.Blocks().Delete(block.Id)
+}
+
+// blockBefore returns whether or not a block comes before the given clock and date
+// in thread history
+func blockBefore(block *pb.Block, clock int64, date *timestamp.Timestamp) bool {
+	if block.Clock != clock {
+		return block.Clock < clock
+	}
+	return util.ProtoTsIsNewer(date, block.Date)
+}
+
+// expired returns whether or not a block should no longer be kept, either because it
+// has expired or it's older than the retention policy allows
+func (t *Thread) expired(btype pb.Block_BlockType, date *timestamp.Timestamp, expires *timestamp.Timestamp) bool {
+	var ok bool
+	for _, rt := range retentionTypes {
+		if btype == rt {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	now := time.Now().UnixNano()
+	if expires != nil && util.ProtoNanos(expires) <= now {
+		return true
+	}
+
+	retention := t.datastore.ThreadRetentions().Get(t.Id)
+	if retention != nil && retention.MaxAge > 0 && date != nil {
+		return util.ProtoNanos(date) <= now-retention.MaxAge*int64(time.Second)
+	}
+	return false
+}
+
+// retentionQuery returns a query clause matching retention block types
+func retentionQuery() string {
+	var clauses []string
+	for _, rt := range retentionTypes {
+		clauses = append(clauses, fmt.Sprintf("type=%d", rt))
+	}
+	return "(" + strings.Join(clauses, " or ") + ")"
+}
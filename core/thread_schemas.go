@@ -0,0 +1,381 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ipfs/go-ipfs/core"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/textileio/go-textile/ipfs"
+	m "github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema"
+	"github.com/textileio/go-textile/util"
+)
+
+// ErrSchemaNotFound indicates a schema could not be loaded from its hash
+var ErrSchemaNotFound = fmt.Errorf("schema not found")
+
+// ErrInvalidSchemaUpdate indicates a schema block does not contain valid versions
+var ErrInvalidSchemaUpdate = fmt.Errorf("invalid schema update")
+
+// ErrIncompatibleSchema indicates a schema update removes or changes existing links
+var ErrIncompatibleSchema = fmt.Errorf("schema is not compatible with the current thread schema")
+
+// ThreadSchemas lists the recorded schema versions of a thread
+func (t *Textile) ThreadSchemas(id string) (*pb.ThreadSchemaList, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	return t.datastore.ThreadSchemas().List(thread.Id), nil
+}
+
+// UpdateThreadSchema compares a new schema against the thread's current schema and,
+// if compatible or forced, shares it with members as the next schema version
+func (t *Textile) UpdateThreadSchema(id string, hash string, force bool) (*pb.SchemaDiff, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	node, err := schemaAtPath(t.node, hash)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, ErrSchemaNotFound
+	}
+
+	diff := schema.Compare(thread.Schema, node)
+	if !diff.Compatible && !force {
+		return diff, ErrIncompatibleSchema
+	}
+
+	err = thread.UpdateSchema(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.cafeOutbox.Add(hash, pb.CafeRequest_STORE)
+	if err != nil {
+		return nil, err
+	}
+	t.FlushCafes()
+
+	return diff, nil
+}
+
+// BackfillThreadSchema re-mills files in the local peer's files blocks into links that
+// were added to the thread schema after the block was created. The files of each updated
+// block are replaced by an edit, which keeps the block's position and annotations.
+// Links that use the original file are milled from the largest existing file the mill accepts.
+func (t *Textile) BackfillThreadSchema(id string) (*pb.BlockList, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	if thread.Schema == nil {
+		return nil, ErrThreadSchemaRequired
+	}
+
+	list := &pb.BlockList{Items: make([]*pb.Block, 0)}
+	if len(thread.Schema.Links) == 0 {
+		return list, nil
+	}
+	steps, err := schema.Steps(thread.Schema.Links)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixNano()
+	query := fmt.Sprintf("threadId='%s' and type=%d and authorId='%s'",
+		thread.Id, pb.Block_FILES, t.node.Identity.Pretty())
+	for _, block := range t.Blocks("", -1, query, pb.Block_DATE).Items {
+		if block.Expires != nil && util.ProtoNanos(block.Expires) <= now {
+			continue
+		}
+
+		edits := t.revisions(block)
+		dirs, err := t.backfillDirs(thread, block, filesData(block, edits), steps)
+		if err != nil {
+			return nil, err
+		}
+		if dirs == nil {
+			continue
+		}
+
+		caption := block.Body
+		if len(edits) > 0 {
+			caption = edits[0].Body
+		}
+		node, keys, err := t.AddNodeFromDirs(dirs)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := thread.AddFilesEdit(block.Id, caption, node, keys.Files)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Items = append(list.Items, block)
+		log.Debugf("backfilled FILES block %s with EDIT %s", block.Id, hash.B58String())
+	}
+
+	if len(list.Items) > 0 {
+		t.FlushCafes()
+	}
+
+	return list, nil
+}
+
+// backfillDirs returns the directories at the data of a files block with missing schema links milled,
+// or nil if the block has nothing to backfill
+func (t *Textile) backfillDirs(thread *Thread, block *pb.Block, data string, steps []pb.Step) (*pb.DirectoryList, error) {
+	files, err := t.fileAtData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed bool
+	dirs := &pb.DirectoryList{Items: make([]*pb.Directory, len(files))}
+	for i, file := range files {
+		if file.Links == nil {
+			// single file blocks can't gain links
+			return nil, nil
+		}
+		dir := &pb.Directory{Files: make(map[string]*pb.FileIndex)}
+		for name, f := range file.Links {
+			dir.Files[name] = f
+		}
+
+		for _, step := range steps {
+			if dir.Files[step.Name] != nil {
+				continue
+			}
+
+			mil, err := t.Mill(step.Link.Mill, step.Link.Opts)
+			if err != nil {
+				return nil, err
+			}
+			if mil == nil {
+				return nil, schema.ErrSchemaInvalidMill
+			}
+
+			src := backfillSource(dir, step.Link, mil)
+			if src == nil {
+				log.Warningf("no source to backfill %s in block %s", step.Name, block.Id)
+				continue
+			}
+			added, err := t.backfillFile(mil, src, step.Link, thread.Id)
+			if err != nil {
+				log.Warningf("unable to backfill %s in block %s: %s", step.Name, block.Id, err)
+				continue
+			}
+			dir.Files[step.Name] = added
+			changed = true
+		}
+		dirs.Items[i] = dir
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	return dirs, nil
+}
+
+// backfillFile mills a new file for a schema link from an existing file
+func (t *Textile) backfillFile(mil m.Mill, src *pb.FileIndex, link *pb.Link, threadId string) (*pb.FileIndex, error) {
+	reader, err := t.FileIndexContent(src)
+	if err != nil {
+		return nil, err
+	}
+	media, err := t.GetMillMedia(reader, mil)
+	if err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+	_, err = reader.Seek(0, 0)
+	if err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+
+	// the reader is consumed (and closed) by AddFileIndex
+	return t.AddFileIndex(mil, AddFileConfig{
+		Reader:    reader,
+		Use:       src.Checksum,
+		Media:     media,
+		Name:      src.Name,
+		Plaintext: link.Plaintext,
+		Thread:    threadId,
+	})
+}
+
+// backfillSource returns the file a schema link should be milled from
+func backfillSource(dir *pb.Directory, link *pb.Link, mil m.Mill) *pb.FileIndex {
+	if link.Use != schema.FileTag {
+		return dir.Files[link.Use]
+	}
+
+	// the original file isn't stored, use the largest rendition
+	var src *pb.FileIndex
+	for _, file := range dir.Files {
+		if mil.AcceptMedia(file.Media) != nil {
+			continue
+		}
+		if src == nil || file.Size > src.Size {
+			src = file
+		}
+	}
+	return src
+}
+
+// UpdateSchema adds an outgoing schema block, which records hash as the next schema
+// version of the thread and makes it current
+func (t *Thread) UpdateSchema(hash string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.admin(t.config.Account.Address) {
+		return ErrNotAdmin
+	}
+
+	versions := t.datastore.ThreadSchemas().List(t.Id).Items
+	var version int32 = 1
+	if len(versions) > 0 {
+		version = versions[len(versions)-1].Version + 1
+	}
+	msg := &pb.ThreadSchemaUpdate{
+		Versions: append(versions, &pb.ThreadSchema{
+			Thread:  t.Id,
+			Version: version,
+			Hash:    hash,
+			Date:    ptypes.TimestampNow(),
+		}),
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_SCHEMA, true, nil)
+	if err != nil {
+		return err
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_SCHEMA,
+		Date:   res.header.Date,
+		Clock:  res.header.Clock,
+		Target: hash,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("added SCHEMA to %s: %s", t.Id, res.hash.B58String())
+
+	return t.applySchemas(msg.Versions)
+}
+
+// handleSchemaBlock handles an incoming schema block
+func (t *Thread) handleSchemaBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadSchemaUpdate)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.permits(block.Header, pb.ThreadMember_ADMIN) {
+		return res, ErrNotAdmin
+	}
+	if len(msg.Versions) == 0 {
+		return res, ErrInvalidSchemaUpdate
+	}
+
+	err = t.applySchemas(msg.Versions)
+	if err != nil {
+		return res, err
+	}
+
+	res.oldTarget = msg.Versions[len(msg.Versions)-1].Hash
+	return res, nil
+}
+
+// applySchemas records schema versions and makes the latest known version current.
+// Versions may conflict if updated concurrently, in which case the later one wins.
+func (t *Thread) applySchemas(versions []*pb.ThreadSchema) error {
+	for _, v := range versions {
+		if v.Version < 1 || v.Hash == "" {
+			return ErrInvalidSchemaUpdate
+		}
+		err := t.datastore.ThreadSchemas().Put(&pb.ThreadSchema{
+			Thread:  t.Id,
+			Version: v.Version,
+			Hash:    v.Hash,
+			Date:    v.Date,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	recorded := t.datastore.ThreadSchemas().List(t.Id).Items
+	current := recorded[len(recorded)-1].Hash
+	if current == t.schemaId {
+		return nil
+	}
+	err := t.datastore.Threads().UpdateSchema(t.Id, current)
+	if err != nil {
+		return err
+	}
+	t.schemaId = current
+	t.Schema = nil
+	return t.loadSchema()
+}
+
+// previousSchemas returns the nodes of older schema versions, newest first
+func (t *Thread) previousSchemas() []*pb.Node {
+	var nodes []*pb.Node
+	versions := t.datastore.ThreadSchemas().List(t.Id).Items
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].Hash == t.schemaId {
+			continue
+		}
+		node, err := schemaAtPath(t.node(), versions[i].Hash)
+		if err != nil {
+			log.Warningf("unable to load schema version %d: %s", versions[i].Version, err)
+			continue
+		}
+		if node != nil {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// schemaAtPath loads a schema node, returning nil if it's not found
+func schemaAtPath(node *core.IpfsNode, hash string) (*pb.Node, error) {
+	data, err := ipfs.DataAtPath(node, hash)
+	if err != nil {
+		if err == ipld.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sch pb.Node
+	err = jsonpb.UnmarshalString(string(data), &sch)
+	if err != nil {
+		return nil, err
+	}
+	return &sch, nil
+}
@@ -0,0 +1,192 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// VerifyThread walks a thread's DAG from its heads, reporting missing nodes,
+// undecryptable blocks, and indexes that are missing, pending, orphaned, or don't
+// match their block. Blocks are content addressed and not signed at rest, so block
+// authenticity is checked by comparing each index with its decrypted header.
+// With repair, missing nodes are re-fetched from the network, bad indexes are rebuilt,
+// and orphaned indexes are removed if the whole DAG could be walked. Queued and
+// pending indexes are never considered orphaned.
+func (t *Textile) VerifyThread(id string, repair bool) (*pb.ThreadVerification, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	res, err := thread.verify(repair)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Repaired) > 0 {
+		t.FlushCafes()
+	}
+
+	return res, nil
+}
+
+// verifiedNode is a walked node which may need repair
+type verifiedNode struct {
+	bnode *blockNode
+	index *pb.Block
+}
+
+// verify walks the DAG from the heads, optionally repairing what it can
+func (t *Thread) verify(repair bool) (*pb.ThreadVerification, error) {
+	heads, err := t.Heads()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ThreadVerification{Thread: t.Id}
+	visited := make(map[string]struct{})
+	blocks := make(map[string]struct{})
+	var unindexed []*verifiedNode
+
+	queue := heads
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		if hash == "" {
+			continue // some old blocks may contain empty string parents
+		}
+		if _, ok := visited[hash]; ok {
+			continue
+		}
+		visited[hash] = struct{}{}
+		res.Nodes++
+
+		bnode, err := t.loadNode(hash)
+		if bnode != nil {
+			blocks[bnode.hash] = struct{}{}
+		}
+		if err != nil {
+			log.Warningf("unable to load node %s: %s", hash, err)
+			res.Missing = append(res.Missing, hash)
+			if bnode != nil {
+				// the node links are known, keep walking
+				queue = append(queue, bnode.parents...)
+			}
+			continue
+		}
+
+		block, err := t.unmarshalBlock(bnode.ciphertext)
+		if err != nil {
+			res.Undecryptable = append(res.Undecryptable, bnode.hash)
+			queue = append(queue, bnode.parents...)
+			continue
+		}
+		if len(block.Header.Parents) > 0 {
+			bnode.parents = block.Header.Parents
+		}
+		queue = append(queue, bnode.parents...)
+
+		index := t.datastore.Blocks().Get(bnode.hash)
+		switch {
+		case index == nil:
+			if t.expired(block.Type, block.Header.Date, block.Header.Expires) {
+				continue
+			}
+			res.Unindexed = append(res.Unindexed, bnode.hash)
+			unindexed = append(unindexed, &verifiedNode{bnode: bnode})
+		case index.Status == pb.Block_PENDING, index.Status == pb.Block_DEFERRED:
+			res.Unindexed = append(res.Unindexed, bnode.hash)
+			unindexed = append(unindexed, &verifiedNode{bnode: bnode, index: index})
+		case index.Author != block.Header.Author || index.Type != block.Type ||
+			util.ProtoNanos(index.Date) != util.ProtoNanos(block.Header.Date) ||
+			index.Clock != block.Header.Clock:
+			res.Mismatched = append(res.Mismatched, bnode.hash)
+			unindexed = append(unindexed, &verifiedNode{bnode: bnode, index: index})
+		}
+	}
+
+	query := fmt.Sprintf("threadId='%s'", t.Id)
+	var orphaned []string
+	for _, index := range t.datastore.Blocks().List("", -1, query, pb.Block_DATE).Items {
+		switch index.Status {
+		case pb.Block_QUEUED, pb.Block_PENDING:
+			continue // outbound blocks may be waiting on cafes, inbound ones on downloads
+		}
+		if _, ok := blocks[index.Id]; !ok {
+			orphaned = append(orphaned, index.Id)
+		}
+	}
+	sort.Strings(orphaned)
+	res.Orphaned = orphaned
+
+	if !repair {
+		return res, nil
+	}
+
+	// missing nodes are followed from the network, which indexes them and their ancestors
+	for _, hash := range res.Missing {
+		t.followParents([]string{hash})
+		id, err := blockCIDFromNode(t.node(), hash)
+		if err != nil {
+			continue
+		}
+		index := t.datastore.Blocks().Get(id)
+		if index != nil && index.Status == pb.Block_READY {
+			res.Repaired = append(res.Repaired, id)
+		}
+	}
+
+	for _, v := range unindexed {
+		_, err = t.handle(v.bnode, v.index != nil)
+		if err != nil {
+			log.Warningf("unable to re-index %s: %s", v.bnode.hash, err)
+			continue
+		}
+		res.Repaired = append(res.Repaired, v.bnode.hash)
+	}
+
+	// unreachable indexes may just be behind a missing node
+	if len(res.Missing) == 0 {
+		for _, id := range res.Orphaned {
+			err = t.datastore.Blocks().Delete(id)
+			if err != nil {
+				return nil, err
+			}
+			res.Repaired = append(res.Repaired, id)
+		}
+	}
+
+	return res, nil
+}
+
+// loadNode loads the block components of a node, which must be local
+// or available within the default timeout. The links are returned if only
+// the block itself can't be loaded.
+func (t *Thread) loadNode(hash string) (*blockNode, error) {
+	node, err := ipfs.NodeAtPath(t.node(), hash, ipfs.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	bnode := &blockNode{hash: hash}
+	if len(node.Links()) > 0 {
+		bnode, err = extractNode(t.node(), node, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// pre-check existence, cat waits much longer
+	_, err = ipfs.NodeAtPath(t.node(), bnode.hash, ipfs.DefaultTimeout)
+	if err != nil {
+		return bnode, err
+	}
+	bnode.ciphertext, err = ipfs.DataAtPath(t.node(), bnode.hash)
+	if err != nil {
+		return bnode, err
+	}
+	return bnode, nil
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/textileio/go-textile/broadcast"
 	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/keypair"
-	"github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo/db"
 	"github.com/textileio/go-textile/schema/textile"
@@ -67,11 +66,17 @@ func (t *Textile) AddThread(conf pb.AddThreadConfig, sk libp2pc.PrivKey, initiat
 				sjson = textile.CameraRoll
 			case pb.AddThreadConfig_Schema_MEDIA:
 				sjson = textile.Media
+			case pb.AddThreadConfig_Schema_VIDEO:
+				sjson = textile.Video
+			case pb.AddThreadConfig_Schema_AUDIO:
+				sjson = textile.Audio
+			case pb.AddThreadConfig_Schema_DOCUMENT:
+				sjson = textile.Document
 			}
 		}
 
 		if sjson != "" {
-			sfile, err := t.AddFileIndex(&mill.Schema{}, AddFileConfig{
+			sfile, err := t.AddFileIndex(t.SchemaMill(), AddFileConfig{
 				Input: []byte(sjson),
 				Media: "application/json",
 			})
@@ -128,6 +133,18 @@ func (t *Textile) AddThread(conf pb.AddThreadConfig, sk libp2pc.PrivKey, initiat
 		}
 		return nil, err
 	}
+	// the creator records the first schema version, others learn versions from schema blocks
+	if sch != "" && join {
+		err = t.datastore.ThreadSchemas().Put(&pb.ThreadSchema{
+			Thread:  model.Id,
+			Version: 1,
+			Hash:    sch,
+			Date:    ptypes.TimestampNow(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	thread, err := t.loadThread(model)
 	if err != nil {
@@ -213,6 +230,12 @@ func (t *Textile) AddOrUpdateThread(thread *pb.Thread) error {
 		}
 	}
 
+	// rotated keys are needed to read (and write) past the first key epoch
+	err = nthread.addKeys(thread.Keys)
+	if err != nil {
+		return err
+	}
+
 	// have we joined?
 	query := fmt.Sprintf("threadId='%s' and type=%d and authorId='%s'", nthread.Id, pb.Block_JOIN, t.node.Identity.Pretty())
 	if t.datastore.Blocks().Count(query) == 0 {
@@ -325,6 +348,58 @@ func (t *Textile) ThreadPeers(id string) (*pb.PeerList, error) {
 	return peers, nil
 }
 
+// ThreadMembers returns the explicit membership state of a thread
+func (t *Textile) ThreadMembers(id string) (*pb.ThreadMemberList, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	return thread.Members(), nil
+}
+
+// UpdateThreadMember adds, removes, or changes the role of a thread member
+func (t *Textile) UpdateThreadMember(id string, address string, action pb.ThreadMembership_Action, role pb.ThreadMember_Role) (mh.Multihash, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	hash, err := thread.AddMember(address, action, role)
+	if err != nil {
+		return nil, err
+	}
+
+	// re-key so the removed account can't read what follows
+	if action == pb.ThreadMembership_REMOVE {
+		_, err = thread.RotateKey(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hash, nil
+}
+
+// RotateThreadKey re-keys a thread for its current members
+func (t *Textile) RotateThreadKey(id string) (mh.Multihash, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	return thread.RotateKey()
+}
+
+// ParseThreadRole parses a thread member role name, an empty name is the default role
+func ParseThreadRole(role string) (pb.ThreadMember_Role, error) {
+	if role == "" {
+		return pb.ThreadMember_DEFAULT, nil
+	}
+	r, ok := pb.ThreadMember_Role_value[strings.ToUpper(role)]
+	if !ok {
+		return 0, fmt.Errorf("invalid role: %s", role)
+	}
+	return pb.ThreadMember_Role(r), nil
+}
+
 // RemoveThread removes a thread
 // @todo rename to abandon to be consistent with CLI+API
 func (t *Textile) RemoveThread(id string) (mh.Multihash, error) {
@@ -357,6 +432,26 @@ func (t *Textile) RemoveThread(id string) (mh.Multihash, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = t.datastore.ThreadSchemas().DeleteByThread(thread.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = t.datastore.ThreadMembers().DeleteByThread(thread.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = t.datastore.ThreadMemberChanges().DeleteByThread(thread.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = t.datastore.ThreadKeys().DeleteByThread(thread.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = t.datastore.ThreadRetentions().Delete(thread.Id)
+	if err != nil {
+		return nil, err
+	}
 
 	copy(t.loadedThreads[index:], t.loadedThreads[index+1:])
 	t.loadedThreads[len(t.loadedThreads)-1] = nil
@@ -159,26 +159,86 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 		log.Debugf("%s exists, aborting", bnode.hash)
 		return reply()
 	}
+	// deferred blocks are indexed without a type, so they don't generate notifications
 	index, err = thread.handle(bnode, false)
 	if err != nil {
 		return nil, err
 	}
 
 	// naively add the inbound head, it will be cleaned up later after following parents,
-	// but we don't want to lose it in the meantime
+	// but we don't want to lose it in the meantime. This holds for expired blocks too,
+	// whose parents may not be.
 	err = thread.addHead(nhash)
 	if err != nil {
 		return nil, err
 	}
 
-	// some updates generate a notification
+	if index != nil {
+		// some updates generate a notification
+		err = h.notify(thread, index, accountPeer)
+		if err != nil {
+			return nil, err
+		}
+
+		// we may be auto-leaving
+		if index.Type == pb.Block_LEAVE && accountPeer {
+			_, err = h.removeThread(thread.Id)
+			if err != nil {
+				log.Warningf("failed to remove thread %s: %s", thread.Id, err)
+				return nil, err
+			}
+
+			stopLock.Lock("ThreadsService.Handle")
+			go func() {
+				defer stopLock.Unlock("ThreadsService.Handle")
+				thread.cafeOutbox.Flush(false)
+			}()
+			return reply()
+		}
+	}
+
+	// handle the thread tail in the background
+	stopLock.Lock("ThreadsService.Handle")
+	go func() {
+		defer stopLock.Unlock("ThreadsService.Handle")
+
+		leaves := thread.followParents(bnode.parents)
+		err = thread.handleHead([]string{nhash}, leaves)
+		if err != nil {
+			log.Warningf("failed to handle head %s: %s", nhash, err)
+			return
+		}
+
+		// re-key so departed accounts can't read what follows, including
+		// those found while following parents
+		err = thread.rotateKeyOnLeave()
+		if err != nil {
+			log.Warningf("failed to rotate key for %s: %s", thread.Id, err)
+		}
+
+		// handle newly discovered peers during back prop
+		err = thread.sendWelcome()
+		if err != nil {
+			log.Warningf("error sending welcome: %s", err)
+			return
+		}
+
+		// flush cafe queue _at the very end_
+		thread.cafeOutbox.Flush(false)
+	}()
+
+	return reply()
+}
+
+// notify sends a notification for the updates that generate one
+func (h *ThreadsService) notify(thread *Thread, index *pb.Block, accountPeer bool) error {
 	note := &pb.Notification{
 		Id:          ksuid.New().String(),
 		Date:        index.Date,
 		Actor:       index.Author,
 		Subject:     thread.Id,
 		SubjectDesc: thread.Name,
-		Block:       bnode.hash,
+		Block:       index.Id,
 		Target:      index.Target,
 		Body:        index.Body,
 	}
@@ -201,6 +261,12 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 		note.Body = "left"
 	case pb.Block_TEXT:
 		note.Type = pb.Notification_MESSAGE_ADDED
+		for _, addr := range mentions(index.Body) {
+			if addr == h.service.Account.Address() {
+				note.Type = pb.Notification_MENTION_RECEIVED
+				break
+			}
+		}
 	case pb.Block_FILES:
 		note.Type = pb.Notification_FILES_ADDED
 		if note.Body == "" { // might be caption
@@ -211,56 +277,20 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 	case pb.Block_LIKE:
 		note.Type = pb.Notification_LIKE_ADDED
 		note.Body = "added a like"
+	case pb.Block_EDIT:
+		note.Type = pb.Notification_EDIT_ADDED
+		send = thread.interacted(index.Target)
+	case pb.Block_REACTION:
+		note.Type = pb.Notification_REACTION_ADDED
+		note.Body = "reacted " + index.Body
+		send = thread.reactionApplied(index)
 	default:
 		send = false
 	}
-	if send {
-		err = h.sendNotification(note)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// we may be auto-leaving
-	if index.Type == pb.Block_LEAVE && accountPeer {
-		_, err = h.removeThread(thread.Id)
-		if err != nil {
-			log.Warningf("failed to remove thread %s: %s", thread.Id, err)
-			return nil, err
-		}
-
-		stopLock.Lock("ThreadsService.Handle")
-		go func() {
-			defer stopLock.Unlock("ThreadsService.Handle")
-			thread.cafeOutbox.Flush(false)
-		}()
-		return reply()
+	if !send {
+		return nil
 	}
-
-	// handle the thread tail in the background
-	stopLock.Lock("ThreadsService.Handle")
-	go func() {
-		defer stopLock.Unlock("ThreadsService.Handle")
-
-		leaves := thread.followParents(bnode.parents)
-		err = thread.handleHead([]string{nhash}, leaves)
-		if err != nil {
-			log.Warningf("failed to handle head %s: %s", nhash, err)
-			return
-		}
-
-		// handle newly discovered peers during back prop
-		err = thread.sendWelcome()
-		if err != nil {
-			log.Warningf("error sending welcome: %s", err)
-			return
-		}
-
-		// flush cafe queue _at the very end_
-		thread.cafeOutbox.Flush(false)
-	}()
-
-	return reply()
+	return h.sendNotification(note)
 }
 
 // HandleStream is called by the underlying service handler method
@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/mr-tron/base58/base58"
@@ -27,9 +28,18 @@ func (t *Textile) CafeTokens() ([]string, error) {
 	return strings, nil
 }
 
+// CafeTokenConfig is used to limit what peers registered with a token can do,
+// zero values are unlimited
+type CafeTokenConfig struct {
+	Quota            *pb.CafeQuota        // storage shared by all registered peers
+	Expires          time.Time            // registrations and sessions are no longer accepted after
+	MaxRegistrations int                  // max number of registered peers
+	Scopes           []pb.CafeToken_Scope // cafe services sessions may use, all if empty
+}
+
 // CreateCafeToken creates (or uses `token`) random access token, returns base58 encoded version,
 // and stores (unless `store` is false) a bcrypt hashed version for later comparison
-func (t *Textile) CreateCafeToken(token string, store bool) (string, error) {
+func (t *Textile) CreateCafeToken(token string, store bool, conf CafeTokenConfig) (string, error) {
 	var key []byte
 	var err error
 	if token != "" {
@@ -54,11 +64,24 @@ func (t *Textile) CreateCafeToken(token string, store bool) (string, error) {
 	}
 
 	if store {
-		if err := t.datastore.CafeTokens().Add(&pb.CafeToken{
-			Id:    hex.EncodeToString(key[:12]),
-			Value: safeToken,
-			Date:  ptypes.TimestampNow(),
-		}); err != nil {
+		if conf.MaxRegistrations < 0 {
+			return "", fmt.Errorf("max registrations must not be negative")
+		}
+		ctoken := &pb.CafeToken{
+			Id:               hex.EncodeToString(key[:12]),
+			Value:            safeToken,
+			Date:             ptypes.TimestampNow(),
+			Quota:            conf.Quota,
+			MaxRegistrations: int32(conf.MaxRegistrations),
+			Scopes:           conf.Scopes,
+		}
+		if !conf.Expires.IsZero() {
+			ctoken.Expires, err = ptypes.TimestampProto(conf.Expires)
+			if err != nil {
+				return "", err
+			}
+		}
+		if err := t.datastore.CafeTokens().Add(ctoken); err != nil {
 			return "", err
 		}
 	}
@@ -88,15 +111,25 @@ func (t *Textile) ValidateCafeToken(token string) (bool, error) {
 	return true, nil
 }
 
-// RemoveCafeToken removes a given cafe token from the local store
+// RemoveCafeToken removes a given cafe token from the local store.
+// Clients registered with the token can no longer store content or refresh sessions.
 func (t *Textile) RemoveCafeToken(token string) error {
+	id, err := cafeTokenId(token)
+	if err != nil {
+		return err
+	}
+	return t.datastore.CafeTokens().Delete(id)
+}
+
+// cafeTokenId returns the id of a base58 encoded token
+func cafeTokenId(token string) (string, error) {
 	// dev tokens are actually base58(id+token)
 	plainBytes, err := base58.FastBase58Decoding(token)
 	if err != nil {
-		return err
+		return "", err
 	}
 	if len(plainBytes) < 44 {
-		return fmt.Errorf("invalid token format")
+		return "", fmt.Errorf("invalid token format")
 	}
-	return t.datastore.CafeTokens().Delete(hex.EncodeToString(plainBytes[:12]))
+	return hex.EncodeToString(plainBytes[:12]), nil
 }
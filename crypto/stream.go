@@ -0,0 +1,196 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// StreamChunkSize is the plaintext size of each sealed chunk in a stream.
+// Each ciphertext chunk is StreamChunkSize plus a 16 byte GCM tag.
+const StreamChunkSize = 64 * 1024
+
+const streamChunkOverhead = 16
+
+// ErrInvalidStream indicates that a stream ciphertext is truncated or has been tampered with
+var ErrInvalidStream = fmt.Errorf("invalid stream ciphertext")
+
+// EncryptAESStream returns a reader of the chunked AES-256 GCM encryption of r with key.
+//
+// The plaintext is sealed in chunks of StreamChunkSize. Each chunk nonce is the
+// first 7 bytes of the key nonce, followed by the chunk index and a byte marking
+// the final chunk, which is always shorter than StreamChunkSize (and may be empty).
+// This prevents chunks from being reordered, dropped, or truncated.
+func EncryptAESStream(r io.Reader, key []byte) (io.Reader, error) {
+	aesgcm, err := streamCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptReader{
+		r:      r,
+		aesgcm: aesgcm,
+		prefix: key[32:39],
+		buf:    make([]byte, StreamChunkSize),
+	}, nil
+}
+
+// DecryptAESStream returns a seekable reader of the plaintext of the chunked
+// AES-256 GCM ciphertext in r, which was produced by EncryptAESStream with key.
+// Chunks are authenticated as they are read.
+func DecryptAESStream(r io.ReadSeeker, key []byte) (io.ReadSeeker, error) {
+	aesgcm, err := streamCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	chunks := size/(StreamChunkSize+streamChunkOverhead) + 1
+	if size%(StreamChunkSize+streamChunkOverhead) < streamChunkOverhead {
+		return nil, ErrInvalidStream
+	}
+
+	return &decryptReader{
+		r:      r,
+		aesgcm: aesgcm,
+		prefix: key[32:39],
+		chunks: chunks,
+		size:   size - chunks*streamChunkOverhead,
+		index:  -1,
+		buf:    make([]byte, StreamChunkSize+streamChunkOverhead),
+	}, nil
+}
+
+func streamCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != 44 {
+		return nil, fmt.Errorf("invalid key")
+	}
+	block, err := aes.NewCipher(key[:32])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func streamNonce(prefix []byte, index int64, final bool) []byte {
+	nonce := make([]byte, 12)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[7:], uint32(index))
+	if final {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+type encryptReader struct {
+	r      io.Reader
+	aesgcm cipher.AEAD
+	prefix []byte
+	buf    []byte
+	sealed []byte
+	out    []byte
+	index  int64
+	done   bool
+}
+
+func (e *encryptReader) Read(p []byte) (int, error) {
+	for len(e.out) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(e.r, e.buf)
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			e.done = true
+		default:
+			return 0, err
+		}
+
+		nonce := streamNonce(e.prefix, e.index, e.done)
+		e.sealed = e.aesgcm.Seal(e.sealed[:0], nonce, e.buf[:n], nil)
+		e.out = e.sealed
+		e.index++
+	}
+
+	n := copy(p, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+type decryptReader struct {
+	r      io.ReadSeeker
+	aesgcm cipher.AEAD
+	prefix []byte
+	chunks int64
+	size   int64
+	offset int64
+	index  int64
+	buf    []byte
+	plain  []byte
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	if d.offset >= d.size {
+		return 0, io.EOF
+	}
+
+	index := d.offset / StreamChunkSize
+	if index != d.index {
+		if err := d.open(index); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.plain[d.offset-index*StreamChunkSize:])
+	d.offset += int64(n)
+	return n, nil
+}
+
+func (d *decryptReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += d.offset
+	case io.SeekEnd:
+		offset += d.size
+	default:
+		return 0, fmt.Errorf("invalid whence")
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position")
+	}
+	d.offset = offset
+	return offset, nil
+}
+
+// open reads and authenticates the chunk at index
+func (d *decryptReader) open(index int64) error {
+	_, err := d.r.Seek(index*(StreamChunkSize+streamChunkOverhead), io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	final := index == d.chunks-1
+	size := StreamChunkSize + streamChunkOverhead
+	if final {
+		size = int(d.size-index*StreamChunkSize) + streamChunkOverhead
+	}
+	if _, err := io.ReadFull(d.r, d.buf[:size]); err != nil {
+		return err
+	}
+
+	nonce := streamNonce(d.prefix, index, final)
+	d.plain, err = d.aesgcm.Open(d.plain[:0], nonce, d.buf[:size], nil)
+	if err != nil {
+		d.index = -1
+		return ErrInvalidStream
+	}
+	d.index = index
+	return nil
+}
@@ -0,0 +1,124 @@
+package crypto_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	. "github.com/textileio/go-textile/crypto"
+)
+
+func TestEncryptAESStream(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, size := range []int{0, 9, StreamChunkSize, StreamChunkSize*3 + 100} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+
+		reader, err := EncryptAESStream(bytes.NewReader(plaintext), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ciphertext, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dreader, err := DecryptAESStream(bytes.NewReader(ciphertext), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decrypted, err := ioutil.ReadAll(dreader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(plaintext, decrypted) {
+			t.Errorf("decrypt AES stream of %d bytes failed", size)
+		}
+	}
+}
+
+func TestDecryptAESStream_Seek(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := make([]byte, StreamChunkSize*2+10)
+	rand.Read(plaintext)
+
+	reader, err := EncryptAESStream(bytes.NewReader(plaintext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dreader, err := DecryptAESStream(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, err := dreader.Seek(0, io.SeekEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != int64(len(plaintext)) {
+		t.Fatalf("wrong size: %d", size)
+	}
+
+	offset := int64(StreamChunkSize - 5)
+	if _, err := dreader.Seek(offset, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	part := make([]byte, 20)
+	if _, err := io.ReadFull(dreader, part); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(part, plaintext[offset:offset+20]) {
+		t.Error("read after seek failed")
+	}
+}
+
+func TestDecryptAESStream_Tampered(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := make([]byte, StreamChunkSize*2)
+	rand.Read(plaintext)
+
+	reader, err := EncryptAESStream(bytes.NewReader(plaintext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// drop the final chunk
+	truncated := ciphertext[:len(ciphertext)-16]
+	dreader, err := DecryptAESStream(bytes.NewReader(truncated), key)
+	if err == nil {
+		_, err = ioutil.ReadAll(dreader)
+	}
+	if err == nil {
+		t.Error("decrypt AES stream of truncated ciphertext succeeded")
+	}
+
+	// flip a bit
+	ciphertext[10] ^= 1
+	dreader, err = DecryptAESStream(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(dreader); err != ErrInvalidStream {
+		t.Error("decrypt AES stream of modified ciphertext succeeded")
+	}
+}
@@ -3,7 +3,10 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
 	"fmt"
 )
 
@@ -16,6 +19,15 @@ func GenerateAESKey() ([]byte, error) {
 	return key, nil
 }
 
+// DeriveAESKey returns 44 bytes derived from secret and the digest of data, suitable for
+// convergent encryption: the same data always encrypts to the same ciphertext under a secret.
+func DeriveAESKey(secret []byte, data []byte) []byte {
+	sum := sha256.Sum256(data)
+	mac := hmac.New(sha512.New, secret)
+	_, _ = mac.Write(sum[:])
+	return mac.Sum(nil)[:44]
+}
+
 // EncryptAES performs AES-256 GCM encryption on the provided bytes with key
 func EncryptAES(bytes []byte, key []byte) ([]byte, error) {
 	if len(key) != 44 {
@@ -1,6 +1,7 @@
 package crypto_test
 
 import (
+	"bytes"
 	"testing"
 
 	. "github.com/textileio/go-textile/crypto"
@@ -47,3 +48,16 @@ func TestDecryptAES(t *testing.T) {
 		t.Error("decrypt AES with bad key succeeded")
 	}
 }
+
+func TestDeriveAESKey(t *testing.T) {
+	key := DeriveAESKey([]byte("secret"), symmetricTestData.plaintext)
+	if len(key) != 44 {
+		t.Fatal("wrong key length")
+	}
+	if !bytes.Equal(key, DeriveAESKey([]byte("secret"), symmetricTestData.plaintext)) {
+		t.Error("derived keys do not match")
+	}
+	if bytes.Equal(key, DeriveAESKey([]byte("other"), symmetricTestData.plaintext)) {
+		t.Error("derived keys for different secrets match")
+	}
+}
@@ -1,10 +1,12 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
 	"html/template"
+	"io/ioutil"
 	"net/http"
 	"strings"
 	"time"
@@ -155,6 +157,10 @@ func (g *Gateway) ipfsHandler(c *gin.Context) {
 			return
 		}
 		plain, err := crypto.DecryptAES(data, keyb)
+		if err != nil {
+			// content may have been encrypted as a stream
+			plain, err = decryptAESStream(data, keyb)
+		}
 		if err != nil {
 			log.Debugf("error decrypting %s: %s", contentPath, err)
 			g.render404(c)
@@ -369,3 +375,12 @@ func getCafeInfo(addr string) (*pb.Cafe, error) {
 	}
 	return &info, nil
 }
+
+// decryptAESStream decrypts chunked ciphertext in full
+func decryptAESStream(data []byte, key []byte) ([]byte, error) {
+	reader, err := crypto.DecryptAESStream(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(reader)
+}
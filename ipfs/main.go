@@ -3,6 +3,7 @@ package ipfs
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
@@ -58,6 +59,66 @@ func DataAtPath(node *core.IpfsNode, pth string) ([]byte, error) {
 	return ioutil.ReadAll(file)
 }
 
+// FileAtPath returns a seekable reader of the file under an ipfs path.
+// Unlike DataAtPath, data is fetched as the reader is consumed, so only each
+// read is bound by CatTimeout, not the whole file. The file must be closed.
+func FileAtPath(node *core.IpfsNode, pth string) (files.File, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	rctx, rcancel := context.WithTimeout(node.Context(), CatTimeout)
+	defer rcancel()
+
+	rpth, err := api.ResolvePath(rctx, path.New(pth))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(node.Context())
+	f, err := api.Unixfs().Get(ctx, rpth)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	switch f := f.(type) {
+	case files.File:
+		return &timeoutFile{File: f, cancel: cancel}, nil
+	case files.Directory:
+		cancel()
+		return nil, iface.ErrIsDir
+	default:
+		cancel()
+		return nil, iface.ErrNotSupported
+	}
+}
+
+// timeoutFile cancels a file's context if a read or seek takes longer than CatTimeout,
+// e.g., when a block can't be found
+type timeoutFile struct {
+	files.File
+	cancel context.CancelFunc
+}
+
+func (f *timeoutFile) Read(p []byte) (int, error) {
+	timer := time.AfterFunc(CatTimeout, f.cancel)
+	defer timer.Stop()
+	return f.File.Read(p)
+}
+
+func (f *timeoutFile) Seek(offset int64, whence int) (int64, error) {
+	timer := time.AfterFunc(CatTimeout, f.cancel)
+	defer timer.Stop()
+	return f.File.Seek(offset, whence)
+}
+
+func (f *timeoutFile) Close() error {
+	f.cancel()
+	return f.File.Close()
+}
+
 // LinksAtPath return ipld links under a path
 func LinksAtPath(node *core.IpfsNode, pth string) ([]*ipld.Link, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -162,6 +223,32 @@ func AddData(node *core.IpfsNode, reader io.Reader, pin bool, hashOnly bool) (*i
 	return &id, nil
 }
 
+// AddStream is like AddData, but without a timeout, for readers of unknown (and possibly large) size
+func AddStream(node *core.IpfsNode, reader io.Reader, pin bool) (*icid.Cid, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	pth, err := api.Unixfs().Add(node.Context(), files.NewReaderFile(reader))
+	if err != nil {
+		return nil, err
+	}
+
+	if pin {
+		ctx, cancel := context.WithTimeout(node.Context(), PinTimeout)
+		defer cancel()
+
+		err = api.Pin().Add(ctx, pth, options.Pin.Recursive(false))
+		if err != nil {
+			return nil, err
+		}
+	}
+	id := pth.Cid()
+
+	return &id, nil
+}
+
 // AddObject takes a reader and adds it as a DAG node, optionally pins it
 func AddObject(node *core.IpfsNode, reader io.Reader, pin bool) (*icid.Cid, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -212,6 +299,77 @@ func NodeAtCid(node *core.IpfsNode, id icid.Cid) (ipld.Node, error) {
 	return api.Dag().Get(ctx, id)
 }
 
+// LocalNodeAtCid returns the node behind a cid from the local blockstore only
+func LocalNodeAtCid(node *core.IpfsNode, id icid.Cid) (ipld.Node, error) {
+	block, err := node.Blockstore.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return ipld.Decode(block)
+}
+
+// PutLocalNode adds a raw or dag-pb node to the local blockstore without
+// announcing it, ensuring the data matches the cid
+func PutLocalNode(node *core.IpfsNode, id icid.Cid, data []byte) error {
+	var nd ipld.Node
+	switch id.Type() {
+	case icid.DagProtobuf:
+		pnode, err := dag.DecodeProtobuf(data)
+		if err != nil {
+			return err
+		}
+		pnode.SetCidBuilder(id.Prefix())
+		nd = pnode
+	case icid.Raw:
+		rnode, err := dag.NewRawNodeWPrefix(data, id.Prefix())
+		if err != nil {
+			return err
+		}
+		nd = rnode
+	default:
+		return fmt.Errorf("unsupported codec: %d", id.Type())
+	}
+	if !nd.Cid().Equals(id) {
+		return fmt.Errorf("data does not match %s", id.String())
+	}
+
+	return node.Blockstore.Put(nd)
+}
+
+// RemoveLocalCid deletes a cid from the local blockstore, along with its
+// descendants if recursive. Anything still pinned is kept.
+func RemoveLocalCid(node *core.IpfsNode, id icid.Cid, recursive bool) error {
+	defer node.Blockstore.PinLock().Unlock()
+
+	return removeLocalCid(node, id, recursive)
+}
+
+func removeLocalCid(node *core.IpfsNode, id icid.Cid, recursive bool) error {
+	has, err := node.Blockstore.Has(id)
+	if err != nil || !has {
+		return err
+	}
+	_, pinned, err := node.Pinning.IsPinned(id)
+	if err != nil || pinned {
+		return err
+	}
+
+	if recursive {
+		nd, err := LocalNodeAtCid(node, id)
+		if err != nil {
+			return err
+		}
+		for _, link := range nd.Links() {
+			err = removeLocalCid(node, link.Cid, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return node.Blockstore.DeleteBlock(id)
+}
+
 // NodeAtPath returns the last node under path
 func NodeAtPath(node *core.IpfsNode, pth string, timeout time.Duration) (ipld.Node, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -3,8 +3,10 @@ package jwt_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	peer "github.com/libp2p/go-libp2p-core/peer"
 	"github.com/textileio/go-textile/ipfs"
 	. "github.com/textileio/go-textile/jwt"
 )
@@ -94,3 +96,31 @@ func TestGenerateEd25519Token(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestNewSession_Until(t *testing.T) {
+	sk, err := ipfs.UnmarshalPrivateKeyFromString(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	until := time.Now().Add(time.Hour)
+	session, err := NewSession(sk, pid, "/textile/cafe/1.0.0", time.Hour*24, until, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Exp.Seconds != until.Unix() || session.Rexp.Seconds != until.Unix() {
+		t.Fatal("session should expire at until")
+	}
+
+	session, err = NewSession(sk, pid, "/textile/cafe/1.0.0", time.Minute, until, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Exp.Seconds >= until.Unix() || session.Rexp.Seconds >= until.Unix() {
+		t.Fatal("session should expire before until")
+	}
+}
@@ -20,10 +20,24 @@ var ErrExpired = fmt.Errorf("token expired")
 var ErrInvalid = fmt.Errorf("token invalid")
 
 type TextileClaims struct {
-	Scope Scope `json:"scopes"`
+	Scope    Scope    `json:"scopes"`
+	Services []string `json:"services,omitempty"` // cafe services the session may use, all if empty
 	jwt.StandardClaims
 }
 
+// Allows returns whether or not the claims allow use of a cafe service
+func (c *TextileClaims) Allows(service string) bool {
+	if len(c.Services) == 0 {
+		return true
+	}
+	for _, s := range c.Services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
 type Scope string
 
 const (
@@ -31,7 +45,9 @@ const (
 	Refresh Scope = "refresh"
 )
 
-func NewSession(sk libp2pc.PrivKey, pid peer.ID, proto protocol.ID, duration time.Duration, cafe *pb.Cafe) (*pb.CafeSession, error) {
+// NewSession returns a signed access and refresh token pair. Neither token
+// expires after until, if not zero.
+func NewSession(sk libp2pc.PrivKey, pid peer.ID, proto protocol.ID, duration time.Duration, until time.Time, cafe *pb.Cafe, services []string) (*pb.CafeSession, error) {
 	issuer, err := peer.IDFromPrivateKey(sk)
 	if err != nil {
 		return nil, err
@@ -40,9 +56,10 @@ func NewSession(sk libp2pc.PrivKey, pid peer.ID, proto protocol.ID, duration tim
 
 	// build access token
 	now := time.Now()
-	exp := now.Add(duration)
+	exp := capTime(now.Add(duration), until)
 	claims := &TextileClaims{
-		Scope: Access,
+		Scope:    Access,
+		Services: services,
 		StandardClaims: jwt.StandardClaims{
 			Audience:  string(proto),
 			ExpiresAt: exp.Unix(),
@@ -58,9 +75,10 @@ func NewSession(sk libp2pc.PrivKey, pid peer.ID, proto protocol.ID, duration tim
 	}
 
 	// build refresh token
-	rexp := now.Add(duration * 2)
+	rexp := capTime(now.Add(duration*2), until)
 	rclaims := &TextileClaims{
-		Scope: Refresh,
+		Scope:    Refresh,
+		Services: services,
 		StandardClaims: jwt.StandardClaims{
 			Audience:  string(proto),
 			ExpiresAt: rexp.Unix(),
@@ -96,6 +114,14 @@ func NewSession(sk libp2pc.PrivKey, pid peer.ID, proto protocol.ID, duration tim
 	}, nil
 }
 
+// capTime returns t, or until if it's earlier and not zero
+func capTime(t time.Time, until time.Time) time.Time {
+	if !until.IsZero() && until.Before(t) {
+		return until
+	}
+	return t
+}
+
 func ParseClaims(claims jwt.Claims) (*TextileClaims, error) {
 	mapClaims, ok := claims.(jwt.MapClaims)
 	if !ok {
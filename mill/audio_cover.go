@@ -0,0 +1,67 @@
+package mill
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dhowden/tag"
+)
+
+// ErrMissingCover indicates the audio does not contain embedded cover art
+var ErrMissingCover = fmt.Errorf("audio does not have cover art")
+
+type AudioCover struct {
+	Opts ImageResizeOpts
+}
+
+func (m *AudioCover) ID() string {
+	return "/audio/cover"
+}
+
+func (m *AudioCover) Encrypt() bool {
+	return true
+}
+
+func (m *AudioCover) Pin() bool {
+	return false
+}
+
+func (m *AudioCover) AcceptMedia(media string) error {
+	return accepts(audioMedia, media)
+}
+
+func (m *AudioCover) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(m.Opts, add)
+}
+
+// OutputMedia returns the format option, which defaults to jpeg
+func (m *AudioCover) OutputMedia(media string) string {
+	return "image/" + string(m.resizer().outputFormat(""))
+}
+
+// Mill extracts the embedded cover art and hands it off to the image resize mill
+func (m *AudioCover) Mill(input []byte, name string) (*Result, error) {
+	meta, err := tag.ReadFrom(bytes.NewReader(input))
+	if err != nil {
+		if err == tag.ErrNoTagsFound {
+			return nil, ErrMissingCover
+		}
+		return nil, err
+	}
+
+	pic := meta.Picture()
+	if pic == nil || len(pic.Data) == 0 {
+		return nil, ErrMissingCover
+	}
+
+	return m.resizer().Mill(pic.Data, name)
+}
+
+// resizer returns an image resize mill that always encodes as jpeg unless another format is given
+func (m *AudioCover) resizer() *ImageResize {
+	opts := m.Opts
+	if opts.Format == "" {
+		opts.Format = string(JPEG)
+	}
+	return &ImageResize{Opts: opts}
+}
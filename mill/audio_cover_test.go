@@ -0,0 +1,42 @@
+package mill
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestAudioCover_Mill(t *testing.T) {
+	m := &AudioCover{
+		Opts: ImageResizeOpts{
+			Width:   "100",
+			Quality: "80",
+		},
+	}
+
+	cover, err := ioutil.ReadFile("testdata/image.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := m.Mill(testMp3(cover), "test.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Meta["width"] != 100 {
+		t.Errorf("wrong width")
+	}
+}
+
+func TestAudioCover_MillMissingCover(t *testing.T) {
+	m := &AudioCover{
+		Opts: ImageResizeOpts{
+			Width:   "100",
+			Quality: "80",
+		},
+	}
+
+	if _, err := m.Mill(testMp3(nil), "test.mp3"); err != ErrMissingCover {
+		t.Fatal("expected missing cover error")
+	}
+}
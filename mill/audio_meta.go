@@ -0,0 +1,241 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/dhowden/tag"
+)
+
+// audioMedia lists the audio media types accepted by the audio mills
+var audioMedia = []string{
+	"audio/mpeg",
+	"audio/mp4",
+	"audio/flac",
+	"audio/ogg",
+	"application/ogg",
+}
+
+type AudioMetaSchema struct {
+	Name        string  `json:"name"`
+	Ext         string  `json:"extension"`
+	Format      string  `json:"format"`
+	Title       string  `json:"title,omitempty"`
+	Artist      string  `json:"artist,omitempty"`
+	AlbumArtist string  `json:"album_artist,omitempty"`
+	Album       string  `json:"album,omitempty"`
+	Genre       string  `json:"genre,omitempty"`
+	Year        int     `json:"year,omitempty"`
+	Track       int     `json:"track,omitempty"`
+	Disc        int     `json:"disc,omitempty"`
+	Duration    float64 `json:"duration"`
+	Cover       bool    `json:"cover"`
+}
+
+type AudioMeta struct{}
+
+func (m *AudioMeta) ID() string {
+	return "/audio/meta"
+}
+
+func (m *AudioMeta) Encrypt() bool {
+	return true
+}
+
+func (m *AudioMeta) Pin() bool {
+	return false
+}
+
+func (m *AudioMeta) AcceptMedia(media string) error {
+	return accepts(audioMedia, media)
+}
+
+func (m *AudioMeta) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *AudioMeta) Mill(input []byte, name string) (*Result, error) {
+	res := &AudioMetaSchema{
+		Name:     name,
+		Ext:      strings.ToLower(filepath.Ext(name)),
+		Duration: audioDuration(input),
+	}
+
+	// untagged audio is still valid, it just lacks descriptive fields
+	meta, err := tag.ReadFrom(bytes.NewReader(input))
+	if err == nil {
+		res.Format = strings.ToLower(string(meta.FileType()))
+		res.Title = meta.Title()
+		res.Artist = meta.Artist()
+		res.AlbumArtist = meta.AlbumArtist()
+		res.Album = meta.Album()
+		res.Genre = meta.Genre()
+		res.Year = meta.Year()
+		res.Track, _ = meta.Track()
+		res.Disc, _ = meta.Disc()
+		res.Cover = meta.Picture() != nil
+	} else if err != tag.ErrNoTagsFound {
+		return nil, err
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{File: data}, nil
+}
+
+// audioDuration returns the length of the audio in seconds, or zero if it cannot be determined
+func audioDuration(data []byte) float64 {
+	switch {
+	case len(data) < 12:
+		return 0
+	case string(data[4:8]) == "ftyp":
+		for _, box := range mp4Boxes(data) {
+			if box.typ != "moov" {
+				continue
+			}
+			for _, mbox := range mp4Boxes(box.data) {
+				if mbox.typ == "mvhd" {
+					_, dur := parseMvhd(mbox.data)
+					return dur
+				}
+			}
+		}
+		return 0
+	case string(data[0:4]) == "fLaC":
+		return flacDuration(data)
+	case string(data[0:4]) == "OggS":
+		return oggDuration(data)
+	default:
+		return mp3Duration(data)
+	}
+}
+
+// flacDuration reads the total samples and sample rate from the STREAMINFO block
+func flacDuration(data []byte) float64 {
+	if len(data) < 8+18 || data[4]&0x7f != 0 {
+		return 0
+	}
+	info := data[8:]
+	rate := uint64(info[10])<<12 | uint64(info[11])<<4 | uint64(info[12])>>4
+	samples := uint64(info[13]&0x0f)<<32 | uint64(binary.BigEndian.Uint32(info[14:18]))
+	if rate == 0 {
+		return 0
+	}
+	return float64(samples) / float64(rate)
+}
+
+// oggDuration reads the sample rate from the identification header and the
+// final granule position from the last page
+func oggDuration(data []byte) float64 {
+	if len(data) < 27 {
+		return 0
+	}
+	start := 27 + int(data[26])
+	if len(data) < start+19 {
+		return 0
+	}
+	packet := data[start:]
+
+	var rate, skip uint64
+	switch {
+	case string(packet[0:7]) == "\x01vorbis":
+		rate = uint64(binary.LittleEndian.Uint32(packet[12:16]))
+	case string(packet[0:8]) == "OpusHead":
+		// opus granule positions are always 48kHz
+		rate = 48000
+		skip = uint64(binary.LittleEndian.Uint16(packet[10:12]))
+	default:
+		return 0
+	}
+
+	last := bytes.LastIndex(data, []byte("OggS"))
+	if rate == 0 || last < 0 || len(data) < last+14 {
+		return 0
+	}
+	granule := binary.LittleEndian.Uint64(data[last+6 : last+14])
+	if granule < skip {
+		return 0
+	}
+	return float64(granule-skip) / float64(rate)
+}
+
+var mp3Bitrates = map[bool][]int{
+	true:  {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	false: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+}
+
+var mp3SampleRates = map[byte][]int{
+	0: {11025, 12000, 8000},  // MPEG 2.5
+	2: {22050, 24000, 16000}, // MPEG 2
+	3: {44100, 48000, 32000}, // MPEG 1
+}
+
+// mp3Duration finds the first layer III frame and uses its Xing / Info header,
+// falling back to a constant bitrate estimate
+func mp3Duration(data []byte) float64 {
+	end := len(data)
+	if end > 128 && string(data[end-128:end-125]) == "TAG" {
+		end -= 128
+	}
+
+	offset := 0
+	if string(data[0:3]) == "ID3" && len(data) >= 10 {
+		size := int(data[6]&0x7f)<<21 | int(data[7]&0x7f)<<14 | int(data[8]&0x7f)<<7 | int(data[9]&0x7f)
+		offset = 10 + size
+		if data[5]&0x10 != 0 {
+			offset += 10
+		}
+	}
+
+	for ; offset+4 <= end; offset++ {
+		if data[offset] != 0xff || data[offset+1]&0xe0 != 0xe0 {
+			continue
+		}
+		version := (data[offset+1] >> 3) & 0x03
+		layer := (data[offset+1] >> 1) & 0x03
+		bitrateIdx := int(data[offset+2] >> 4)
+		rateIdx := int((data[offset+2] >> 2) & 0x03)
+		rates, ok := mp3SampleRates[version]
+		if !ok || layer != 1 || bitrateIdx == 0 || bitrateIdx == 15 || rateIdx == 3 {
+			continue
+		}
+
+		mpeg1 := version == 3
+		mono := data[offset+3]>>6 == 3
+		rate := rates[rateIdx]
+		bitrate := mp3Bitrates[mpeg1][bitrateIdx] * 1000
+
+		samples := 576
+		side := 9
+		if mono && mpeg1 {
+			side = 17
+		} else if !mono {
+			side = 17
+			if mpeg1 {
+				side = 32
+			}
+		}
+		if mpeg1 {
+			samples = 1152
+		}
+
+		xing := offset + 4 + side
+		if xing+12 <= end {
+			id := string(data[xing : xing+4])
+			flags := binary.BigEndian.Uint32(data[xing+4 : xing+8])
+			if (id == "Xing" || id == "Info") && flags&0x01 != 0 {
+				frames := binary.BigEndian.Uint32(data[xing+8 : xing+12])
+				return float64(frames) * float64(samples) / float64(rate)
+			}
+		}
+
+		return float64(end-offset) * 8 / float64(bitrate)
+	}
+	return 0
+}
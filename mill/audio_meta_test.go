@@ -0,0 +1,93 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+)
+
+func TestAudioMeta_Mill(t *testing.T) {
+	m := &AudioMeta{}
+
+	res, err := m.Mill(testMp3(nil), "test.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var meta *AudioMetaSchema
+	if err := json.Unmarshal(res.File, &meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if meta.Format != "mp3" {
+		t.Errorf("wrong format")
+	}
+	if meta.Title != "Song" {
+		t.Errorf("wrong title")
+	}
+	if meta.Artist != "Band" {
+		t.Errorf("wrong artist")
+	}
+	if meta.Album != "Record" {
+		t.Errorf("wrong album")
+	}
+	if meta.Duration < 59.9 || meta.Duration > 60.1 {
+		t.Errorf("wrong duration: %f", meta.Duration)
+	}
+	if meta.Cover {
+		t.Errorf("should not have cover")
+	}
+}
+
+func TestAudioMeta_FlacDuration(t *testing.T) {
+	info := make([]byte, 34)
+	// 44100 Hz, 2 channels, 16 bits, 441000 samples
+	binary.BigEndian.PutUint32(info[10:], 44100<<12|1<<9|15<<4)
+	binary.BigEndian.PutUint32(info[14:], 441000)
+
+	data := append([]byte("fLaC\x00\x00\x00\x22"), info...)
+	if dur := audioDuration(data); dur != 10 {
+		t.Errorf("wrong duration: %f", dur)
+	}
+}
+
+// testMp3 builds an ID3v2.3 tagged MPEG 1 layer III stream with a Xing header
+// describing 60 seconds of audio, optionally including cover art
+func testMp3(cover []byte) []byte {
+	var frames bytes.Buffer
+	text := func(id string, val string) {
+		frames.Write(testId3Frame(id, append([]byte{0x00}, val...)))
+	}
+	text("TIT2", "Song")
+	text("TPE1", "Band")
+	text("TALB", "Record")
+	if cover != nil {
+		pic := append([]byte("\x00image/png\x00\x03\x00"), cover...)
+		frames.Write(testId3Frame("APIC", pic))
+	}
+
+	size := frames.Len()
+	head := []byte{'I', 'D', '3', 0x03, 0x00, 0x00,
+		byte(size >> 21 & 0x7f), byte(size >> 14 & 0x7f), byte(size >> 7 & 0x7f), byte(size & 0x7f)}
+
+	// 128kbps, 44.1kHz, stereo
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+	copy(frame[36:], "Xing")
+	binary.BigEndian.PutUint32(frame[40:], 0x01)
+	binary.BigEndian.PutUint32(frame[44:], 2297) // 2297 * 1152 / 44100 ~= 60s
+
+	var file bytes.Buffer
+	file.Write(head)
+	file.Write(frames.Bytes())
+	file.Write(frame)
+	return file.Bytes()
+}
+
+func testId3Frame(id string, data []byte) []byte {
+	frame := make([]byte, 10, 10+len(data))
+	copy(frame, id)
+	binary.BigEndian.PutUint32(frame[4:], uint32(len(data)))
+	return append(frame, data...)
+}
@@ -1,6 +1,14 @@
 package mill
 
-type Blob struct{}
+import "io"
+
+type BlobOpts struct {
+	Chunked string `json:"chunked,omitempty"`
+}
+
+type Blob struct {
+	Opts BlobOpts
+}
 
 func (m *Blob) ID() string {
 	return "/blob"
@@ -19,9 +27,18 @@ func (m *Blob) AcceptMedia(media string) error {
 }
 
 func (m *Blob) Options(add map[string]interface{}) (string, error) {
-	return hashOpts(make(map[string]string), add)
+	return hashOpts(m.Opts, add)
+}
+
+// Chunked is true when output should be stored as deduplicated chunks
+func (m *Blob) Chunked() bool {
+	return m.Opts.Chunked == "true"
 }
 
 func (m *Blob) Mill(input []byte, name string) (*Result, error) {
 	return &Result{File: input}, nil
 }
+
+func (m *Blob) MillStream(input io.Reader, name string) (*StreamResult, error) {
+	return &StreamResult{File: input}, nil
+}
@@ -1,6 +1,8 @@
 package mill
 
 import (
+	"bytes"
+	"io/ioutil"
 	"math/rand"
 	"testing"
 )
@@ -15,3 +17,39 @@ func TestBlob_Mill(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBlob_MillStream(t *testing.T) {
+	m := &Blob{}
+
+	input := make([]byte, 512)
+	rand.Read(input)
+
+	res, err := m.MillStream(bytes.NewReader(input), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := ioutil.ReadAll(res.File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, output) {
+		t.Error("output does not match input")
+	}
+}
+
+func TestBlob_Options(t *testing.T) {
+	opts, err := (&Blob{}).Options(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunked, err := (&Blob{Opts: BlobOpts{Chunked: "true"}}).Options(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts == chunked {
+		t.Error("chunked option not included in hash")
+	}
+	if !(&Blob{Opts: BlobOpts{Chunked: "true"}}).Chunked() {
+		t.Error("blob should be chunked")
+	}
+}
@@ -0,0 +1,154 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"rsc.io/pdf"
+)
+
+// docMedia lists the document media types accepted by the document mills
+var docMedia = []string{
+	"application/pdf",
+	"text/plain; charset=utf-8",
+}
+
+type DocMetaSchema struct {
+	Created time.Time `json:"created,omitempty"`
+	Name    string    `json:"name"`
+	Ext     string    `json:"extension"`
+	Format  string    `json:"format"`
+	Pages   int       `json:"pages,omitempty"`
+	Title   string    `json:"title,omitempty"`
+	Author  string    `json:"author,omitempty"`
+	Subject string    `json:"subject,omitempty"`
+	Creator string    `json:"creator,omitempty"`
+	Words   int       `json:"words"`
+}
+
+type DocMeta struct{}
+
+func (m *DocMeta) ID() string {
+	return "/doc/meta"
+}
+
+func (m *DocMeta) Encrypt() bool {
+	return true
+}
+
+func (m *DocMeta) Pin() bool {
+	return false
+}
+
+func (m *DocMeta) AcceptMedia(media string) error {
+	return accepts(docMedia, media)
+}
+
+func (m *DocMeta) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *DocMeta) Mill(input []byte, name string) (*Result, error) {
+	res := &DocMetaSchema{
+		Name: name,
+		Ext:  strings.ToLower(filepath.Ext(name)),
+	}
+
+	if isPdf(input) {
+		var text string
+		err := readPdf(input, func(r *pdf.Reader) {
+			info := r.Trailer().Key("Info")
+			res.Title = info.Key("Title").Text()
+			res.Author = info.Key("Author").Text()
+			res.Subject = info.Key("Subject").Text()
+			res.Creator = info.Key("Creator").Text()
+			res.Created = parsePdfDate(info.Key("CreationDate").Text())
+			res.Pages = r.NumPage()
+			text = pdfText(r)
+		})
+		if err != nil {
+			return nil, err
+		}
+		res.Format = "pdf"
+		res.Words = len(strings.Fields(text))
+	} else {
+		res.Format = "text"
+		res.Words = len(strings.Fields(string(input)))
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{File: data}, nil
+}
+
+func isPdf(input []byte) bool {
+	return bytes.HasPrefix(input, []byte("%PDF-"))
+}
+
+// readPdf opens input as a pdf and calls fn with the reader.
+// The pdf package panics on malformed input, which is returned as an error.
+func readPdf(input []byte, fn func(r *pdf.Reader)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed pdf: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(input), int64(len(input)))
+	if err != nil {
+		return err
+	}
+	fn(reader)
+	return nil
+}
+
+// pdfText joins the text of all pages, one line per text baseline
+func pdfText(r *pdf.Reader) string {
+	var buf strings.Builder
+	for i := 1; i <= r.NumPage(); i++ {
+		page := r.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+
+		text := page.Content().Text
+		sort.Stable(pdf.TextVertical(text))
+
+		for j, t := range text {
+			if j > 0 {
+				prev := text[j-1]
+				if math.Abs(prev.Y-t.Y) > prev.FontSize/2 {
+					buf.WriteString("\n")
+				} else if t.X-(prev.X+prev.W) > prev.FontSize/5 {
+					buf.WriteString(" ")
+				}
+			}
+			buf.WriteString(t.S)
+		}
+		buf.WriteString("\n\n")
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// parsePdfDate parses the D:YYYYMMDDHHmmSS prefix of a pdf date string
+func parsePdfDate(str string) time.Time {
+	str = strings.TrimPrefix(str, "D:")
+	if len(str) > 14 {
+		str = str[:14]
+	}
+	layout := "20060102150405"[:len(str)]
+	date, err := time.Parse(layout, str)
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
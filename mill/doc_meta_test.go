@@ -0,0 +1,93 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDocMeta_Mill(t *testing.T) {
+	m := &DocMeta{}
+
+	res, err := m.Mill(testPdf(), "test.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var meta *DocMetaSchema
+	if err := json.Unmarshal(res.File, &meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if meta.Format != "pdf" {
+		t.Errorf("wrong format")
+	}
+	if meta.Pages != 1 {
+		t.Errorf("wrong page count")
+	}
+	if meta.Title != "Quarterly Report" {
+		t.Errorf("wrong title")
+	}
+	if meta.Author != "Textile" {
+		t.Errorf("wrong author")
+	}
+	if meta.Created.Year() != 2019 {
+		t.Errorf("wrong created date")
+	}
+	if meta.Words != 4 {
+		t.Errorf("wrong word count")
+	}
+}
+
+func TestDocMeta_MillText(t *testing.T) {
+	m := &DocMeta{}
+
+	res, err := m.Mill([]byte("just some notes"), "notes.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var meta *DocMetaSchema
+	if err := json.Unmarshal(res.File, &meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if meta.Format != "text" {
+		t.Errorf("wrong format")
+	}
+	if meta.Words != 3 {
+		t.Errorf("wrong word count")
+	}
+}
+
+// testPdf builds a single page pdf with two lines of text
+func testPdf() []byte {
+	widths := strings.TrimSpace(strings.Repeat("500 ", 126-32+1))
+	content := "BT /F1 12 Tf 72 720 Td (Hello textile) Tj 0 -20 Td (Second line) Tj ET"
+	objs := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /FirstChar 32 /LastChar 126 /Widths [%s] >>", widths),
+		"<< /Title (Quarterly Report) /Author (Textile) /CreationDate (D:20190102030405Z) >>",
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objs))
+	for i, obj := range objs {
+		offsets[i] = buf.Len()
+		buf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", i+1, obj))
+	}
+
+	xref := buf.Len()
+	buf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(objs)+1))
+	for _, off := range offsets {
+		buf.WriteString(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R /Info 6 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objs)+1, xref))
+	return buf.Bytes()
+}
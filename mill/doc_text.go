@@ -0,0 +1,52 @@
+package mill
+
+import (
+	"unicode/utf8"
+
+	"rsc.io/pdf"
+)
+
+type DocText struct{}
+
+func (m *DocText) ID() string {
+	return "/doc/text"
+}
+
+func (m *DocText) Encrypt() bool {
+	return true
+}
+
+func (m *DocText) Pin() bool {
+	return false
+}
+
+func (m *DocText) AcceptMedia(media string) error {
+	return accepts(docMedia, media)
+}
+
+func (m *DocText) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *DocText) OutputMedia(media string) string {
+	return "text/plain; charset=utf-8"
+}
+
+func (m *DocText) Mill(input []byte, name string) (*Result, error) {
+	if !isPdf(input) {
+		if !utf8.Valid(input) {
+			return nil, ErrMediaTypeNotSupported
+		}
+		return &Result{File: input}, nil
+	}
+
+	var text string
+	err := readPdf(input, func(r *pdf.Reader) {
+		text = pdfText(r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{File: []byte(text)}, nil
+}
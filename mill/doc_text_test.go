@@ -0,0 +1,26 @@
+package mill
+
+import (
+	"testing"
+)
+
+func TestDocText_Mill(t *testing.T) {
+	m := &DocText{}
+
+	res, err := m.Mill(testPdf(), "test.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(res.File) != "Hello textile\nSecond line" {
+		t.Errorf("wrong text: %s", string(res.File))
+	}
+}
+
+func TestDocText_MillMalformed(t *testing.T) {
+	m := &DocText{}
+
+	if _, err := m.Mill([]byte("%PDF-1.4\nnot really"), "test.pdf"); err == nil {
+		t.Fatal("expected malformed pdf error")
+	}
+}
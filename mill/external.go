@@ -0,0 +1,156 @@
+package mill
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/textileio/go-textile/repo/config"
+)
+
+// defaultExternalTimeout is used when an external mill does not set a timeout
+const defaultExternalTimeout = time.Minute
+
+// ExternalResult is the JSON response of an external mill, where file is base64 encoded
+type ExternalResult struct {
+	File []byte                 `json:"file"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+}
+
+// External runs a mill defined in the textile config.
+//
+// The input is written to the stdin of a command, or sent as the body of a POST
+// request. The file name and options (a JSON object) are passed in the
+// TEXTILE_MILL_NAME and TEXTILE_MILL_OPTS environment variables, or the
+// X-Textile-Name and X-Textile-Opts headers. The mill writes an ExternalResult
+// to stdout, or responds with one.
+type External struct {
+	Conf config.Mill
+	Opts map[string]string
+}
+
+func (m *External) ID() string {
+	return m.Conf.ID
+}
+
+func (m *External) Encrypt() bool {
+	return !m.Conf.Plaintext
+}
+
+func (m *External) Pin() bool {
+	return m.Conf.Pin
+}
+
+func (m *External) AcceptMedia(media string) error {
+	if len(m.Conf.Media) == 0 {
+		return nil
+	}
+	return accepts(m.Conf.Media, media)
+}
+
+func (m *External) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(m.opts(), add)
+}
+
+func (m *External) OutputMedia(media string) string {
+	if m.Conf.Output != "" {
+		return m.Conf.Output
+	}
+	return media
+}
+
+func (m *External) Mill(input []byte, name string) (*Result, error) {
+	timeout := defaultExternalTimeout
+	if m.Conf.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(m.Conf.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	opts, err := json.Marshal(m.opts())
+	if err != nil {
+		return nil, err
+	}
+
+	var output []byte
+	switch {
+	case len(m.Conf.Command) > 0:
+		output, err = m.run(ctx, input, name, opts)
+	case m.Conf.URL != "":
+		output, err = m.post(ctx, input, name, opts)
+	default:
+		err = fmt.Errorf("mill %s has no command or url", m.Conf.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res ExternalResult
+	if err := json.Unmarshal(output, &res); err != nil {
+		return nil, fmt.Errorf("mill %s returned an invalid result: %s", m.Conf.ID, err)
+	}
+	return &Result{File: res.File, Meta: res.Meta}, nil
+}
+
+func (m *External) opts() map[string]string {
+	if m.Opts == nil {
+		return make(map[string]string)
+	}
+	return m.Opts
+}
+
+func (m *External) run(ctx context.Context, input []byte, name string, opts []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, m.Conf.Command[0], m.Conf.Command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"TEXTILE_MILL_NAME="+name,
+		"TEXTILE_MILL_OPTS="+string(opts),
+	)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("mill %s timed out", m.Conf.ID)
+		}
+		return nil, fmt.Errorf("mill %s failed: %s", m.Conf.ID, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func (m *External) post(ctx context.Context, input []byte, name string, opts []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, m.Conf.URL, bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("X-Textile-Name", name)
+	req.Header.Set("X-Textile-Opts", string(opts))
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("mill %s failed: %s", m.Conf.ID, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
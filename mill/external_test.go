@@ -0,0 +1,112 @@
+package mill
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"testing"
+
+	"github.com/textileio/go-textile/repo/config"
+)
+
+func TestExternal_MillCommand(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	m := &External{
+		Conf: config.Mill{
+			ID: "/test/upper",
+			Command: []string{"sh", "-c", `printf '{"file":"%s","meta":{"name":"%s","opts":%s}}' ` +
+				`"$(tr a-z A-Z | base64)" "$TEXTILE_MILL_NAME" "$TEXTILE_MILL_OPTS"`},
+		},
+		Opts: map[string]string{"lang": "en"},
+	}
+
+	res, err := m.Mill([]byte("hello"), "test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.File) != "HELLO" {
+		t.Errorf("wrong output: %s", res.File)
+	}
+	if res.Meta["name"] != "test.txt" {
+		t.Errorf("wrong name: %v", res.Meta["name"])
+	}
+	if opts, ok := res.Meta["opts"].(map[string]interface{}); !ok || opts["lang"] != "en" {
+		t.Errorf("wrong opts: %v", res.Meta["opts"])
+	}
+
+	m.Conf.Command = []string{"sh", "-c", "echo oops >&2; exit 1"}
+	if _, err := m.Mill([]byte("hello"), "test.txt"); err == nil {
+		t.Error("failed command did not return an error")
+	}
+
+	m.Conf.Command = []string{"sleep", "1"}
+	m.Conf.Timeout = "10ms"
+	if _, err := m.Mill([]byte("hello"), "test.txt"); err == nil {
+		t.Error("command did not time out")
+	}
+}
+
+func TestExternal_MillURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if len(body) == 0 {
+			http.Error(w, "missing input", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&ExternalResult{
+			File: append(body, body...),
+			Meta: map[string]interface{}{
+				"name": r.Header.Get("X-Textile-Name"),
+			},
+		})
+	}))
+	defer server.Close()
+
+	m := &External{
+		Conf: config.Mill{
+			ID:     "/test/double",
+			URL:    server.URL,
+			Output: "text/plain",
+		},
+	}
+
+	res, err := m.Mill([]byte("hi"), "test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.File) != "hihi" {
+		t.Errorf("wrong output: %s", res.File)
+	}
+	if res.Meta["name"] != "test.txt" {
+		t.Errorf("wrong name: %v", res.Meta["name"])
+	}
+	if m.OutputMedia("image/png") != "text/plain" {
+		t.Error("wrong output media")
+	}
+
+	if _, err := m.Mill(nil, "test.txt"); err == nil {
+		t.Error("failed request did not return an error")
+	}
+}
+
+func TestExternal_Options(t *testing.T) {
+	m := &External{Conf: config.Mill{ID: "/test/opts"}}
+	empty, err := m.Options(map[string]interface{}{"plaintext": false})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.Opts = map[string]string{"lang": "en"}
+	lang, err := m.Options(map[string]interface{}{"plaintext": false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty == lang {
+		t.Error("opts did not change options")
+	}
+}
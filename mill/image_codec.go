@@ -0,0 +1,83 @@
+package mill
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// HeifConvertBin is the executable used to decode HEIC and AVIF images
+var HeifConvertBin = "heif-convert"
+
+// CWebPBin is the executable used to encode WebP images
+var CWebPBin = "cwebp"
+
+// ErrCodecNotFound indicates an image codec executable is not available
+var ErrCodecNotFound = fmt.Errorf("image codec not found")
+
+// ImageCodec converts images to and from formats that can't be handled in pure Go
+type ImageCodec interface {
+	// Decode returns a HEIC or AVIF image as PNG or JPEG
+	Decode(input []byte, format Format) ([]byte, error)
+
+	// Encode returns a PNG image as WebP
+	Encode(input []byte, format Format, quality int) ([]byte, error)
+}
+
+// Codec is used by the image mills. The default uses libheif and libwebp
+// executables, but can be replaced, e.g., to use platform codecs on mobile.
+var Codec ImageCodec = &execCodec{}
+
+type execCodec struct{}
+
+func (c *execCodec) Decode(input []byte, format Format) ([]byte, error) {
+	if format != HEIC && format != AVIF {
+		return nil, ErrMediaTypeNotSupported
+	}
+	return runCodec(HeifConvertBin, input, "."+string(format), ".png", func(in, out string) []string {
+		return []string{in, out}
+	})
+}
+
+func (c *execCodec) Encode(input []byte, format Format, quality int) ([]byte, error) {
+	if format != WEBP {
+		return nil, ErrMediaTypeNotSupported
+	}
+	return runCodec(CWebPBin, input, ".png", ".webp", func(in, out string) []string {
+		return []string{"-quiet", "-q", strconv.Itoa(quality), in, "-o", out}
+	})
+}
+
+// runCodec writes input to a temp file, runs bin with args, and returns the output file
+func runCodec(bin string, input []byte, inExt string, outExt string, args func(in, out string) []string) ([]byte, error) {
+	pth, err := exec.LookPath(bin)
+	if err != nil {
+		return nil, ErrCodecNotFound
+	}
+
+	dir, err := ioutil.TempDir("", "textile-image-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	in := filepath.Join(dir, "input"+inExt)
+	out := filepath.Join(dir, "output"+outExt)
+	if err := ioutil.WriteFile(in, input, 0600); err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(pth, args(in, out)...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %s", bin, strings.TrimSpace(stderr.String()))
+	}
+
+	return ioutil.ReadFile(out)
+}
@@ -3,26 +3,55 @@ package mill
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"image"
+	"io"
+	"math"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/rwcarlsen/goexif/exif"
+	"github.com/rwcarlsen/goexif/tiff"
 )
 
 type ImageExifSchema struct {
-	Created   time.Time `json:"created,omitempty"`
-	Name      string    `json:"name"`
-	Ext       string    `json:"extension"`
-	Width     int       `json:"width"`
-	Height    int       `json:"height"`
-	Format    string    `json:"format"`
-	Latitude  float64   `json:"latitude,omitempty"`
-	Longitude float64   `json:"longitude,omitempty"`
+	Created      time.Time `json:"created,omitempty"`
+	Name         string    `json:"name"`
+	Ext          string    `json:"extension"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	Format       string    `json:"format"`
+	Latitude     float64   `json:"latitude,omitempty"`
+	Longitude    float64   `json:"longitude,omitempty"`
+	Altitude     float64   `json:"altitude,omitempty"`
+	Accuracy     float64   `json:"accuracy,omitempty"`
+	Make         string    `json:"make,omitempty"`
+	Model        string    `json:"model,omitempty"`
+	Lens         string    `json:"lens,omitempty"`
+	ExposureTime float64   `json:"exposure_time,omitempty"`
+	FNumber      float64   `json:"f_number,omitempty"`
+	FocalLength  float64   `json:"focal_length,omitempty"`
+	ISO          int       `json:"iso,omitempty"`
+	Orientation  int       `json:"orientation,omitempty"`
+	Keywords     []string  `json:"keywords,omitempty"`
+	Rating       int       `json:"rating,omitempty"`
 }
 
-type ImageExif struct{}
+// Location options control how much of an image's location is kept
+const (
+	LocationExact  = "exact"
+	LocationCoarse = "coarse"
+	LocationNone   = "none"
+)
+
+type ImageExifOpts struct {
+	Location string `json:"location,omitempty"`
+}
+
+type ImageExif struct {
+	Opts ImageExifOpts
+}
 
 func (m *ImageExif) ID() string {
 	return "/image/exif"
@@ -37,49 +66,64 @@ func (m *ImageExif) Pin() bool {
 }
 
 func (m *ImageExif) AcceptMedia(media string) error {
-	return accepts([]string{
-		"image/jpeg",
-		"image/png",
-		"image/gif",
-	}, media)
+	return accepts(imageMedia, media)
 }
 
 func (m *ImageExif) Options(add map[string]interface{}) (string, error) {
-	return hashOpts(make(map[string]string), add)
+	return hashOpts(m.Opts, add)
 }
 
 func (m *ImageExif) Mill(input []byte, name string) (*Result, error) {
-	conf, formatStr, err := image.DecodeConfig(bytes.NewReader(input))
-	if err != nil {
-		return nil, err
+	switch m.Opts.Location {
+	case "", LocationExact, LocationCoarse, LocationNone:
+	default:
+		return nil, fmt.Errorf("invalid location: %s", m.Opts.Location)
 	}
-	format := Format(formatStr)
 
-	var created time.Time
-	var lat, lon float64
-
-	exf, err := exif.Decode(bytes.NewReader(input))
-	if err == nil {
-		createdTmp, err := exf.DateTime()
-		if err == nil {
-			created = createdTmp
-		}
-
-		latTmp, lonTmp, err := exf.LatLong()
-		if err == nil {
-			lat, lon = latTmp, lonTmp
+	var conf image.Config
+	var format Format
+	exifData := input
+
+	// heif images are described without decoding
+	if format = HeifFormat(input); format != "" {
+		heif := parseHeif(input)
+		conf.Width, conf.Height = heif.width, heif.height
+		exifData = heif.exif
+	} else {
+		var formatStr string
+		var err error
+		conf, formatStr, err = image.DecodeConfig(bytes.NewReader(input))
+		if err != nil {
+			return nil, err
 		}
+		format = Format(formatStr)
 	}
 
 	res := &ImageExifSchema{
-		Created:   created,
-		Name:      name,
-		Ext:       strings.ToLower(filepath.Ext(name)),
-		Format:    string(format),
-		Width:     conf.Width,
-		Height:    conf.Height,
-		Latitude:  lat,
-		Longitude: lon,
+		Name:   name,
+		Ext:    strings.ToLower(filepath.Ext(name)),
+		Format: string(format),
+		Width:  conf.Width,
+		Height: conf.Height,
+	}
+
+	exf, err := exif.Decode(bytes.NewReader(exifData))
+	if err == nil {
+		readExif(exf, res)
+	}
+	if xmp := findXmp(input); xmp != nil {
+		res.Keywords, res.Rating = parseXmp(xmp)
+	}
+
+	switch m.Opts.Location {
+	case LocationCoarse:
+		// ~1km
+		res.Latitude = math.Round(res.Latitude*100) / 100
+		res.Longitude = math.Round(res.Longitude*100) / 100
+		res.Altitude = math.Round(res.Altitude/100) * 100
+		res.Accuracy = 0
+	case LocationNone:
+		res.Latitude, res.Longitude, res.Altitude, res.Accuracy = 0, 0, 0, 0
 	}
 
 	data, err := json.Marshal(res)
@@ -89,3 +133,99 @@ func (m *ImageExif) Mill(input []byte, name string) (*Result, error) {
 
 	return &Result{File: data}, nil
 }
+
+// readExif copies the supported exif fields to res
+func readExif(exf *exif.Exif, res *ImageExifSchema) {
+	if created, err := exf.DateTime(); err == nil {
+		res.Created = created
+	}
+	if lat, lon, err := exf.LatLong(); err == nil {
+		res.Latitude, res.Longitude = lat, lon
+	}
+
+	res.Make = exifString(exf, exif.Make)
+	res.Model = exifString(exf, exif.Model)
+	res.Lens = exifString(exf, exif.LensModel)
+	res.ExposureTime = exifFloat(exf, exif.ExposureTime)
+	res.FNumber = exifFloat(exf, exif.FNumber)
+	res.FocalLength = exifFloat(exf, exif.FocalLength)
+	res.ISO = exifInt(exf, exif.ISOSpeedRatings)
+	res.Orientation = exifInt(exf, exif.Orientation)
+
+	res.Altitude = exifFloat(exf, exif.GPSAltitude)
+	if exifInt(exf, exif.GPSAltitudeRef) == 1 {
+		res.Altitude = -res.Altitude
+	}
+	res.Accuracy = exifGPSAccuracy(exf)
+}
+
+func exifString(exf *exif.Exif, field exif.FieldName) string {
+	tag, err := exf.Get(field)
+	if err != nil {
+		return ""
+	}
+	val, err := tag.StringVal()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(val, "\x00"))
+}
+
+func exifInt(exf *exif.Exif, field exif.FieldName) int {
+	tag, err := exf.Get(field)
+	if err != nil {
+		return 0
+	}
+	val, err := tag.Int(0)
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+func exifFloat(exf *exif.Exif, field exif.FieldName) float64 {
+	tag, err := exf.Get(field)
+	if err != nil {
+		return 0
+	}
+	return tagFloat(tag)
+}
+
+func tagFloat(tag *tiff.Tag) float64 {
+	num, den, err := tag.Rat2(0)
+	if err != nil || den == 0 {
+		return 0
+	}
+	return float64(num) / float64(den)
+}
+
+// gpsHPositioningError is the gps tag for horizontal accuracy in meters,
+// which is not loaded by the exif package
+const gpsHPositioningError = 0x001f
+
+// exifGPSAccuracy reads the horizontal accuracy from the gps directory
+func exifGPSAccuracy(exf *exif.Exif) float64 {
+	ptr, err := exf.Get(exif.GPSInfoIFDPointer)
+	if err != nil {
+		return 0
+	}
+	offset, err := ptr.Int64(0)
+	if err != nil {
+		return 0
+	}
+
+	r := bytes.NewReader(exf.Raw)
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return 0
+	}
+	dir, _, err := tiff.DecodeDir(r, exf.Tiff.Order)
+	if err != nil {
+		return 0
+	}
+	for _, tag := range dir.Tags {
+		if tag.Id == gpsHPositioningError {
+			return tagFloat(tag)
+		}
+	}
+	return 0
+}
@@ -1,8 +1,11 @@
 package mill
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"os"
 	"testing"
 
@@ -45,3 +48,253 @@ func TestImageExif_Mill(t *testing.T) {
 		}
 	}
 }
+
+func TestImageExif_MillHeif(t *testing.T) {
+	m := &ImageExif{}
+
+	jpg, err := ioutil.ReadFile("testdata/image-no-orientation.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tiff := testExifTiff(jpg)
+	if tiff == nil {
+		t.Fatal("test image has no exif")
+	}
+
+	for _, brand := range []string{"heic", "avif"} {
+		res, err := m.Mill(testHeif(brand, 4032, 3024, tiff), "test.heic")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var exif *ImageExifSchema
+		if err := json.Unmarshal(res.File, &exif); err != nil {
+			t.Fatal(err)
+		}
+
+		if exif.Width != 4032 || exif.Height != 3024 {
+			t.Errorf("wrong size for %s", brand)
+		}
+		if exif.Format != brand {
+			t.Errorf("wrong format for %s", brand)
+		}
+		if exif.Created.IsZero() {
+			t.Errorf("missing created for %s", brand)
+		}
+	}
+}
+
+func TestImageExif_MillCamera(t *testing.T) {
+	m := &ImageExif{}
+
+	input, err := ioutil.ReadFile("testdata/image-no-orientation.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := m.Mill(input, "test.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var exif *ImageExifSchema
+	if err := json.Unmarshal(res.File, &exif); err != nil {
+		t.Fatal(err)
+	}
+
+	if exif.Make != "FUJIFILM" || exif.Model != "FinePix S6500fd" {
+		t.Errorf("wrong camera: %s %s", exif.Make, exif.Model)
+	}
+	if exif.ExposureTime != 0.002 {
+		t.Errorf("wrong exposure time: %f", exif.ExposureTime)
+	}
+	if exif.FNumber != 8 {
+		t.Errorf("wrong f-number: %f", exif.FNumber)
+	}
+	if exif.FocalLength != 14 {
+		t.Errorf("wrong focal length: %f", exif.FocalLength)
+	}
+	if exif.ISO != 200 {
+		t.Errorf("wrong iso: %d", exif.ISO)
+	}
+}
+
+func TestImageExif_MillLocation(t *testing.T) {
+	input := testHeif("heic", 100, 100, testExifGps())
+	input = append(input, testBox("free", []byte(testXmp))...)
+
+	tests := []struct {
+		location string
+		lat      float64
+		lon      float64
+		alt      float64
+		acc      float64
+	}{
+		{"", 37.7745, -122.4195, 15.5, 5},
+		{LocationCoarse, 37.77, -122.42, 0, 0},
+		{LocationNone, 0, 0, 0, 0},
+	}
+	for _, test := range tests {
+		m := &ImageExif{Opts: ImageExifOpts{Location: test.location}}
+		res, err := m.Mill(input, "test.heic")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var exif *ImageExifSchema
+		if err := json.Unmarshal(res.File, &exif); err != nil {
+			t.Fatal(err)
+		}
+
+		if math.Abs(exif.Latitude-test.lat) > 1e-9 || math.Abs(exif.Longitude-test.lon) > 1e-9 {
+			t.Errorf("wrong location for %s: %f, %f", test.location, exif.Latitude, exif.Longitude)
+		}
+		if exif.Altitude != test.alt {
+			t.Errorf("wrong altitude for %s: %f", test.location, exif.Altitude)
+		}
+		if exif.Accuracy != test.acc {
+			t.Errorf("wrong accuracy for %s: %f", test.location, exif.Accuracy)
+		}
+		if len(exif.Keywords) != 2 || exif.Keywords[0] != "beach" || exif.Keywords[1] != "sunset" {
+			t.Errorf("wrong keywords: %v", exif.Keywords)
+		}
+		if exif.Rating != 4 {
+			t.Errorf("wrong rating: %d", exif.Rating)
+		}
+	}
+
+	m := &ImageExif{Opts: ImageExifOpts{Location: "street"}}
+	if _, err := m.Mill(input, "test.heic"); err == nil {
+		t.Error("invalid location was accepted")
+	}
+}
+
+func TestImageExif_Options(t *testing.T) {
+	exact, err := (&ImageExif{}).Options(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the default must match files added before options existed
+	legacy, err := hashOpts(make(map[string]string), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exact != legacy {
+		t.Error("default options changed")
+	}
+
+	coarse, err := (&ImageExif{Opts: ImageExifOpts{Location: LocationCoarse}}).Options(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coarse == exact {
+		t.Error("location did not change options")
+	}
+}
+
+const testXmp = `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description xmlns:xmp="http://ns.adobe.com/xap/1.0/" xmlns:dc="http://purl.org/dc/elements/1.1/" xmp:Rating="4">
+   <dc:subject>
+    <rdf:Bag>
+     <rdf:li>beach</rdf:li>
+     <rdf:li>sunset</rdf:li>
+    </rdf:Bag>
+   </dc:subject>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>`
+
+// testExifGps builds little-endian tiff exif data with a gps directory
+func testExifGps() []byte {
+	le := binary.LittleEndian
+	data := make([]byte, 168)
+	copy(data, "II*\x00")
+	le.PutUint32(data[4:], 8)
+
+	entry := func(pos int, tag uint16, typ uint16, count uint32, val uint32) {
+		le.PutUint16(data[pos:], tag)
+		le.PutUint16(data[pos+2:], typ)
+		le.PutUint32(data[pos+4:], count)
+		le.PutUint32(data[pos+8:], val)
+	}
+	rational := func(pos int, num uint32, den uint32) {
+		le.PutUint32(data[pos:], num)
+		le.PutUint32(data[pos+4:], den)
+	}
+
+	// ifd0 with a gps pointer
+	le.PutUint16(data[8:], 1)
+	entry(10, 0x8825, 4, 1, 26)
+
+	// gps ifd
+	le.PutUint16(data[26:], 6)
+	entry(28, 0x0001, 2, 2, 'N')
+	entry(40, 0x0002, 5, 3, 104)
+	entry(52, 0x0003, 2, 2, 'W')
+	entry(64, 0x0004, 5, 3, 128)
+	entry(76, 0x0006, 5, 1, 152)
+	entry(88, 0x001f, 5, 1, 160)
+
+	rational(104, 37, 1)
+	rational(112, 46, 1)
+	rational(120, 2820, 100) // 37.7745
+	rational(128, 122, 1)
+	rational(136, 25, 1)
+	rational(144, 1020, 100) // 122.4195
+	rational(152, 31, 2)
+	rational(160, 5, 1)
+	return data
+}
+
+// testExifTiff returns the tiff data of the exif segment in a jpeg
+func testExifTiff(jpg []byte) []byte {
+	i := bytes.Index(jpg, []byte("Exif\x00\x00"))
+	if i < 2 {
+		return nil
+	}
+	size := int(binary.BigEndian.Uint16(jpg[i-2:]))
+	return jpg[i+6 : i-2+size]
+}
+
+// testHeif builds a minimal heif file with a primary image item and an exif item
+func testHeif(brand string, width int, height int, tiff []byte) []byte {
+	ftyp := testBox("ftyp", []byte(brand+"\x00\x00\x00\x00mif1"+brand))
+
+	pitm := testBox("pitm", []byte{0, 0, 0, 0, 0, 1})
+
+	infe := func(id byte, typ string) []byte {
+		return testBox("infe", append([]byte{2, 0, 0, 0, 0, id, 0, 0}, typ+"\x00"...))
+	}
+	iinf := testBox("iinf", append(append([]byte{0, 0, 0, 0, 0, 2}, infe(1, "hvc1")...), infe(2, "Exif")...))
+
+	ispe := make([]byte, 12)
+	binary.BigEndian.PutUint32(ispe[4:], uint32(width))
+	binary.BigEndian.PutUint32(ispe[8:], uint32(height))
+	ipco := testBox("ipco", testBox("ispe", ispe))
+	ipma := testBox("ipma", []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 1, 0x81})
+	iprp := testBox("iprp", append(ipco, ipma...))
+
+	item := append([]byte{0, 0, 0, 0}, tiff...)
+	meta := func(offset int) []byte {
+		iloc := []byte{0, 0, 0, 0, 0x44, 0, 0, 1, 0, 2, 0, 0, 0, 1}
+		extent := make([]byte, 8)
+		binary.BigEndian.PutUint32(extent, uint32(offset))
+		binary.BigEndian.PutUint32(extent[4:], uint32(len(item)))
+		iloc = append(iloc, extent...)
+
+		var data bytes.Buffer
+		data.Write([]byte{0, 0, 0, 0})
+		data.Write(pitm)
+		data.Write(iinf)
+		data.Write(testBox("iloc", iloc))
+		data.Write(iprp)
+		return testBox("meta", data.Bytes())
+	}
+	offset := len(ftyp) + len(meta(0)) + 8
+
+	var file bytes.Buffer
+	file.Write(ftyp)
+	file.Write(meta(offset))
+	file.Write(testBox("mdat", item))
+	return file.Bytes()
+}
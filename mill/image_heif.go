@@ -0,0 +1,315 @@
+package mill
+
+import (
+	"encoding/binary"
+)
+
+// HeifFormat returns HEIC or AVIF if input is a HEIF image, otherwise an empty format.
+// Only the leading ftyp box is read.
+func HeifFormat(input []byte) Format {
+	boxes := mp4Boxes(input)
+	if len(boxes) == 0 || boxes[0].typ != "ftyp" || len(boxes[0].data) < 8 {
+		return ""
+	}
+	ftyp := boxes[0].data
+
+	brands := []string{string(ftyp[0:4])}
+	for i := 8; i+4 <= len(ftyp); i += 4 {
+		brands = append(brands, string(ftyp[i:i+4]))
+	}
+
+	var heif bool
+	for _, b := range brands {
+		switch b {
+		case "avif", "avis":
+			return AVIF
+		case "heic", "heix", "heim", "heis", "hevc", "hevx", "mif1", "msf1":
+			heif = true
+		}
+	}
+	if heif {
+		return HEIC
+	}
+	return ""
+}
+
+// heifImage describes the primary image of a HEIF file
+type heifImage struct {
+	width  int
+	height int
+	exif   []byte
+}
+
+// parseHeif reads the size and exif data of the primary image from the meta box
+func parseHeif(input []byte) *heifImage {
+	img := &heifImage{}
+
+	var meta []byte
+	for _, b := range mp4Boxes(input) {
+		if b.typ == "meta" {
+			meta = b.data
+			break
+		}
+	}
+	if len(meta) < 4 {
+		return img
+	}
+
+	var primary uint32
+	var exifItem uint32
+	var locs map[uint32][]byte
+	var props [][]byte
+	var assoc map[uint32][]int
+
+	// meta is a full box
+	for _, b := range mp4Boxes(meta[4:]) {
+		switch b.typ {
+		case "pitm":
+			primary = parsePitm(b.data)
+		case "iinf":
+			exifItem = parseIinf(b.data, "Exif")
+		case "iloc":
+			locs = parseIloc(b.data, input)
+		case "iprp":
+			props, assoc = parseIprp(b.data)
+		}
+	}
+
+	for _, i := range assoc[primary] {
+		if i < 1 || i > len(props) {
+			continue
+		}
+		prop := props[i-1]
+		if string(prop[4:8]) == "ispe" && len(prop) >= 20 {
+			img.width = int(binary.BigEndian.Uint32(prop[12:16]))
+			img.height = int(binary.BigEndian.Uint32(prop[16:20]))
+		}
+	}
+
+	// the exif item starts with the offset to the tiff header
+	if data := locs[exifItem]; exifItem != 0 && len(data) > 4 {
+		offset := int(binary.BigEndian.Uint32(data[0:4])) + 4
+		if offset < len(data) {
+			img.exif = data[offset:]
+		}
+	}
+
+	return img
+}
+
+// parsePitm returns the primary item id
+func parsePitm(data []byte) uint32 {
+	if len(data) < 6 {
+		return 0
+	}
+	if data[0] == 0 {
+		return uint32(binary.BigEndian.Uint16(data[4:6]))
+	}
+	if len(data) < 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(data[4:8])
+}
+
+// parseIinf returns the id of the first item of type typ
+func parseIinf(data []byte, typ string) uint32 {
+	if len(data) < 6 {
+		return 0
+	}
+	head := 6
+	if data[0] != 0 {
+		head = 8
+	}
+	if len(data) < head {
+		return 0
+	}
+
+	for _, b := range mp4Boxes(data[head:]) {
+		if b.typ != "infe" || len(b.data) < 4 {
+			continue
+		}
+		var id uint32
+		var rest []byte
+		switch b.data[0] {
+		case 2:
+			if len(b.data) < 12 {
+				continue
+			}
+			id = uint32(binary.BigEndian.Uint16(b.data[4:6]))
+			rest = b.data[8:]
+		case 3:
+			if len(b.data) < 14 {
+				continue
+			}
+			id = binary.BigEndian.Uint32(b.data[4:8])
+			rest = b.data[10:]
+		default:
+			continue
+		}
+		if string(rest[0:4]) == typ {
+			return id
+		}
+	}
+	return 0
+}
+
+// parseIloc returns item data by id, for items stored in the file
+func parseIloc(data []byte, file []byte) map[uint32][]byte {
+	locs := make(map[uint32][]byte)
+	if len(data) < 8 {
+		return locs
+	}
+	version := data[0]
+	offsetSize := int(data[4] >> 4)
+	lengthSize := int(data[4] & 0x0f)
+	baseSize := int(data[5] >> 4)
+	var indexSize int
+	if version == 1 || version == 2 {
+		indexSize = int(data[5] & 0x0f)
+	}
+
+	pos := 6
+	read := func(size int) (uint64, bool) {
+		if size == 0 {
+			return 0, true
+		}
+		if pos+size > len(data) {
+			return 0, false
+		}
+		var v uint64
+		for _, c := range data[pos : pos+size] {
+			v = v<<8 | uint64(c)
+		}
+		pos += size
+		return v, true
+	}
+
+	countSize := 2
+	idSize := 2
+	if version == 2 {
+		countSize = 4
+		idSize = 4
+	}
+	count, ok := read(countSize)
+	if !ok {
+		return locs
+	}
+
+	for i := uint64(0); i < count; i++ {
+		id, ok := read(idSize)
+		if !ok {
+			return locs
+		}
+		var method uint64
+		if version == 1 || version == 2 {
+			if method, ok = read(2); !ok {
+				return locs
+			}
+			method &= 0x0f
+		}
+		if _, ok = read(2); !ok { // data reference index
+			return locs
+		}
+		base, ok := read(baseSize)
+		if !ok {
+			return locs
+		}
+		extents, ok := read(2)
+		if !ok {
+			return locs
+		}
+
+		var item []byte
+		for j := uint64(0); j < extents; j++ {
+			if _, ok = read(indexSize); !ok {
+				return locs
+			}
+			offset, ok := read(offsetSize)
+			if !ok {
+				return locs
+			}
+			length, ok := read(lengthSize)
+			if !ok {
+				return locs
+			}
+			start := base + offset
+			end := start + length
+			if method != 0 || end > uint64(len(file)) || start > end {
+				item = nil
+				continue
+			}
+			item = append(item, file[start:end]...)
+		}
+		if item != nil {
+			locs[uint32(id)] = item
+		}
+	}
+	return locs
+}
+
+// parseIprp returns the item properties (as full boxes) and the
+// 1-based property indexes associated with each item
+func parseIprp(data []byte) ([][]byte, map[uint32][]int) {
+	var props [][]byte
+	assoc := make(map[uint32][]int)
+
+	for _, b := range mp4Boxes(data) {
+		switch b.typ {
+		case "ipco":
+			rest := b.data
+			for len(rest) >= 8 {
+				size := int(binary.BigEndian.Uint32(rest[0:4]))
+				if size < 8 || size > len(rest) {
+					break
+				}
+				props = append(props, rest[:size])
+				rest = rest[size:]
+			}
+		case "ipma":
+			if len(b.data) < 8 {
+				continue
+			}
+			version := b.data[0]
+			large := b.data[3]&1 == 1
+			count := binary.BigEndian.Uint32(b.data[4:8])
+			pos := 8
+			for i := uint32(0); i < count; i++ {
+				var id uint32
+				if version < 1 {
+					if pos+2 > len(b.data) {
+						break
+					}
+					id = uint32(binary.BigEndian.Uint16(b.data[pos:]))
+					pos += 2
+				} else {
+					if pos+4 > len(b.data) {
+						break
+					}
+					id = binary.BigEndian.Uint32(b.data[pos:])
+					pos += 4
+				}
+				if pos >= len(b.data) {
+					break
+				}
+				n := int(b.data[pos])
+				pos++
+				for j := 0; j < n; j++ {
+					if large {
+						if pos+2 > len(b.data) {
+							break
+						}
+						assoc[id] = append(assoc[id], int(binary.BigEndian.Uint16(b.data[pos:])&0x7fff))
+						pos += 2
+					} else {
+						if pos >= len(b.data) {
+							break
+						}
+						assoc[id] = append(assoc[id], int(b.data[pos]&0x7f))
+						pos++
+					}
+				}
+			}
+		}
+	}
+	return props, assoc
+}
@@ -0,0 +1,179 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+
+	"github.com/disintegration/imaging"
+	"github.com/rwcarlsen/goexif/exif"
+)
+
+// phashSize is the side length of the image sampled by the dct
+const phashSize = 32
+
+// phashBits is the side length of the low frequency block used for the hash
+const phashBits = 8
+
+type ImagePhashSchema struct {
+	Hash   string `json:"hash"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type ImagePhash struct{}
+
+func (m *ImagePhash) ID() string {
+	return "/image/phash"
+}
+
+func (m *ImagePhash) Encrypt() bool {
+	return true
+}
+
+func (m *ImagePhash) Pin() bool {
+	return false
+}
+
+func (m *ImagePhash) AcceptMedia(media string) error {
+	return accepts(imageMedia, media)
+}
+
+func (m *ImagePhash) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *ImagePhash) OutputMedia(media string) string {
+	return "application/json"
+}
+
+func (m *ImagePhash) Mill(input []byte, name string) (*Result, error) {
+	input, err := decodableImage(input)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+
+	// hash images as they are displayed
+	exf, _ := exif.Decode(bytes.NewReader(input))
+	img, err = correctOrientation(img, exf)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ImagePhashSchema{
+		Hash:   Phash(img),
+		Width:  img.Bounds().Dx(),
+		Height: img.Bounds().Dy(),
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		File: data,
+		Meta: map[string]interface{}{
+			"phash": res.Hash,
+		},
+	}, nil
+}
+
+// Phash returns the 64 bit dct perceptual hash of an image as hex
+func Phash(img image.Image) string {
+	gray := imaging.Grayscale(imaging.Resize(img, phashSize, phashSize, imaging.Lanczos))
+
+	pixels := make([][]float64, phashSize)
+	for y := range pixels {
+		pixels[y] = make([]float64, phashSize)
+		for x := range pixels[y] {
+			pixels[y][x] = float64(gray.Pix[y*gray.Stride+x*4])
+		}
+	}
+	coeffs := dct2(pixels)
+
+	// the dc term is skipped, it only reflects average brightness
+	var low []float64
+	for y := 0; y < phashBits; y++ {
+		for x := 0; x < phashBits; x++ {
+			if x == 0 && y == 0 {
+				continue
+			}
+			low = append(low, coeffs[y][x])
+		}
+	}
+	sorted := append([]float64{}, low...)
+	sort.Float64s(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+
+	var hash uint64
+	for i, c := range low {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return fmt.Sprintf("%016x", hash)
+}
+
+// PhashDistance returns the number of bits that differ between two perceptual hashes
+func PhashDistance(a string, b string) (int, error) {
+	x, err := strconv.ParseUint(a, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid phash: %s", a)
+	}
+	y, err := strconv.ParseUint(b, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid phash: %s", b)
+	}
+	return bits.OnesCount64(x ^ y), nil
+}
+
+// dct2 returns the 2D type II discrete cosine transform of a square matrix
+func dct2(input [][]float64) [][]float64 {
+	n := len(input)
+	cos := make([][]float64, n)
+	for k := range cos {
+		cos[k] = make([]float64, n)
+		for i := range cos[k] {
+			cos[k][i] = math.Cos(math.Pi / float64(n) * (float64(i) + 0.5) * float64(k))
+		}
+	}
+	transform := func(row []float64) []float64 {
+		out := make([]float64, n)
+		for k := range out {
+			for i, v := range row {
+				out[k] += v * cos[k][i]
+			}
+		}
+		return out
+	}
+
+	rows := make([][]float64, n)
+	for y, row := range input {
+		rows[y] = transform(row)
+	}
+	output := make([][]float64, n)
+	for y := range output {
+		output[y] = make([]float64, n)
+	}
+	col := make([]float64, n)
+	for x := 0; x < n; x++ {
+		for y := 0; y < n; y++ {
+			col[y] = rows[y][x]
+		}
+		for y, v := range transform(col) {
+			output[y][x] = v
+		}
+	}
+	return output
+}
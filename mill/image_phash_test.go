@@ -0,0 +1,109 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	"github.com/textileio/go-textile/mill/testdata"
+)
+
+func TestImagePhash_Mill(t *testing.T) {
+	m := &ImagePhash{}
+
+	hashes := make(map[string]string)
+	for _, i := range testdata.Images {
+		input, err := ioutil.ReadFile(i.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := m.Mill(input, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var phash *ImagePhashSchema
+		if err := json.Unmarshal(res.File, &phash); err != nil {
+			t.Fatal(err)
+		}
+		if len(phash.Hash) != 16 {
+			t.Errorf("wrong hash length: %s", phash.Hash)
+		}
+		if res.Meta["phash"] != phash.Hash {
+			t.Errorf("meta is missing phash")
+		}
+		if phash.Width != i.Width || phash.Height != i.Height {
+			t.Errorf("wrong size")
+		}
+		hashes[i.Path] = phash.Hash
+	}
+
+	for a, ha := range hashes {
+		for b, hb := range hashes {
+			if a == b {
+				continue
+			}
+			dist, err := PhashDistance(ha, hb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dist <= 10 {
+				t.Errorf("%s and %s are too similar: %d", a, b, dist)
+			}
+		}
+	}
+}
+
+func TestImagePhash_MillResized(t *testing.T) {
+	m := &ImagePhash{}
+
+	input, err := ioutil.ReadFile(testdata.Images[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, _, err := image.Decode(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var small bytes.Buffer
+	err = jpeg.Encode(&small, imaging.Resize(img, 200, 0, imaging.Lanczos), &jpeg.Options{Quality: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := m.Mill(input, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sres, err := m.Mill(small.Bytes(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dist, err := PhashDistance(res.Meta["phash"].(string), sres.Meta["phash"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dist > 4 {
+		t.Errorf("resized image is not similar: %d", dist)
+	}
+}
+
+func TestPhashDistance(t *testing.T) {
+	dist, err := PhashDistance("00000000000000ff", "000000000000000f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dist != 4 {
+		t.Errorf("wrong distance: %d", dist)
+	}
+
+	if _, err := PhashDistance("nope", "000000000000000f"); err == nil {
+		t.Error("invalid hash was accepted")
+	}
+}
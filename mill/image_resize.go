@@ -14,6 +14,7 @@ import (
 
 	"github.com/disintegration/imaging"
 	"github.com/rwcarlsen/goexif/exif"
+	_ "golang.org/x/image/webp"
 )
 
 // Format enumerates the type of images currently supported
@@ -23,8 +24,22 @@ const (
 	JPEG Format = "jpeg"
 	PNG  Format = "png"
 	GIF  Format = "gif"
+	WEBP Format = "webp"
+	HEIC Format = "heic"
+	AVIF Format = "avif"
 )
 
+// imageMedia lists the image media types accepted by the image mills
+var imageMedia = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"image/heic",
+	"image/heif",
+	"image/avif",
+}
+
 type ImageSize struct {
 	Width  int
 	Height int
@@ -33,6 +48,7 @@ type ImageSize struct {
 type ImageResizeOpts struct {
 	Width   string `json:"width"`
 	Quality string `json:"quality"`
+	Format  string `json:"format,omitempty"`
 }
 
 type ImageResize struct {
@@ -52,23 +68,49 @@ func (m *ImageResize) Pin() bool {
 }
 
 func (m *ImageResize) AcceptMedia(media string) error {
-	return accepts([]string{
-		"image/jpeg",
-		"image/png",
-		"image/gif",
-	}, media)
+	return accepts(imageMedia, media)
 }
 
 func (m *ImageResize) Options(add map[string]interface{}) (string, error) {
 	return hashOpts(m.Opts, add)
 }
 
+// OutputMedia returns the media type of images resized from media
+func (m *ImageResize) OutputMedia(media string) string {
+	var format Format
+	switch media {
+	case "image/png":
+		format = PNG
+	case "image/gif":
+		format = GIF
+	}
+	return "image/" + string(m.outputFormat(format))
+}
+
 func (m *ImageResize) Mill(input []byte, name string) (*Result, error) {
+	out := m.outputFormat("")
+	switch out {
+	case JPEG, PNG, WEBP:
+	default:
+		return nil, fmt.Errorf("invalid format: %s", m.Opts.Format)
+	}
+
+	// heif images are decoded as png, but are output like other photos
+	source := HeifFormat(input)
+	input, err := decodableImage(input)
+	if err != nil {
+		return nil, err
+	}
+
 	img, formatStr, err := image.Decode(bytes.NewReader(input))
 	if err != nil {
 		return nil, err
 	}
 	format := Format(formatStr)
+	if source == "" {
+		source = format
+	}
+	out = m.outputFormat(source)
 
 	clean, err := removeExif(bytes.NewReader(input), img, format)
 	if err != nil {
@@ -84,7 +126,7 @@ func (m *ImageResize) Mill(input []byte, name string) (*Result, error) {
 		return nil, fmt.Errorf("invalid quality: " + m.Opts.Quality)
 	}
 
-	buff, rect, err := encodeImage(clean, format, width, quality)
+	buff, rect, err := encodeImage(clean, out, width, quality)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +140,29 @@ func (m *ImageResize) Mill(input []byte, name string) (*Result, error) {
 	}, nil
 }
 
+// outputFormat returns the format option, or otherwise the format used for source images,
+// where png and gif are kept, and all others become jpeg
+func (m *ImageResize) outputFormat(source Format) Format {
+	if m.Opts.Format != "" {
+		return Format(m.Opts.Format)
+	}
+	switch source {
+	case PNG, GIF:
+		return source
+	default:
+		return JPEG
+	}
+}
+
+// decodableImage converts HEIC and AVIF images to a format that can be decoded in Go
+func decodableImage(input []byte) ([]byte, error) {
+	format := HeifFormat(input)
+	if format == "" {
+		return input, nil
+	}
+	return Codec.Decode(input, format)
+}
+
 // removeExif strips exif data from an image
 func removeExif(reader io.Reader, img image.Image, format Format) (io.Reader, error) {
 	if format == GIF {
@@ -115,14 +180,14 @@ func removeExif(reader io.Reader, img image.Image, format Format) (io.Reader, er
 	return encodeSingleImage(img, format)
 }
 
-// encodeImage creates a jpeg|gif from reader (quality applies to jpeg only)
-// NOTE: format is the reader image format, destination format is chosen accordingly.
+// encodeImage creates a jpeg|png|webp|gif from reader (quality applies to jpeg and webp only)
+// NOTE: format is the destination format. Only a gif reader can be encoded as gif.
 func encodeImage(reader io.Reader, format Format, width int, quality int) (*bytes.Buffer, *image.Rectangle, error) {
 	buff := new(bytes.Buffer)
 	var size image.Rectangle
 
 	if format != GIF {
-		// encode to png or jpeg
+		// encode to png, jpeg, or webp
 		img, _, err := image.Decode(reader)
 		if err != nil {
 			return nil, nil, err
@@ -134,11 +199,21 @@ func encodeImage(reader io.Reader, format Format, width int, quality int) (*byte
 
 		resized := imaging.Resize(img, width, 0, imaging.Lanczos)
 
-		if format == PNG {
+		switch format {
+		case PNG:
+			if err = png.Encode(buff, resized); err != nil {
+				return nil, nil, err
+			}
+		case WEBP:
 			if err = png.Encode(buff, resized); err != nil {
 				return nil, nil, err
 			}
-		} else {
+			data, err := Codec.Encode(buff.Bytes(), WEBP, quality)
+			if err != nil {
+				return nil, nil, err
+			}
+			buff = bytes.NewBuffer(data)
+		default:
 			if err = jpeg.Encode(buff, resized, &jpeg.Options{Quality: quality}); err != nil {
 				return nil, nil, err
 			}
@@ -207,9 +282,10 @@ func encodeSingleImage(img image.Image, format Format) (*bytes.Reader, error) {
 	switch format {
 	case JPEG:
 		err = jpeg.Encode(writer, img, &jpeg.Options{Quality: 100})
-	case PNG:
+	case PNG, WEBP:
 		// NOTE: while PNGs don't technically have exif data,
-		// they can contain meta data with sensitive info
+		// they can contain meta data with sensitive info.
+		// WebP is re-encoded losslessly as png.
 		err = png.Encode(writer, img)
 	default:
 		err = fmt.Errorf("unrecognized image format")
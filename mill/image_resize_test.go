@@ -3,6 +3,7 @@ package mill
 import (
 	"bytes"
 	"fmt"
+	"image"
 	"io"
 	"io/ioutil"
 	"os"
@@ -50,3 +51,126 @@ func TestImageResize_Mill(t *testing.T) {
 		}
 	}
 }
+
+// testWebp is a 1x1 lossless webp image
+var testWebp = []byte("RIFF\x1a\x00\x00\x00WEBPVP8L\x0d\x00\x00\x00\x2f\x00\x00\x00\x10\x07\x10\x11\x11\x88\x88\xfe\x07\x00")
+
+// testCodec decodes any image to png, and encodes by tagging png input
+type testCodec struct{}
+
+func (c *testCodec) Decode(input []byte, format Format) ([]byte, error) {
+	file, err := os.Open("testdata/image.png")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ioutil.ReadAll(file)
+}
+
+func (c *testCodec) Encode(input []byte, format Format, quality int) ([]byte, error) {
+	return append([]byte(format), input...), nil
+}
+
+func TestImageResize_MillWebp(t *testing.T) {
+	m := &ImageResize{
+		Opts: ImageResizeOpts{
+			Width:   "200",
+			Quality: "80",
+		},
+	}
+
+	res, err := m.Mill(testWebp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Meta["width"] != 1 {
+		t.Errorf("wrong width")
+	}
+	if _, format, _ := image.DecodeConfig(bytes.NewReader(res.File)); format != "jpeg" {
+		t.Errorf("wrong format: %s", format)
+	}
+}
+
+func TestImageResize_MillFormat(t *testing.T) {
+	codec := Codec
+	Codec = &testCodec{}
+	defer func() {
+		Codec = codec
+	}()
+
+	m := &ImageResize{
+		Opts: ImageResizeOpts{
+			Width:   "200",
+			Quality: "80",
+			Format:  "webp",
+		},
+	}
+	if media := m.OutputMedia("image/png"); media != "image/webp" {
+		t.Errorf("wrong output media: %s", media)
+	}
+
+	// heic input is decoded by the codec
+	input := testHeif("heic", 300, 300, nil)
+	res, err := m.Mill(input, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(res.File, []byte("webp")) {
+		t.Errorf("output was not encoded as webp")
+	}
+	if res.Meta["width"] != 200 {
+		t.Errorf("wrong width")
+	}
+
+	m.Opts.Format = "tiff"
+	if _, err := m.Mill(input, "test"); err == nil {
+		t.Errorf("invalid format was accepted")
+	}
+}
+
+func TestImageResize_MillHeif(t *testing.T) {
+	codec := Codec
+	Codec = &testCodec{}
+	defer func() {
+		Codec = codec
+	}()
+
+	m := &ImageResize{
+		Opts: ImageResizeOpts{
+			Width:   "200",
+			Quality: "80",
+		},
+	}
+
+	for _, typ := range []string{"heic", "avif"} {
+		res, err := m.Mill(testHeif(typ, 300, 300, nil), "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, format, err := image.DecodeConfig(bytes.NewReader(res.File))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if media := m.OutputMedia("image/" + typ); media != "image/"+format {
+			t.Errorf("output media %s does not match %s output", media, format)
+		}
+	}
+}
+
+func TestImageResize_OutputMedia(t *testing.T) {
+	m := &ImageResize{}
+
+	tests := map[string]string{
+		"image/jpeg": "image/jpeg",
+		"image/png":  "image/png",
+		"image/gif":  "image/gif",
+		"image/webp": "image/jpeg",
+		"image/heic": "image/jpeg",
+		"image/avif": "image/jpeg",
+	}
+	for in, out := range tests {
+		if media := m.OutputMedia(in); media != out {
+			t.Errorf("wrong output media for %s: %s", in, media)
+		}
+	}
+}
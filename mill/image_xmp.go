@@ -0,0 +1,90 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const xmpNS = "http://ns.adobe.com/xap/1.0/"
+const dcNS = "http://purl.org/dc/elements/1.1/"
+
+// findXmp returns the first xmp packet in input. Packets are stored
+// uncompressed in jpeg, png, webp and heif files, so the container
+// doesn't need to be parsed.
+func findXmp(input []byte) []byte {
+	start := bytes.Index(input, []byte("<x:xmpmeta"))
+	if start < 0 {
+		return nil
+	}
+	end := bytes.Index(input[start:], []byte("</x:xmpmeta>"))
+	if end < 0 {
+		return nil
+	}
+	return input[start : start+end+len("</x:xmpmeta>")]
+}
+
+// parseXmp returns the keywords (dc:subject) and rating (xmp:Rating) in an xmp packet
+func parseXmp(data []byte) ([]string, int) {
+	var keywords []string
+	var rating int
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var subject, li, rated bool
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return keywords, rating
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Space == dcNS && t.Name.Local == "subject":
+				subject = true
+			case subject && t.Name.Local == "li":
+				li = true
+			case t.Name.Space == xmpNS && t.Name.Local == "Rating":
+				rated = true
+			}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == xmpNS && attr.Name.Local == "Rating" {
+					rating = xmpRating(attr.Value)
+				}
+			}
+		case xml.EndElement:
+			switch {
+			case t.Name.Space == dcNS && t.Name.Local == "subject":
+				subject = false
+			case t.Name.Local == "li":
+				li = false
+			case t.Name.Space == xmpNS && t.Name.Local == "Rating":
+				rated = false
+			}
+		case xml.CharData:
+			val := strings.TrimSpace(string(t))
+			switch {
+			case val == "":
+			case subject && li:
+				keywords = append(keywords, val)
+			case rated:
+				rating = xmpRating(val)
+			}
+		}
+	}
+	return keywords, rating
+}
+
+// xmpRating parses a rating, where -1 (rejected) and unknown values are ignored
+func xmpRating(val string) int {
+	rating, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil || rating < 0 || rating > 5 {
+		return 0
+	}
+	return int(rating)
+}
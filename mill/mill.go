@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	logging "github.com/ipfs/go-log"
 	"github.com/mr-tron/base58/base58"
@@ -27,6 +28,31 @@ type Mill interface {
 	Mill(input []byte, name string) (*Result, error)
 }
 
+type StreamResult struct {
+	File io.Reader
+	Meta map[string]interface{}
+}
+
+// MediaMill is a Mill whose output media type differs from the input media type
+type MediaMill interface {
+	Mill
+	OutputMedia(media string) string
+}
+
+// StreamMill is a Mill that can also process input as a stream,
+// without holding the whole file in memory
+type StreamMill interface {
+	Mill
+	MillStream(input io.Reader, name string) (*StreamResult, error)
+}
+
+// ChunkedMill is a StreamMill whose encrypted output may be split into
+// content-defined chunks, so that similar files share storage
+type ChunkedMill interface {
+	StreamMill
+	Chunked() bool
+}
+
 func accepts(list []string, media string) error {
 	for _, m := range list {
 		if media == m {
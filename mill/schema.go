@@ -14,7 +14,9 @@ var pbMarshaler = jsonpb.Marshaler{
 	OrigName: true,
 }
 
-type Schema struct{}
+type Schema struct {
+	External []string // ids of external mills that links can use
+}
 
 func (m *Schema) ID() string {
 	return "/schema"
@@ -48,7 +50,7 @@ func (m *Schema) Mill(input []byte, name string) (*Result, error) {
 		}
 
 		for _, link := range node.Links {
-			if !schema.ValidateMill(link.Mill) {
+			if !schema.ValidateMill(link.Mill, m.External) {
 				return nil, schema.ErrSchemaInvalidMill
 			}
 
@@ -67,9 +69,12 @@ func (m *Schema) Mill(input []byte, name string) (*Result, error) {
 		if _, err := schema.Steps(node.Links); err != nil {
 			return nil, err
 		}
+		if err := schema.ValidateOptional(node.Links); err != nil {
+			return nil, err
+		}
 
 	} else {
-		if !schema.ValidateMill(node.Mill) {
+		if !schema.ValidateMill(node.Mill, m.External) {
 			return nil, schema.ErrSchemaInvalidMill
 		}
 
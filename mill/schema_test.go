@@ -2,6 +2,9 @@ package mill
 
 import (
 	"testing"
+
+	"github.com/textileio/go-textile/schema"
+	"github.com/textileio/go-textile/schema/textile"
 )
 
 func TestSchema_Mill(t *testing.T) {
@@ -39,3 +42,62 @@ func TestSchema_Mill(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSchema_MillOptional(t *testing.T) {
+	m := &Schema{}
+
+	if _, err := m.Mill([]byte(textile.Audio), "audio"); err != nil {
+		t.Fatal(err)
+	}
+
+	bad := `
+{
+  "pin": true,
+  "links": {
+    "raw": {
+      "use": ":file",
+      "mill": "/blob"
+    },
+    "cover": {
+      "use": "raw",
+      "optional": true,
+      "mill": "/audio/cover"
+    },
+    "thumb": {
+      "use": "cover",
+      "mill": "/image/resize"
+    }
+  }
+}
+`
+	if _, err := m.Mill([]byte(bad), "bad"); err != schema.ErrOptionalLinkUse {
+		t.Fatalf("expected optional link use error, got %v", err)
+	}
+}
+
+func TestSchema_MillExternal(t *testing.T) {
+	ocr := `
+{
+  "pin": true,
+  "links": {
+    "raw": {
+      "use": ":file",
+      "mill": "/blob"
+    },
+    "text": {
+      "use": "raw",
+      "mill": "/acme/ocr"
+    }
+  }
+}
+`
+	m := &Schema{}
+	if _, err := m.Mill([]byte(ocr), "ocr"); err != schema.ErrSchemaInvalidMill {
+		t.Fatalf("expected invalid mill error, got %v", err)
+	}
+
+	m = &Schema{External: []string{"/acme/ocr"}}
+	if _, err := m.Mill([]byte(ocr), "ocr"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,126 @@
+package mill
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// FFmpegBin is the executable used to decode video frames
+var FFmpegBin = "ffmpeg"
+
+// ErrFFmpegNotFound indicates the ffmpeg executable is not available
+var ErrFFmpegNotFound = fmt.Errorf("ffmpeg not found")
+
+type VideoPosterOpts struct {
+	Width   string `json:"width"`
+	Quality string `json:"quality"`
+}
+
+type VideoPoster struct {
+	Opts VideoPosterOpts
+}
+
+func (m *VideoPoster) ID() string {
+	return "/video/poster"
+}
+
+func (m *VideoPoster) Encrypt() bool {
+	return true
+}
+
+func (m *VideoPoster) Pin() bool {
+	return false
+}
+
+func (m *VideoPoster) AcceptMedia(media string) error {
+	return accepts([]string{
+		"video/mp4",
+		"video/quicktime",
+		"video/webm",
+		"video/avi",
+	}, media)
+}
+
+func (m *VideoPoster) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(m.Opts, add)
+}
+
+func (m *VideoPoster) OutputMedia(media string) string {
+	return "image/jpeg"
+}
+
+func (m *VideoPoster) Mill(input []byte, name string) (*Result, error) {
+	width, err := strconv.Atoi(m.Opts.Width)
+	if err != nil {
+		return nil, fmt.Errorf("invalid width: %s", m.Opts.Width)
+	}
+	quality, err := strconv.Atoi(m.Opts.Quality)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quality: %s", m.Opts.Quality)
+	}
+
+	frame, err := extractPosterFrame(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// the frame is decoded as png, but posters are always encoded as jpeg
+	buff, rect, err := encodeImage(bytes.NewReader(frame), JPEG, width, quality)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		File: buff.Bytes(),
+		Meta: map[string]interface{}{
+			"width":  rect.Dx(),
+			"height": rect.Dy(),
+		},
+	}, nil
+}
+
+// extractPosterFrame uses ffmpeg to pick a representative frame as png.
+// Note: ffmpeg applies any container rotation before the frame is written.
+func extractPosterFrame(input []byte) ([]byte, error) {
+	bin, err := exec.LookPath(FFmpegBin)
+	if err != nil {
+		return nil, ErrFFmpegNotFound
+	}
+
+	// a file is required because some containers keep their index at the end
+	tmp, err := ioutil.TempFile("", "textile-video-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(input)
+	_ = tmp.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(bin,
+		"-v", "error",
+		"-i", tmp.Name(),
+		"-vf", "thumbnail",
+		"-frames:v", "1",
+		"-f", "image2pipe",
+		"-vcodec", "png",
+		"pipe:1")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %s", strings.TrimSpace(stderr.String()))
+	}
+	if stdout.Len() == 0 {
+		return nil, fmt.Errorf("video does not have any frames")
+	}
+
+	return stdout.Bytes(), nil
+}
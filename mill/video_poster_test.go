@@ -0,0 +1,58 @@
+package mill
+
+import (
+	"bytes"
+	"image/jpeg"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestVideoPoster_Mill(t *testing.T) {
+	if _, err := exec.LookPath(FFmpegBin); err != nil {
+		t.Skip("ffmpeg not found")
+	}
+
+	dir, err := ioutil.TempDir("", "textile-video-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// generate a short test pattern video
+	pth := filepath.Join(dir, "test.mp4")
+	cmd := exec.Command(FFmpegBin, "-v", "error",
+		"-f", "lavfi", "-i", "testsrc=size=640x360:duration=1",
+		"-pix_fmt", "yuv420p", pth)
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	input, err := ioutil.ReadFile(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &VideoPoster{
+		Opts: VideoPosterOpts{
+			Width:   "320",
+			Quality: "80",
+		},
+	}
+
+	res, err := m.Mill(input, "test.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Meta["width"] != 320 {
+		t.Errorf("wrong width")
+	}
+	if res.Meta["height"] != 180 {
+		t.Errorf("wrong height")
+	}
+	if _, err := jpeg.Decode(bytes.NewReader(res.File)); err != nil {
+		t.Errorf("poster is not a jpeg: %s", err)
+	}
+}
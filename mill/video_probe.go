@@ -0,0 +1,239 @@
+package mill
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// errMissingMovieBox indicates the input does not contain an ISO BMFF movie box
+var errMissingMovieBox = fmt.Errorf("video is missing a moov box")
+
+// mp4Epoch is the zero time used by ISO BMFF creation dates
+var mp4Epoch = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type VideoProbeSchema struct {
+	Created    time.Time `json:"created,omitempty"`
+	Name       string    `json:"name"`
+	Ext        string    `json:"extension"`
+	Format     string    `json:"format"`
+	Duration   float64   `json:"duration"`
+	Width      int       `json:"width"`
+	Height     int       `json:"height"`
+	Rotation   int       `json:"rotation"`
+	VideoCodec string    `json:"video_codec,omitempty"`
+	AudioCodec string    `json:"audio_codec,omitempty"`
+}
+
+type VideoProbe struct{}
+
+func (m *VideoProbe) ID() string {
+	return "/video/probe"
+}
+
+func (m *VideoProbe) Encrypt() bool {
+	return true
+}
+
+func (m *VideoProbe) Pin() bool {
+	return false
+}
+
+func (m *VideoProbe) AcceptMedia(media string) error {
+	return accepts([]string{
+		"video/mp4",
+		"video/quicktime",
+	}, media)
+}
+
+func (m *VideoProbe) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *VideoProbe) Mill(input []byte, name string) (*Result, error) {
+	res := &VideoProbeSchema{
+		Name: name,
+		Ext:  strings.ToLower(filepath.Ext(name)),
+	}
+
+	var moov []byte
+	for _, box := range mp4Boxes(input) {
+		switch box.typ {
+		case "ftyp":
+			if len(box.data) >= 4 {
+				res.Format = strings.TrimSpace(string(box.data[:4]))
+			}
+		case "moov":
+			moov = box.data
+		}
+	}
+	if moov == nil {
+		return nil, errMissingMovieBox
+	}
+
+	for _, box := range mp4Boxes(moov) {
+		switch box.typ {
+		case "mvhd":
+			res.Created, res.Duration = parseMvhd(box.data)
+		case "trak":
+			trak := parseTrak(box.data)
+			switch trak.handler {
+			case "vide":
+				if res.VideoCodec != "" {
+					continue
+				}
+				res.VideoCodec = trak.codec
+				res.Width = trak.width
+				res.Height = trak.height
+				res.Rotation = trak.rotation
+			case "soun":
+				if res.AudioCodec == "" {
+					res.AudioCodec = trak.codec
+				}
+			}
+		}
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{File: data}, nil
+}
+
+type mp4Box struct {
+	typ  string
+	data []byte
+}
+
+type mp4Track struct {
+	handler  string
+	codec    string
+	width    int
+	height   int
+	rotation int
+}
+
+// mp4Boxes splits data into a list of ISO BMFF boxes, stopping at the first malformed box
+func mp4Boxes(data []byte) []mp4Box {
+	var boxes []mp4Box
+	for len(data) >= 8 {
+		size := uint64(binary.BigEndian.Uint32(data[0:4]))
+		typ := string(data[4:8])
+		head := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return boxes
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			head = 16
+		}
+		if size < head || size > uint64(len(data)) {
+			return boxes
+		}
+		boxes = append(boxes, mp4Box{typ: typ, data: data[head:size]})
+		data = data[size:]
+	}
+	return boxes
+}
+
+// parseMvhd returns the creation date and duration (seconds) from a movie header box
+func parseMvhd(data []byte) (time.Time, float64) {
+	var created, timescale, duration uint64
+	if len(data) > 0 && data[0] == 1 {
+		if len(data) < 32 {
+			return time.Time{}, 0
+		}
+		created = binary.BigEndian.Uint64(data[4:12])
+		timescale = uint64(binary.BigEndian.Uint32(data[20:24]))
+		duration = binary.BigEndian.Uint64(data[24:32])
+	} else {
+		if len(data) < 20 {
+			return time.Time{}, 0
+		}
+		created = uint64(binary.BigEndian.Uint32(data[4:8]))
+		timescale = uint64(binary.BigEndian.Uint32(data[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	}
+
+	var date time.Time
+	if created > 0 {
+		date = mp4Epoch.Add(time.Duration(created) * time.Second)
+	}
+	if timescale == 0 {
+		return date, 0
+	}
+	return date, float64(duration) / float64(timescale)
+}
+
+// parseTrak collects handler, codec, dimensions, and rotation for a track box
+func parseTrak(data []byte) mp4Track {
+	var trak mp4Track
+	for _, box := range mp4Boxes(data) {
+		switch box.typ {
+		case "tkhd":
+			trak.width, trak.height, trak.rotation = parseTkhd(box.data)
+		case "mdia":
+			for _, mbox := range mp4Boxes(box.data) {
+				switch mbox.typ {
+				case "hdlr":
+					if len(mbox.data) >= 12 {
+						trak.handler = string(mbox.data[8:12])
+					}
+				case "minf":
+					trak.codec = parseMinfCodec(mbox.data)
+				}
+			}
+		}
+	}
+	return trak
+}
+
+// parseTkhd returns the presentation width, height, and rotation (degrees) of a track header box
+func parseTkhd(data []byte) (int, int, int) {
+	// version 1 uses 64-bit creation, modification, and duration fields
+	offset := 40
+	if len(data) > 0 && data[0] == 1 {
+		offset = 52
+	}
+	if len(data) < offset+44 {
+		return 0, 0, 0
+	}
+
+	matrix := data[offset : offset+36]
+	a := float64(int32(binary.BigEndian.Uint32(matrix[0:4])))
+	b := float64(int32(binary.BigEndian.Uint32(matrix[4:8])))
+	rotation := int(math.Round(math.Atan2(b, a)*180/math.Pi)+360) % 360
+
+	width := int(binary.BigEndian.Uint32(data[offset+36:offset+40]) >> 16)
+	height := int(binary.BigEndian.Uint32(data[offset+40:offset+44]) >> 16)
+
+	return width, height, rotation
+}
+
+// parseMinfCodec returns the four character code of the first sample description
+func parseMinfCodec(data []byte) string {
+	for _, box := range mp4Boxes(data) {
+		if box.typ != "stbl" {
+			continue
+		}
+		for _, sbox := range mp4Boxes(box.data) {
+			if sbox.typ != "stsd" || len(sbox.data) < 8 {
+				continue
+			}
+			entries := mp4Boxes(sbox.data[8:])
+			if len(entries) > 0 {
+				return strings.TrimSpace(entries[0].typ)
+			}
+		}
+	}
+	return ""
+}
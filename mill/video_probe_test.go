@@ -0,0 +1,99 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+)
+
+func TestVideoProbe_Mill(t *testing.T) {
+	m := &VideoProbe{}
+
+	res, err := m.Mill(testMp4(), "test.mov")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var probe *VideoProbeSchema
+	if err := json.Unmarshal(res.File, &probe); err != nil {
+		t.Fatal(err)
+	}
+
+	if probe.Format != "qt" {
+		t.Errorf("wrong format")
+	}
+	if probe.Ext != ".mov" {
+		t.Errorf("wrong extension")
+	}
+	if probe.Duration != 2.5 {
+		t.Errorf("wrong duration")
+	}
+	if probe.Width != 1920 {
+		t.Errorf("wrong width")
+	}
+	if probe.Height != 1080 {
+		t.Errorf("wrong height")
+	}
+	if probe.Rotation != 90 {
+		t.Errorf("wrong rotation")
+	}
+	if probe.VideoCodec != "avc1" {
+		t.Errorf("wrong video codec")
+	}
+	if probe.AudioCodec != "mp4a" {
+		t.Errorf("wrong audio codec")
+	}
+	if probe.Created.Year() != 2019 {
+		t.Errorf("wrong created date")
+	}
+}
+
+func TestVideoProbe_MillNotVideo(t *testing.T) {
+	m := &VideoProbe{}
+
+	if _, err := m.Mill([]byte("not a video"), "test"); err != errMissingMovieBox {
+		t.Fatal("expected missing moov error")
+	}
+}
+
+// testMp4 builds a minimal rotated quicktime movie with a video and an audio track
+func testMp4() []byte {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[4:], 3629894400) // 2019-01-10
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 2500)
+
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[44:], 0x00010000)
+	binary.BigEndian.PutUint32(tkhd[48:], 0xffff0000)
+	binary.BigEndian.PutUint32(tkhd[76:], 1920<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 1080<<16)
+
+	track := func(handler string, codec string) []byte {
+		hdlr := make([]byte, 24)
+		copy(hdlr[8:], handler)
+		stsd := append(make([]byte, 8), testBox(codec, make([]byte, 8))...)
+		stbl := testBox("stbl", testBox("stsd", stsd))
+		mdia := testBox("mdia", append(testBox("hdlr", hdlr), testBox("minf", stbl)...))
+		return testBox("trak", append(testBox("tkhd", tkhd), mdia...))
+	}
+
+	var moov bytes.Buffer
+	moov.Write(testBox("mvhd", mvhd))
+	moov.Write(track("vide", "avc1"))
+	moov.Write(track("soun", "mp4a"))
+
+	var file bytes.Buffer
+	file.Write(testBox("ftyp", []byte("qt  \x00\x00\x02\x00qt  ")))
+	file.Write(testBox("mdat", make([]byte, 16)))
+	file.Write(testBox("moov", moov.Bytes()))
+	return file.Bytes()
+}
+
+func testBox(typ string, data []byte) []byte {
+	box := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint32(box, uint32(8+len(data)))
+	copy(box[4:], typ)
+	return append(box, data...)
+}
@@ -204,7 +204,9 @@ func (m *Mobile) CompleteCafeRequest(id string) error {
 	return m.handleCafeRequestDone(id, m.cafeSyncGroupStatus(id))
 }
 
-// FailCafeRequest deletes a request
+// FailCafeRequest deletes a request.
+// Requests rejected with 507 Insufficient Storage exceed the cafe's storage quota
+// and should be failed, rather than retried.
 func (m *Mobile) FailCafeRequest(id string, reason string) error {
 	if !m.node.Started() {
 		return core.ErrStopped
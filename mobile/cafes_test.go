@@ -52,7 +52,7 @@ func TestMobile_SetupCafes(t *testing.T) {
 }
 
 func TestMobile_RegisterCafe(t *testing.T) {
-	token, err := cafesTestVars.cafe.CreateCafeToken("", true)
+	token, err := cafesTestVars.cafe.CreateCafeToken("", true, core.CafeTokenConfig{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -102,7 +102,7 @@ func TestMobile_HandleCafeRequests(t *testing.T) {
 	// check if blocks are pinned
 	var blocks []string
 	var datas []string
-	list := m.node.Blocks("", -1, "")
+	list := m.node.Blocks("", -1, "", pb.Block_DATE)
 	for _, b := range list.Items {
 		blocks = append(blocks, b.Id)
 		if b.Type == pb.Block_FILES {
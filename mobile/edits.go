@@ -0,0 +1,46 @@
+package mobile
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/core"
+)
+
+// AddEdit adds an edit targeted at the given message or files block
+func (m *Mobile) AddEdit(blockId string, body string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddEdit(block.Id, body)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// Edits calls core Edits
+func (m *Mobile) Edits(blockId string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	edits, err := m.node.Edits(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(edits)
+}
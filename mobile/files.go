@@ -129,6 +129,20 @@ func (m *Mobile) Files(threadId string, offset string, limit int) ([]byte, error
 	return proto.Marshal(files)
 }
 
+// SimilarFiles calls core SimilarFiles
+func (m *Mobile) SimilarFiles(blockId string, distance int) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	files, err := m.node.SimilarFiles(blockId, distance)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(files)
+}
+
 // FileContent is the async version of fileContent
 func (m *Mobile) FileContent(hash string, cb DataCallback) {
 	m.node.Lock()
@@ -152,6 +166,7 @@ func (m *Mobile) fileContent(hash string) ([]byte, string, error) {
 		}
 		return nil, "", err
 	}
+	defer reader.Close()
 
 	data, err := ioutil.ReadAll(reader)
 	if err != nil {
@@ -217,7 +232,7 @@ func (m *Mobile) imageFileContentForMinWidth(pth string, minWidth int) ([]byte,
 			return nil, "", err
 		}
 
-		if file.Mill == "/image/resize" {
+		if file.Mill == "/image/resize" || file.Mill == "/video/poster" {
 			width := file.Meta.Fields["width"]
 			if width != nil {
 				imgs = append(imgs, img{
@@ -292,7 +307,7 @@ func (m *Mobile) shareFiles(data string, threadId string, caption string) (mh.Mu
 		return nil, err
 	}
 
-	hash, err := thrd.AddFiles(node, "", caption, keys.Files)
+	hash, err := thrd.AddFiles(node, "", caption, keys.Files, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -320,7 +335,7 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 		Files: make(map[string]*pb.FileIndex),
 	}
 
-	mil, err := getMill(thrd.Schema.Mill, thrd.Schema.Opts)
+	mil, err := m.node.Mill(thrd.Schema.Mill, thrd.Schema.Opts)
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +348,7 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 		if err != nil {
 			return nil, err
 		}
+		conf.Thread = thrd.Id
 
 		added, err := m.node.AddFileIndex(mil, *conf)
 		if err != nil {
@@ -350,7 +366,7 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 
 		// send each link
 		for _, step := range steps {
-			mil, err := getMill(step.Link.Mill, step.Link.Opts)
+			mil, err := m.node.Mill(step.Link.Mill, step.Link.Opts)
 			if err != nil {
 				return nil, err
 			}
@@ -368,6 +384,9 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 
 			} else {
 				if dir.Files[step.Link.Use] == nil {
+					if step.Link.Optional {
+						continue
+					}
 					return nil, fmt.Errorf(step.Link.Use + " not found")
 				}
 
@@ -381,8 +400,13 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 				}
 			}
 
+			conf.Thread = thrd.Id
+
 			added, err := m.node.AddFileIndex(mil, *conf)
 			if err != nil {
+				if step.Link.Optional {
+					continue
+				}
 				return nil, err
 			}
 			dir.Files[step.Name] = added
@@ -428,61 +452,32 @@ func (m *Mobile) getFileConfig(mil mill.Mill, opts ...fileConfigOption) (*core.A
 			if err != nil {
 				return nil, err
 			}
-			defer f.Close()
 			reader = f
 			_, conf.Name = filepath.Split(f.Name())
 		}
 	}
 
+	// readers are consumed (and closed) by AddFileIndex
+	conf.Reader = reader
+	conf.Plaintext = settings.Plaintext
+
 	var err error
 	if mil.ID() == "/json" {
 		conf.Media = "application/json"
 	} else {
 		conf.Media, err = m.node.GetMillMedia(reader, mil)
 		if err != nil {
+			if closer, ok := reader.(io.Closer); ok {
+				_ = closer.Close()
+			}
 			return nil, err
 		}
 	}
 	_, _ = reader.Seek(0, 0)
 
-	input, err := ioutil.ReadAll(reader)
-	if err != nil {
-		return nil, err
-	}
-	conf.Input = input
-	conf.Plaintext = settings.Plaintext
-
 	return conf, nil
 }
 
-func getMill(id string, opts map[string]string) (mill.Mill, error) {
-	switch id {
-	case "/blob":
-		return &mill.Blob{}, nil
-	case "/image/resize":
-		width := opts["width"]
-		if width == "" {
-			return nil, fmt.Errorf("missing width")
-		}
-		quality := opts["quality"]
-		if quality == "" {
-			quality = "75"
-		}
-		return &mill.ImageResize{
-			Opts: mill.ImageResizeOpts{
-				Width:   width,
-				Quality: quality,
-			},
-		}, nil
-	case "/image/exif":
-		return &mill.ImageExif{}, nil
-	case "/json":
-		return &mill.Json{}, nil
-	default:
-		return nil, nil
-	}
-}
-
 func (m *Mobile) writeFiles(dirs *pb.DirectoryList, threadId string, caption string) (mh.Multihash, error) {
 	if !m.node.Started() {
 		return nil, core.ErrStopped
@@ -515,7 +510,7 @@ func (m *Mobile) writeFiles(dirs *pb.DirectoryList, threadId string, caption str
 		return nil, fmt.Errorf("no files found")
 	}
 
-	hash, err := thrd.AddFiles(node, "", caption, keys.Files)
+	hash, err := thrd.AddFiles(node, "", caption, keys.Files, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package mobile
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
 )
 
 // AddMessage adds a message to a thread
@@ -16,7 +17,33 @@ func (m *Mobile) AddMessage(threadId string, body string) (string, error) {
 		return "", core.ErrThreadNotFound
 	}
 
-	hash, err := thrd.AddMessage("", body)
+	hash, err := thrd.AddMessage("", body, 0)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// AddReply adds a message to a thread as a reply to the given message or files block
+func (m *Mobile) AddReply(blockId string, body string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddMessage(block.Id, body, 0)
 	if err != nil {
 		return "", err
 	}
@@ -32,7 +59,7 @@ func (m *Mobile) Messages(offset string, limit int, threadId string) ([]byte, er
 		return nil, core.ErrStopped
 	}
 
-	msgs, err := m.node.Messages(offset, limit, threadId)
+	msgs, err := m.node.Messages(offset, limit, threadId, pb.Block_DATE)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/textileio/go-textile/common"
 	"github.com/textileio/go-textile/core"
 	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/wallet"
 )
@@ -44,6 +45,30 @@ type DataCallback interface {
 	Call(data []byte, media string, err error)
 }
 
+// ImageCodec converts images using platform codecs (format is one of
+// heic, avif or webp, where input is PNG when encoding)
+type ImageCodec interface {
+	Decode(data []byte, format string) ([]byte, error)
+	Encode(data []byte, format string, quality int) ([]byte, error)
+}
+
+// SetImageCodec replaces the executable based codec used by the image mills
+func SetImageCodec(codec ImageCodec) {
+	mill.Codec = &imageCodec{codec: codec}
+}
+
+type imageCodec struct {
+	codec ImageCodec
+}
+
+func (c *imageCodec) Decode(input []byte, format mill.Format) ([]byte, error) {
+	return c.codec.Decode(input, string(format))
+}
+
+func (c *imageCodec) Encode(input []byte, format mill.Format, quality int) ([]byte, error) {
+	return c.codec.Encode(input, string(format), quality)
+}
+
 // NewWallet creates a brand new wallet and returns its recovery phrase
 func NewWallet(wordCount int) (string, error) {
 	w, err := wallet.WalletFromWordCount(wordCount)
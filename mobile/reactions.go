@@ -0,0 +1,46 @@
+package mobile
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/core"
+)
+
+// AddReaction adds a reaction targeted at the given block, or removes it if already added
+func (m *Mobile) AddReaction(blockId string, emoji string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddReaction(block.Id, emoji)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// Reactions calls core Reactions
+func (m *Mobile) Reactions(blockId string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	reactions, err := m.node.Reactions(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(reactions)
+}
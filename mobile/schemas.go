@@ -4,7 +4,6 @@ import (
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/textileio/go-textile/core"
-	"github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -26,7 +25,7 @@ func (m *Mobile) AddSchema(node []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	added, err := m.node.AddFileIndex(&mill.Schema{}, core.AddFileConfig{
+	added, err := m.node.AddFileIndex(m.node.SchemaMill(), core.AddFileConfig{
 		Input: []byte(jsn),
 		Media: "application/json",
 	})
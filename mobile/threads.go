@@ -2,6 +2,8 @@ package mobile
 
 import (
 	"crypto/rand"
+	"os"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
@@ -40,6 +42,38 @@ func (m *Mobile) AddThread(config []byte) ([]byte, error) {
 	return proto.Marshal(view)
 }
 
+// ForkThread adds a new thread from the messages and files of an existing one,
+// between the from and to blocks (empty for the whole thread)
+func (m *Mobile) ForkThread(id string, config []byte, from string, to string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	conf := new(pb.AddThreadConfig)
+	if err := proto.Unmarshal(config, conf); err != nil {
+		return nil, err
+	}
+
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	thrd, err := m.node.ForkThread(id, *conf, sk, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := m.node.ThreadView(thrd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.node.FlushCafes()
+
+	return proto.Marshal(view)
+}
+
 // AddOrUpdateThread calls core AddOrUpdateThread
 func (m *Mobile) AddOrUpdateThread(thrd []byte) error {
 	if !m.node.Online() {
@@ -129,6 +163,198 @@ func (m *Mobile) ThreadPeers(id string) ([]byte, error) {
 	return proto.Marshal(peers)
 }
 
+// ThreadMembers calls core ThreadMembers
+func (m *Mobile) ThreadMembers(id string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	list, err := m.node.ThreadMembers(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(list)
+}
+
+// AddThreadMember adds an address to a thread with an optional role
+func (m *Mobile) AddThreadMember(id string, address string, role string) (string, error) {
+	r, err := core.ParseThreadRole(role)
+	if err != nil {
+		return "", err
+	}
+	return m.updateThreadMember(id, address, pb.ThreadMembership_ADD, r)
+}
+
+// RemoveThreadMember removes an address from a thread
+func (m *Mobile) RemoveThreadMember(id string, address string) (string, error) {
+	return m.updateThreadMember(id, address, pb.ThreadMembership_REMOVE, pb.ThreadMember_DEFAULT)
+}
+
+// GrantThreadRole grants a role to a thread member
+func (m *Mobile) GrantThreadRole(id string, address string, role string) (string, error) {
+	r, err := core.ParseThreadRole(role)
+	if err != nil {
+		return "", err
+	}
+	return m.updateThreadMember(id, address, pb.ThreadMembership_GRANT, r)
+}
+
+// RevokeThreadRole revokes a thread member's role
+func (m *Mobile) RevokeThreadRole(id string, address string) (string, error) {
+	return m.updateThreadMember(id, address, pb.ThreadMembership_REVOKE, pb.ThreadMember_DEFAULT)
+}
+
+func (m *Mobile) updateThreadMember(id string, address string, action pb.ThreadMembership_Action, role pb.ThreadMember_Role) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	hash, err := m.node.UpdateThreadMember(id, address, action, role)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// RotateThreadKey calls core RotateThreadKey
+func (m *Mobile) RotateThreadKey(id string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	hash, err := m.node.RotateThreadKey(id)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// VerifyThread calls core VerifyThread
+func (m *Mobile) VerifyThread(id string, repair bool) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	res, err := m.node.VerifyThread(id, repair)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(res)
+}
+
+// ExportThread calls core ExportThread, writing the archive to path
+func (m *Mobile) ExportThread(id string, path string, key bool) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return m.node.ExportThread(id, key, file)
+}
+
+// ImportThread calls core ImportThread with the archive at path
+func (m *Mobile) ImportThread(path string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	view, err := m.node.ImportThread(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(view)
+}
+
+// ThreadRetention calls core ThreadRetention
+func (m *Mobile) ThreadRetention(id string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	retention, err := m.node.ThreadRetention(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(retention)
+}
+
+// SetThreadRetention calls core SetThreadRetention, max age is in seconds
+func (m *Mobile) SetThreadRetention(id string, maxAge int64, maxBlocks int) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	retention, err := m.node.SetThreadRetention(id, time.Duration(maxAge)*time.Second, maxBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(retention)
+}
+
+// ThreadSchemas calls core ThreadSchemas
+func (m *Mobile) ThreadSchemas(id string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	list, err := m.node.ThreadSchemas(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(list)
+}
+
+// UpdateThreadSchema calls core UpdateThreadSchema, returning the schema diff
+func (m *Mobile) UpdateThreadSchema(id string, hash string, force bool) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	diff, err := m.node.UpdateThreadSchema(id, hash, force)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(diff)
+}
+
+// BackfillThreadSchema calls core BackfillThreadSchema
+func (m *Mobile) BackfillThreadSchema(id string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	list, err := m.node.BackfillThreadSchema(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(list)
+}
+
 // RemoveThread call core RemoveThread
 func (m *Mobile) RemoveThread(id string) (string, error) {
 	if !m.node.Started() {
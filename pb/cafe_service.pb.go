@@ -6,6 +6,7 @@ package pb
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -29,7 +30,7 @@ func (m *CafeChallenge) Reset()         { *m = CafeChallenge{} }
 func (m *CafeChallenge) String() string { return proto.CompactTextString(m) }
 func (*CafeChallenge) ProtoMessage()    {}
 func (*CafeChallenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{0}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{0}
 }
 func (m *CafeChallenge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeChallenge.Unmarshal(m, b)
@@ -67,7 +68,7 @@ func (m *CafeNonce) Reset()         { *m = CafeNonce{} }
 func (m *CafeNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeNonce) ProtoMessage()    {}
 func (*CafeNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{1}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{1}
 }
 func (m *CafeNonce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeNonce.Unmarshal(m, b)
@@ -109,7 +110,7 @@ func (m *CafeRegistration) Reset()         { *m = CafeRegistration{} }
 func (m *CafeRegistration) String() string { return proto.CompactTextString(m) }
 func (*CafeRegistration) ProtoMessage()    {}
 func (*CafeRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{2}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{2}
 }
 func (m *CafeRegistration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRegistration.Unmarshal(m, b)
@@ -175,7 +176,7 @@ func (m *CafeDeregistration) Reset()         { *m = CafeDeregistration{} }
 func (m *CafeDeregistration) String() string { return proto.CompactTextString(m) }
 func (*CafeDeregistration) ProtoMessage()    {}
 func (*CafeDeregistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{3}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{3}
 }
 func (m *CafeDeregistration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeregistration.Unmarshal(m, b)
@@ -213,7 +214,7 @@ func (m *CafeDeregistrationAck) Reset()         { *m = CafeDeregistrationAck{} }
 func (m *CafeDeregistrationAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeregistrationAck) ProtoMessage()    {}
 func (*CafeDeregistrationAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{4}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{4}
 }
 func (m *CafeDeregistrationAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeregistrationAck.Unmarshal(m, b)
@@ -252,7 +253,7 @@ func (m *CafeRefreshSession) Reset()         { *m = CafeRefreshSession{} }
 func (m *CafeRefreshSession) String() string { return proto.CompactTextString(m) }
 func (*CafeRefreshSession) ProtoMessage()    {}
 func (*CafeRefreshSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{5}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{5}
 }
 func (m *CafeRefreshSession) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRefreshSession.Unmarshal(m, b)
@@ -298,7 +299,7 @@ func (m *CafePublishPeer) Reset()         { *m = CafePublishPeer{} }
 func (m *CafePublishPeer) String() string { return proto.CompactTextString(m) }
 func (*CafePublishPeer) ProtoMessage()    {}
 func (*CafePublishPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{6}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{6}
 }
 func (m *CafePublishPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafePublishPeer.Unmarshal(m, b)
@@ -343,7 +344,7 @@ func (m *CafePublishPeerAck) Reset()         { *m = CafePublishPeerAck{} }
 func (m *CafePublishPeerAck) String() string { return proto.CompactTextString(m) }
 func (*CafePublishPeerAck) ProtoMessage()    {}
 func (*CafePublishPeerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{7}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{7}
 }
 func (m *CafePublishPeerAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafePublishPeerAck.Unmarshal(m, b)
@@ -382,7 +383,7 @@ func (m *CafeStore) Reset()         { *m = CafeStore{} }
 func (m *CafeStore) String() string { return proto.CompactTextString(m) }
 func (*CafeStore) ProtoMessage()    {}
 func (*CafeStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{8}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{8}
 }
 func (m *CafeStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStore.Unmarshal(m, b)
@@ -417,17 +418,18 @@ func (m *CafeStore) GetCids() []string {
 }
 
 type CafeStoreAck struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Usage                *CafeUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CafeStoreAck) Reset()         { *m = CafeStoreAck{} }
 func (m *CafeStoreAck) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreAck) ProtoMessage()    {}
 func (*CafeStoreAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{9}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{9}
 }
 func (m *CafeStoreAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreAck.Unmarshal(m, b)
@@ -454,6 +456,13 @@ func (m *CafeStoreAck) GetId() string {
 	return ""
 }
 
+func (m *CafeStoreAck) GetUsage() *CafeUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type CafeUnstore struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Cids                 []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
@@ -466,7 +475,7 @@ func (m *CafeUnstore) Reset()         { *m = CafeUnstore{} }
 func (m *CafeUnstore) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstore) ProtoMessage()    {}
 func (*CafeUnstore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{10}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{10}
 }
 func (m *CafeUnstore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstore.Unmarshal(m, b)
@@ -511,7 +520,7 @@ func (m *CafeUnstoreAck) Reset()         { *m = CafeUnstoreAck{} }
 func (m *CafeUnstoreAck) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreAck) ProtoMessage()    {}
 func (*CafeUnstoreAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{11}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{11}
 }
 func (m *CafeUnstoreAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreAck.Unmarshal(m, b)
@@ -539,17 +548,18 @@ func (m *CafeUnstoreAck) GetCids() []string {
 }
 
 type CafeObjectList struct {
-	Cids                 []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Cids                 []string   `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	Usage                *CafeUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CafeObjectList) Reset()         { *m = CafeObjectList{} }
 func (m *CafeObjectList) String() string { return proto.CompactTextString(m) }
 func (*CafeObjectList) ProtoMessage()    {}
 func (*CafeObjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{12}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{12}
 }
 func (m *CafeObjectList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeObjectList.Unmarshal(m, b)
@@ -576,6 +586,13 @@ func (m *CafeObjectList) GetCids() []string {
 	return nil
 }
 
+func (m *CafeObjectList) GetUsage() *CafeUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type CafeObject struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
@@ -590,7 +607,7 @@ func (m *CafeObject) Reset()         { *m = CafeObject{} }
 func (m *CafeObject) String() string { return proto.CompactTextString(m) }
 func (*CafeObject) ProtoMessage()    {}
 func (*CafeObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{13}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{13}
 }
 func (m *CafeObject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeObject.Unmarshal(m, b)
@@ -651,7 +668,7 @@ func (m *CafeStoreThread) Reset()         { *m = CafeStoreThread{} }
 func (m *CafeStoreThread) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreThread) ProtoMessage()    {}
 func (*CafeStoreThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{14}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{14}
 }
 func (m *CafeStoreThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreThread.Unmarshal(m, b)
@@ -693,17 +710,18 @@ func (m *CafeStoreThread) GetCiphertext() []byte {
 }
 
 type CafeStoreThreadAck struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Usage                *CafeUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CafeStoreThreadAck) Reset()         { *m = CafeStoreThreadAck{} }
 func (m *CafeStoreThreadAck) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreThreadAck) ProtoMessage()    {}
 func (*CafeStoreThreadAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{15}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{15}
 }
 func (m *CafeStoreThreadAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreThreadAck.Unmarshal(m, b)
@@ -730,6 +748,13 @@ func (m *CafeStoreThreadAck) GetId() string {
 	return ""
 }
 
+func (m *CafeStoreThreadAck) GetUsage() *CafeUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type CafeUnstoreThread struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -742,7 +767,7 @@ func (m *CafeUnstoreThread) Reset()         { *m = CafeUnstoreThread{} }
 func (m *CafeUnstoreThread) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreThread) ProtoMessage()    {}
 func (*CafeUnstoreThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{16}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{16}
 }
 func (m *CafeUnstoreThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreThread.Unmarshal(m, b)
@@ -787,7 +812,7 @@ func (m *CafeUnstoreThreadAck) Reset()         { *m = CafeUnstoreThreadAck{} }
 func (m *CafeUnstoreThreadAck) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreThreadAck) ProtoMessage()    {}
 func (*CafeUnstoreThreadAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{17}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{17}
 }
 func (m *CafeUnstoreThreadAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreThreadAck.Unmarshal(m, b)
@@ -827,7 +852,7 @@ func (m *CafeDeliverMessage) Reset()         { *m = CafeDeliverMessage{} }
 func (m *CafeDeliverMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeDeliverMessage) ProtoMessage()    {}
 func (*CafeDeliverMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{18}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{18}
 }
 func (m *CafeDeliverMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeliverMessage.Unmarshal(m, b)
@@ -879,7 +904,7 @@ func (m *CafeCheckMessages) Reset()         { *m = CafeCheckMessages{} }
 func (m *CafeCheckMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeCheckMessages) ProtoMessage()    {}
 func (*CafeCheckMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{19}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{19}
 }
 func (m *CafeCheckMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeCheckMessages.Unmarshal(m, b)
@@ -917,7 +942,7 @@ func (m *CafeMessages) Reset()         { *m = CafeMessages{} }
 func (m *CafeMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeMessages) ProtoMessage()    {}
 func (*CafeMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{20}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{20}
 }
 func (m *CafeMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeMessages.Unmarshal(m, b)
@@ -955,7 +980,7 @@ func (m *CafeDeleteMessages) Reset()         { *m = CafeDeleteMessages{} }
 func (m *CafeDeleteMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessages) ProtoMessage()    {}
 func (*CafeDeleteMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{21}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{21}
 }
 func (m *CafeDeleteMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeleteMessages.Unmarshal(m, b)
@@ -993,7 +1018,7 @@ func (m *CafeDeleteMessagesAck) Reset()         { *m = CafeDeleteMessagesAck{} }
 func (m *CafeDeleteMessagesAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessagesAck) ProtoMessage()    {}
 func (*CafeDeleteMessagesAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d8d39819aa21027d, []int{22}
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{22}
 }
 func (m *CafeDeleteMessagesAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeleteMessagesAck.Unmarshal(m, b)
@@ -1020,6 +1045,136 @@ func (m *CafeDeleteMessagesAck) GetMore() bool {
 	return false
 }
 
+type CafeClientExpiring struct {
+	Expires              *timestamp.Timestamp `protobuf:"bytes,1,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientExpiring) Reset()         { *m = CafeClientExpiring{} }
+func (m *CafeClientExpiring) String() string { return proto.CompactTextString(m) }
+func (*CafeClientExpiring) ProtoMessage()    {}
+func (*CafeClientExpiring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{23}
+}
+func (m *CafeClientExpiring) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientExpiring.Unmarshal(m, b)
+}
+func (m *CafeClientExpiring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientExpiring.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientExpiring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientExpiring.Merge(dst, src)
+}
+func (m *CafeClientExpiring) XXX_Size() int {
+	return xxx_messageInfo_CafeClientExpiring.Size(m)
+}
+func (m *CafeClientExpiring) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientExpiring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientExpiring proto.InternalMessageInfo
+
+func (m *CafeClientExpiring) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+type CafeReplicate struct {
+	Clients              []*CafeClient      `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	Items                []*CafeReplication `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Tokens               []*CafeToken       `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeReplicate) Reset()         { *m = CafeReplicate{} }
+func (m *CafeReplicate) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicate) ProtoMessage()    {}
+func (*CafeReplicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{24}
+}
+func (m *CafeReplicate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicate.Unmarshal(m, b)
+}
+func (m *CafeReplicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicate.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicate.Merge(dst, src)
+}
+func (m *CafeReplicate) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicate.Size(m)
+}
+func (m *CafeReplicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicate proto.InternalMessageInfo
+
+func (m *CafeReplicate) GetClients() []*CafeClient {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetItems() []*CafeReplication {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetTokens() []*CafeToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type CafeReplicateAck struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeReplicateAck) Reset()         { *m = CafeReplicateAck{} }
+func (m *CafeReplicateAck) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicateAck) ProtoMessage()    {}
+func (*CafeReplicateAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_3af173d56bdd5262, []int{25}
+}
+func (m *CafeReplicateAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicateAck.Unmarshal(m, b)
+}
+func (m *CafeReplicateAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicateAck.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicateAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicateAck.Merge(dst, src)
+}
+func (m *CafeReplicateAck) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicateAck.Size(m)
+}
+func (m *CafeReplicateAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicateAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicateAck proto.InternalMessageInfo
+
+func (m *CafeReplicateAck) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CafeChallenge)(nil), "CafeChallenge")
 	proto.RegisterType((*CafeNonce)(nil), "CafeNonce")
@@ -1044,44 +1199,57 @@ func init() {
 	proto.RegisterType((*CafeMessages)(nil), "CafeMessages")
 	proto.RegisterType((*CafeDeleteMessages)(nil), "CafeDeleteMessages")
 	proto.RegisterType((*CafeDeleteMessagesAck)(nil), "CafeDeleteMessagesAck")
-}
-
-func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_cafe_service_d8d39819aa21027d) }
-
-var fileDescriptor_cafe_service_d8d39819aa21027d = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x51, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0x95, 0x74, 0x1d, 0xeb, 0xb5, 0x1b, 0x25, 0x0c, 0x14, 0x78, 0x98, 0x8a, 0x35, 0x41,
-	0x07, 0x52, 0x1f, 0x86, 0x10, 0xf0, 0xc8, 0x8a, 0x78, 0x82, 0x31, 0x65, 0x20, 0x24, 0x84, 0x84,
-	0x5c, 0xe7, 0xda, 0x98, 0xa6, 0x49, 0x65, 0x7b, 0x15, 0x8f, 0x7c, 0x74, 0x74, 0xb6, 0xd3, 0x46,
-	0x34, 0x15, 0xda, 0xdb, 0xdd, 0xe5, 0xe7, 0xff, 0x9d, 0xef, 0xce, 0x81, 0x48, 0xf0, 0x29, 0xfe,
-	0xd4, 0xa8, 0x56, 0x52, 0xe0, 0x68, 0xa9, 0x4a, 0x53, 0x3e, 0xee, 0x2e, 0xca, 0x14, 0x73, 0xe7,
-	0xb0, 0x33, 0x38, 0x1c, 0xf3, 0x29, 0x8e, 0x33, 0x9e, 0xe7, 0x58, 0xcc, 0x30, 0x8a, 0xe1, 0x0e,
-	0x4f, 0x53, 0x85, 0x5a, 0xc7, 0xc1, 0x20, 0x18, 0x76, 0x92, 0xca, 0x65, 0x4f, 0xa0, 0x43, 0xe8,
-	0x65, 0x59, 0x08, 0x8c, 0x8e, 0xa1, 0xbd, 0xe2, 0xf9, 0x0d, 0x7a, 0xc8, 0x39, 0xec, 0x4f, 0x00,
-	0x7d, 0x62, 0x12, 0x9c, 0x49, 0x6d, 0x14, 0x37, 0xb2, 0x2c, 0x76, 0x2b, 0x6e, 0x44, 0xc2, 0x9a,
-	0x08, 0x45, 0x0b, 0xca, 0x11, 0xb7, 0x5c, 0xd4, 0x3a, 0x51, 0x1f, 0x5a, 0x5a, 0xce, 0xe2, 0xbd,
-	0x41, 0x30, 0xec, 0x25, 0x64, 0x12, 0x67, 0xca, 0x39, 0x16, 0x71, 0xdb, 0x71, 0xd6, 0x61, 0xcf,
-	0x21, 0xa2, 0x0a, 0xde, 0xa3, 0xaa, 0xd7, 0xb0, 0x66, 0x83, 0x3a, 0xfb, 0x0c, 0x1e, 0x6c, 0xb3,
-	0xef, 0xc4, 0x3c, 0x3a, 0x82, 0x50, 0xa6, 0x9e, 0x0d, 0x65, 0xca, 0x3e, 0x38, 0xd1, 0x04, 0xa7,
-	0x0a, 0x75, 0x76, 0x8d, 0x5a, 0x93, 0xe8, 0x43, 0xd8, 0xe7, 0x42, 0x6c, 0xee, 0xe5, 0x3d, 0xba,
-	0xb0, 0x72, 0xa4, 0xbf, 0x58, 0xe5, 0xb2, 0x0b, 0xb8, 0x4b, 0x3a, 0x57, 0x37, 0x93, 0x5c, 0xea,
-	0xec, 0x0a, 0x51, 0x35, 0x57, 0x16, 0x3d, 0x82, 0xbd, 0x25, 0xa2, 0xb2, 0xe7, 0xbb, 0xe7, 0xed,
-	0x11, 0xa1, 0x89, 0x0d, 0xb1, 0x53, 0x57, 0x4b, 0x4d, 0xa3, 0xa9, 0xe2, 0x57, 0x6e, 0x58, 0xd7,
-	0xa6, 0x54, 0xb8, 0x23, 0x47, 0x04, 0x7b, 0x42, 0xa6, 0x3a, 0x0e, 0x07, 0xad, 0x61, 0x27, 0xb1,
-	0x36, 0x3b, 0x81, 0xde, 0xfa, 0x58, 0x93, 0xec, 0x6b, 0xe8, 0xd2, 0xf7, 0xaf, 0x85, 0xbe, 0xa5,
-	0xf0, 0x29, 0x1c, 0xd5, 0x0e, 0x92, 0x74, 0x45, 0x05, 0xdb, 0xd4, 0xe7, 0xc9, 0x2f, 0x14, 0xe6,
-	0xa3, 0xd4, 0xa6, 0x91, 0xfa, 0x01, 0xb0, 0xa1, 0x76, 0xd4, 0xd0, 0x87, 0x96, 0x90, 0xa9, 0xef,
-	0x3f, 0x99, 0xa4, 0x94, 0x72, 0xc3, 0xed, 0x56, 0xf5, 0x12, 0x6b, 0x53, 0xac, 0x28, 0x53, 0xf4,
-	0x5b, 0x65, 0x6d, 0xf6, 0xcd, 0xcd, 0xc8, 0xb6, 0xe0, 0x4b, 0xa6, 0x90, 0xa7, 0x3b, 0x52, 0xb8,
-	0xde, 0x84, 0x55, 0x6f, 0xa2, 0x13, 0x00, 0x21, 0x97, 0x19, 0x2a, 0x83, 0xbf, 0x8d, 0x4f, 0x53,
-	0x8b, 0x54, 0x83, 0xab, 0x09, 0x37, 0x75, 0xf8, 0x2d, 0xdc, 0xab, 0x35, 0xea, 0x36, 0x05, 0xb0,
-	0xa7, 0x70, 0xbc, 0x75, 0xb4, 0x29, 0xc5, 0x65, 0xf5, 0x44, 0x72, 0xb9, 0x42, 0xf5, 0x09, 0xb5,
-	0xe6, 0x33, 0xfc, 0x97, 0xa2, 0xed, 0x16, 0xb9, 0xc4, 0xc2, 0xf8, 0x0c, 0xde, 0xa3, 0xce, 0x62,
-	0xb1, 0xf2, 0xf7, 0x23, 0x93, 0x9d, 0xb9, 0x92, 0xc7, 0x19, 0x8a, 0xb9, 0x57, 0xd3, 0x3b, 0x5e,
-	0xdc, 0x1b, 0xb7, 0x5f, 0x6b, 0x6a, 0x08, 0x07, 0x0b, 0x6f, 0xdb, 0x11, 0x77, 0xcf, 0x7b, 0xa3,
-	0x1a, 0x90, 0xac, 0xbf, 0x6e, 0xde, 0x75, 0x8e, 0x06, 0xff, 0x93, 0xe5, 0x45, 0xf5, 0xae, 0xeb,
-	0xac, 0xdf, 0xb9, 0x45, 0xa9, 0xdc, 0x4f, 0xeb, 0x20, 0xb1, 0xf6, 0xc5, 0x7d, 0x38, 0x94, 0xe5,
-	0x88, 0x26, 0x24, 0x73, 0x1c, 0x2d, 0x27, 0xdf, 0xc3, 0xe5, 0x64, 0xb2, 0x6f, 0xff, 0x8e, 0x2f,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xc5, 0x28, 0x58, 0x40, 0x05, 0x00, 0x00,
+	proto.RegisterType((*CafeClientExpiring)(nil), "CafeClientExpiring")
+	proto.RegisterType((*CafeReplicate)(nil), "CafeReplicate")
+	proto.RegisterType((*CafeReplicateAck)(nil), "CafeReplicateAck")
+}
+
+func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_cafe_service_3af173d56bdd5262) }
+
+var fileDescriptor_cafe_service_3af173d56bdd5262 = []byte{
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x4c,
+	0x10, 0x55, 0x92, 0x26, 0x6d, 0x27, 0x69, 0x9b, 0x6f, 0xbf, 0xb6, 0x0a, 0x3d, 0x40, 0x58, 0x95,
+	0x92, 0x82, 0xe4, 0x4a, 0x01, 0x04, 0xdc, 0xa0, 0x81, 0x1e, 0x10, 0x94, 0x6a, 0x5b, 0x84, 0x84,
+	0x90, 0x90, 0x63, 0x4f, 0x9c, 0xa5, 0x8e, 0x6d, 0xed, 0x6e, 0xa2, 0x1e, 0x11, 0x7f, 0x39, 0xda,
+	0x1f, 0x76, 0x2c, 0x92, 0x08, 0xca, 0x6d, 0x66, 0xf6, 0xed, 0x7b, 0x33, 0xb3, 0xb3, 0x03, 0x24,
+	0xf0, 0x47, 0xf8, 0x4d, 0xa2, 0x98, 0xf1, 0x00, 0xbd, 0x4c, 0xa4, 0x2a, 0x3d, 0xb8, 0x17, 0xa5,
+	0x69, 0x14, 0xe3, 0x89, 0xf1, 0x86, 0xd3, 0xd1, 0x89, 0xe2, 0x13, 0x94, 0xca, 0x9f, 0x64, 0x0e,
+	0xd0, 0x9c, 0xa4, 0x21, 0xc6, 0xd6, 0xa1, 0xc7, 0xb0, 0x35, 0xf0, 0x47, 0x38, 0x18, 0xfb, 0x71,
+	0x8c, 0x49, 0x84, 0xa4, 0x03, 0xeb, 0x7e, 0x18, 0x0a, 0x94, 0xb2, 0x53, 0xe9, 0x56, 0x7a, 0x9b,
+	0x2c, 0x77, 0xe9, 0x7d, 0xd8, 0xd4, 0xd0, 0xf3, 0x34, 0x09, 0x90, 0xec, 0x42, 0x7d, 0xe6, 0xc7,
+	0x53, 0x74, 0x20, 0xeb, 0xd0, 0x1f, 0x15, 0x68, 0x6b, 0x0c, 0xc3, 0x88, 0x4b, 0x25, 0x7c, 0xc5,
+	0xd3, 0x64, 0x35, 0xe3, 0x9c, 0xa4, 0x5a, 0x22, 0xd1, 0xd1, 0x44, 0x6b, 0x74, 0x6a, 0x36, 0x6a,
+	0x1c, 0xd2, 0x86, 0x9a, 0xe4, 0x51, 0x67, 0xad, 0x5b, 0xe9, 0xb5, 0x98, 0x36, 0x35, 0x4e, 0xa5,
+	0xd7, 0x98, 0x74, 0xea, 0x16, 0x67, 0x1c, 0xfa, 0x08, 0x88, 0xce, 0xe0, 0x0d, 0x8a, 0x72, 0x0e,
+	0x05, 0xb6, 0x52, 0xc6, 0x3e, 0x84, 0xbd, 0x45, 0xec, 0xeb, 0xe0, 0x9a, 0x6c, 0x43, 0x95, 0x87,
+	0x0e, 0x5b, 0xe5, 0x21, 0x3d, 0xb3, 0xa4, 0x0c, 0x47, 0x02, 0xe5, 0xf8, 0x12, 0xa5, 0xd4, 0xa4,
+	0xfb, 0xd0, 0xf0, 0x83, 0x60, 0x5e, 0x97, 0xf3, 0x74, 0xc1, 0xc2, 0x22, 0x5d, 0x61, 0xb9, 0x4b,
+	0x4f, 0x61, 0x47, 0xf3, 0x5c, 0x4c, 0x87, 0x31, 0x97, 0xe3, 0x0b, 0x44, 0xb1, 0x3c, 0x33, 0x72,
+	0x07, 0xd6, 0x32, 0x44, 0x61, 0xee, 0x37, 0xfb, 0x75, 0x4f, 0x43, 0x99, 0x09, 0xd1, 0x43, 0x20,
+	0xbf, 0x71, 0x2c, 0xcb, 0xf8, 0x99, 0x7d, 0xac, 0x4b, 0x95, 0x0a, 0x5c, 0xa1, 0x41, 0x60, 0x2d,
+	0xe0, 0xa1, 0xec, 0x54, 0xbb, 0xb5, 0xde, 0x26, 0x33, 0x36, 0x7d, 0x05, 0xad, 0xe2, 0xda, 0x12,
+	0x5a, 0xd2, 0x85, 0xfa, 0x54, 0xfa, 0x11, 0xba, 0xc4, 0xc0, 0xd3, 0xe8, 0x4f, 0x3a, 0xc2, 0xec,
+	0x01, 0x7d, 0x0e, 0x4d, 0x13, 0x4b, 0xe4, 0x2d, 0xa5, 0x0f, 0x61, 0xbb, 0x74, 0x51, 0x8b, 0xe7,
+	0xa8, 0x4a, 0x09, 0x75, 0x66, 0x51, 0x1f, 0x87, 0xdf, 0x31, 0x50, 0xef, 0xb9, 0x54, 0xcb, 0x50,
+	0x7f, 0x91, 0xe6, 0x57, 0x80, 0x39, 0xcf, 0x8a, 0x2c, 0xdb, 0x50, 0x0b, 0x78, 0xe8, 0xde, 0x50,
+	0x9b, 0x5a, 0x2b, 0xf4, 0x95, 0x6f, 0x26, 0xb3, 0xc5, 0x8c, 0xad, 0x63, 0x49, 0x1a, 0xa2, 0x9b,
+	0x4c, 0x63, 0xd3, 0xcf, 0xb0, 0x53, 0xb4, 0xf1, 0x6a, 0x2c, 0xd0, 0x0f, 0x57, 0x48, 0xd8, 0xfe,
+	0x56, 0x8b, 0xfe, 0xde, 0x05, 0x08, 0x78, 0x36, 0x46, 0xa1, 0xf0, 0x46, 0x39, 0x99, 0x52, 0x24,
+	0x1f, 0xc4, 0x12, 0xf1, 0xbf, 0xbd, 0xd2, 0x4b, 0xf8, 0xaf, 0xd4, 0xec, 0xdb, 0xa4, 0x48, 0x8f,
+	0x60, 0x77, 0xe1, 0xea, 0xb2, 0x09, 0x3c, 0xcf, 0x3f, 0x62, 0xcc, 0x67, 0x28, 0x3e, 0xa0, 0xd4,
+	0xc2, 0x0b, 0xa9, 0xee, 0x43, 0x23, 0x88, 0x39, 0x26, 0xca, 0x29, 0x38, 0x4f, 0xf7, 0x1e, 0x93,
+	0x99, 0xeb, 0x80, 0x36, 0xe9, 0xb1, 0x4d, 0x79, 0x30, 0xc6, 0xe0, 0xda, 0xb1, 0xc9, 0x15, 0xff,
+	0xfa, 0x85, 0x9d, 0xe2, 0x02, 0xd5, 0x83, 0x8d, 0x89, 0xb3, 0xcd, 0x98, 0x34, 0xfb, 0x2d, 0xaf,
+	0x04, 0x60, 0xc5, 0xe9, 0x7c, 0x7b, 0xc4, 0xa8, 0xf0, 0x0f, 0x2a, 0x8f, 0x61, 0x6f, 0x11, 0xeb,
+	0xe6, 0x76, 0x92, 0x0a, 0xbb, 0x1a, 0x37, 0x98, 0xb1, 0xe9, 0x3b, 0x4b, 0x3c, 0x30, 0xd5, 0xbd,
+	0xbd, 0xc9, 0xb8, 0xe0, 0x49, 0x44, 0x9e, 0xc2, 0x3a, 0x6a, 0x1b, 0xed, 0x0a, 0x69, 0xf6, 0x0f,
+	0x3c, 0xbb, 0xbd, 0xbd, 0x7c, 0x7b, 0x7b, 0x57, 0xf9, 0xf6, 0x66, 0x39, 0x94, 0xfe, 0xac, 0xd8,
+	0xa5, 0xcd, 0x30, 0x8b, 0x79, 0xe0, 0x2b, 0x24, 0x0f, 0x60, 0xdd, 0xf6, 0x2d, 0xaf, 0xaf, 0xe9,
+	0xcd, 0xd5, 0x58, 0x7e, 0x46, 0x8e, 0xa0, 0xce, 0x15, 0x4e, 0xec, 0xbf, 0x6b, 0xf6, 0xdb, 0x5e,
+	0x99, 0x85, 0xa7, 0x09, 0xb3, 0xc7, 0x84, 0x42, 0xc3, 0x94, 0x28, 0x3b, 0xb5, 0x6e, 0xad, 0x18,
+	0xa0, 0x2b, 0x1d, 0x62, 0xee, 0x84, 0x1e, 0x42, 0xbb, 0x7c, 0xdb, 0x7c, 0xd8, 0x36, 0xd4, 0xe6,
+	0x3f, 0x51, 0x9b, 0xa7, 0xff, 0xc3, 0x16, 0x4f, 0x3d, 0x3d, 0xba, 0x5c, 0x17, 0x35, 0xfc, 0x52,
+	0xcd, 0x86, 0xc3, 0x86, 0x29, 0xee, 0xc9, 0xaf, 0x01, 0x00, 0x1f, 0xca, 0x5f, 0xee, 0xbe, 0x06,
+	0x00, 0x00,
 }
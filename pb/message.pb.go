@@ -53,6 +53,9 @@ const (
 	Message_CAFE_PUBLISH_PEER_ACK         Message_Type = 67
 	Message_CAFE_QUERY                    Message_Type = 70
 	Message_CAFE_QUERY_RES                Message_Type = 71
+	Message_CAFE_REPLICATE                Message_Type = 79
+	Message_CAFE_REPLICATE_ACK            Message_Type = 80
+	Message_CAFE_CLIENT_EXPIRING          Message_Type = 81
 	Message_CAFE_PUBSUB_QUERY             Message_Type = 102
 	Message_CAFE_PUBSUB_QUERY_RES         Message_Type = 103
 	Message_ERROR                         Message_Type = 500
@@ -94,6 +97,9 @@ var Message_Type_name = map[int32]string{
 	67:  "CAFE_PUBLISH_PEER_ACK",
 	70:  "CAFE_QUERY",
 	71:  "CAFE_QUERY_RES",
+	79:  "CAFE_REPLICATE",
+	80:  "CAFE_REPLICATE_ACK",
+	81:  "CAFE_CLIENT_EXPIRING",
 	102: "CAFE_PUBSUB_QUERY",
 	103: "CAFE_PUBSUB_QUERY_RES",
 	500: "ERROR",
@@ -134,6 +140,9 @@ var Message_Type_value = map[string]int32{
 	"CAFE_PUBLISH_PEER_ACK":         67,
 	"CAFE_QUERY":                    70,
 	"CAFE_QUERY_RES":                71,
+	"CAFE_REPLICATE":                79,
+	"CAFE_REPLICATE_ACK":            80,
+	"CAFE_CLIENT_EXPIRING":          81,
 	"CAFE_PUBSUB_QUERY":             102,
 	"CAFE_PUBSUB_QUERY_RES":         103,
 	"ERROR":                         500,
@@ -147,7 +156,7 @@ func (x Message_Type) String() string {
 	return proto.EnumName(Message_Type_name, int32(x))
 }
 func (Message_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_6b993fd146f2feb2, []int{0, 0}
+	return fileDescriptor_message_368b8c8d4adc56a0, []int{0, 0}
 }
 
 type Message struct {
@@ -164,7 +173,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_6b993fd146f2feb2, []int{0}
+	return fileDescriptor_message_368b8c8d4adc56a0, []int{0}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Message.Unmarshal(m, b)
@@ -224,7 +233,7 @@ func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_6b993fd146f2feb2, []int{1}
+	return fileDescriptor_message_368b8c8d4adc56a0, []int{1}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -270,7 +279,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_6b993fd146f2feb2, []int{2}
+	return fileDescriptor_message_368b8c8d4adc56a0, []int{2}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Error.Unmarshal(m, b)
@@ -311,48 +320,50 @@ func init() {
 	proto.RegisterEnum("Message_Type", Message_Type_name, Message_Type_value)
 }
 
-func init() { proto.RegisterFile("message.proto", fileDescriptor_message_6b993fd146f2feb2) }
+func init() { proto.RegisterFile("message.proto", fileDescriptor_message_368b8c8d4adc56a0) }
 
-var fileDescriptor_message_6b993fd146f2feb2 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x5b, 0x6f, 0xda, 0x4c,
-	0x10, 0x86, 0x3f, 0x12, 0x12, 0xf8, 0x86, 0x90, 0x6c, 0x26, 0x27, 0xc2, 0x77, 0x10, 0x41, 0xaa,
-	0xc4, 0x95, 0x23, 0x91, 0xa6, 0xe7, 0x43, 0x8c, 0x99, 0x80, 0x13, 0x63, 0xd3, 0xb5, 0x89, 0x94,
-	0xde, 0x58, 0xd0, 0x38, 0x28, 0x52, 0x8a, 0x29, 0x90, 0xaa, 0xfc, 0x80, 0xfe, 0xdf, 0x5e, 0xf4,
-	0x07, 0x54, 0x1e, 0xe3, 0x95, 0xd3, 0xa4, 0x77, 0x9e, 0xf7, 0x7d, 0xe7, 0x99, 0xdd, 0xb5, 0x34,
-	0x50, 0xfc, 0x1c, 0x4c, 0xa7, 0xfd, 0x61, 0xa0, 0x8d, 0x27, 0xe1, 0x2c, 0x2c, 0xef, 0x0f, 0xc3,
-	0x70, 0x78, 0x1b, 0x1c, 0x72, 0x35, 0xb8, 0xbb, 0x3e, 0xec, 0x8f, 0xe6, 0xb1, 0x55, 0xfd, 0x9e,
-	0x87, 0x5c, 0x27, 0x0e, 0xe3, 0x01, 0x64, 0x67, 0xf3, 0x71, 0x50, 0xca, 0x54, 0x32, 0xb5, 0xf5,
-	0x7a, 0x51, 0x5b, 0xe8, 0x9a, 0x37, 0x1f, 0x07, 0x92, 0x2d, 0xd4, 0x20, 0x37, 0xee, 0xcf, 0x6f,
-	0xc3, 0xfe, 0x55, 0x69, 0xa9, 0x92, 0xa9, 0x15, 0xea, 0xdb, 0x5a, 0xcc, 0xd6, 0x12, 0xb6, 0xa6,
-	0x8f, 0xe6, 0x32, 0x09, 0x61, 0x09, 0x72, 0x93, 0xe0, 0xcb, 0x5d, 0x30, 0x9d, 0x95, 0x96, 0x2b,
-	0x99, 0xda, 0x8a, 0x4c, 0x4a, 0x2c, 0x43, 0x7e, 0x12, 0x4c, 0xc7, 0xe1, 0x68, 0x1a, 0x94, 0xb2,
-	0x95, 0x4c, 0x2d, 0x2f, 0x55, 0x5d, 0xfd, 0xb1, 0x0a, 0xd9, 0x68, 0x28, 0xe6, 0x21, 0xdb, 0x35,
-	0xed, 0x96, 0xf8, 0x8b, 0xbf, 0x1c, 0xbb, 0x25, 0x32, 0xb8, 0x05, 0x1b, 0x5e, 0x5b, 0x92, 0xde,
-	0xf4, 0xc9, 0xbe, 0x20, 0xcb, 0xe9, 0x92, 0x00, 0xdc, 0x83, 0xad, 0xdf, 0x44, 0x5f, 0x37, 0xce,
-	0x45, 0x01, 0x11, 0xd6, 0x0d, 0xfd, 0x94, 0x7c, 0xa3, 0xad, 0x5b, 0x16, 0xd9, 0x2d, 0x12, 0x75,
-	0x5c, 0x07, 0x60, 0xcd, 0x76, 0x6c, 0x83, 0xc4, 0x11, 0xee, 0xc0, 0x26, 0xd7, 0x92, 0x5a, 0xa6,
-	0xeb, 0x49, 0xdd, 0x33, 0x1d, 0x5b, 0x3c, 0x8d, 0x98, 0x2c, 0x37, 0xe9, 0x9e, 0xd1, 0xc6, 0x7f,
-	0x60, 0xef, 0x11, 0x83, 0x07, 0x9a, 0x28, 0x60, 0x8d, 0x4d, 0x97, 0x5c, 0x37, 0x8a, 0x1f, 0x63,
-	0x09, 0xb6, 0x17, 0xf8, 0x53, 0x49, 0x6e, 0x5b, 0x39, 0xcf, 0xd4, 0x41, 0x5c, 0xcf, 0x91, 0x24,
-	0x9e, 0xab, 0xc3, 0x72, 0xcd, 0xbc, 0xd7, 0x8a, 0xd7, 0xb3, 0xe3, 0xd4, 0x19, 0x6e, 0x83, 0x48,
-	0x2b, 0x9c, 0x3b, 0xc7, 0x0d, 0x28, 0xb0, 0xea, 0x34, 0xce, 0xc8, 0xf0, 0xc4, 0x0b, 0x15, 0x8b,
-	0x05, 0xdf, 0x32, 0x5d, 0x4f, 0xbc, 0x54, 0x77, 0x8d, 0x5b, 0xe3, 0x37, 0x13, 0xaf, 0x70, 0x1f,
-	0x76, 0x1e, 0xc8, 0x0c, 0xb6, 0xd4, 0x33, 0x24, 0xe3, 0x16, 0x3d, 0x1d, 0xf5, 0x0c, 0xf7, 0x0d,
-	0xee, 0xb2, 0xd5, 0xa5, 0x9b, 0x64, 0x99, 0x17, 0x24, 0xfd, 0x0e, 0xb9, 0xae, 0xde, 0x22, 0xf1,
-	0x46, 0xf1, 0x8c, 0x36, 0x19, 0xe7, 0x89, 0xee, 0x8a, 0xb7, 0xb8, 0x09, 0x45, 0x36, 0x94, 0xf4,
-	0x2e, 0x4d, 0x21, 0x2f, 0xe5, 0xbc, 0xc7, 0x7f, 0xa1, 0xf4, 0x98, 0xc3, 0xd3, 0x4f, 0x70, 0x17,
-	0x90, 0xdd, 0x4b, 0xa7, 0xe7, 0xb7, 0xf5, 0x0b, 0xf2, 0x3b, 0xba, 0x69, 0x09, 0x5d, 0xdd, 0xbe,
-	0xdb, 0x6b, 0x58, 0xa6, 0xdb, 0xf6, 0xbb, 0x44, 0x52, 0x34, 0xd4, 0xed, 0xd3, 0x32, 0x93, 0x0c,
-	0xf5, 0x8b, 0x3e, 0xf4, 0x48, 0x5e, 0x8a, 0x53, 0xf5, 0x8b, 0xb8, 0xf6, 0x25, 0xb9, 0xa2, 0x95,
-	0xa6, 0xba, 0xbd, 0xc6, 0x22, 0x7a, 0x9d, 0xa6, 0x2a, 0x99, 0x3b, 0x86, 0x08, 0xb0, 0x42, 0x52,
-	0x3a, 0x52, 0xfc, 0x5c, 0xc6, 0xf2, 0xe2, 0xac, 0x86, 0x63, 0x7b, 0xba, 0xe1, 0x2d, 0xda, 0x9b,
-	0xe5, 0xa5, 0x7c, 0x06, 0xff, 0x87, 0xdd, 0x87, 0x1e, 0x33, 0x88, 0xfd, 0x03, 0xd8, 0x4f, 0x8f,
-	0xb8, 0x8f, 0xb8, 0xe2, 0xc8, 0x13, 0xf8, 0xef, 0x8f, 0x11, 0x26, 0x05, 0x51, 0xac, 0x7a, 0x02,
-	0x79, 0x1a, 0x7d, 0x0d, 0x6e, 0xc3, 0x71, 0x80, 0x55, 0xc8, 0x2d, 0xf6, 0x07, 0xaf, 0x82, 0x42,
-	0x3d, 0x9f, 0xac, 0x02, 0x99, 0x18, 0x28, 0x60, 0x79, 0x7a, 0x33, 0xe4, 0x25, 0xb0, 0x26, 0xa3,
-	0xcf, 0xea, 0x31, 0xac, 0xd0, 0x64, 0x12, 0x4e, 0x10, 0x21, 0xfb, 0x29, 0xbc, 0x8a, 0x7b, 0x8b,
-	0x92, 0xbf, 0xa3, 0x3d, 0x90, 0x20, 0xa3, 0x96, 0xbf, 0x15, 0xa8, 0xb1, 0x05, 0xc5, 0x9b, 0x50,
-	0x9b, 0x05, 0xdf, 0x66, 0x37, 0xd1, 0x16, 0x19, 0x7c, 0x5c, 0x1a, 0x0f, 0x06, 0xab, 0xbc, 0x4d,
-	0x8e, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x25, 0xb0, 0x61, 0x52, 0xc8, 0x04, 0x00, 0x00,
+var fileDescriptor_message_368b8c8d4adc56a0 = []byte{
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x6b, 0x4f, 0xda, 0x60,
+	0x14, 0x1e, 0x8a, 0x83, 0x1d, 0x44, 0x5f, 0x8f, 0x37, 0x64, 0x97, 0x20, 0xc9, 0x12, 0x3e, 0xd5,
+	0x04, 0xe7, 0xee, 0x17, 0x4b, 0x39, 0x42, 0xb5, 0xb4, 0xf8, 0xb6, 0x98, 0xb9, 0x2f, 0x0d, 0xcc,
+	0x4a, 0x4c, 0x1c, 0x65, 0x80, 0xcb, 0xf8, 0x63, 0xfb, 0x13, 0xfb, 0x3b, 0xfb, 0x01, 0x4b, 0x4f,
+	0xe1, 0x4d, 0x9d, 0xee, 0xdb, 0x7b, 0x9e, 0xe7, 0x39, 0xcf, 0xb9, 0xb4, 0x39, 0x90, 0xff, 0x16,
+	0x8c, 0xc7, 0xdd, 0x7e, 0xa0, 0x0d, 0x47, 0xe1, 0x24, 0x2c, 0xee, 0xf4, 0xc3, 0xb0, 0x7f, 0x1d,
+	0xec, 0x71, 0xd4, 0xbb, 0xb9, 0xdc, 0xeb, 0x0e, 0xa6, 0x31, 0x55, 0xfe, 0x9d, 0x85, 0x4c, 0x2b,
+	0x16, 0xe3, 0x2e, 0xa4, 0x27, 0xd3, 0x61, 0x50, 0x48, 0x95, 0x52, 0x95, 0x95, 0x6a, 0x5e, 0x9b,
+	0xe1, 0x9a, 0x37, 0x1d, 0x06, 0x92, 0x29, 0xd4, 0x20, 0x33, 0xec, 0x4e, 0xaf, 0xc3, 0xee, 0x45,
+	0x61, 0xa1, 0x94, 0xaa, 0xe4, 0xaa, 0x1b, 0x5a, 0xec, 0xad, 0xcd, 0xbd, 0x35, 0x7d, 0x30, 0x95,
+	0x73, 0x11, 0x16, 0x20, 0x33, 0x0a, 0xbe, 0xdf, 0x04, 0xe3, 0x49, 0x61, 0xb1, 0x94, 0xaa, 0x2c,
+	0xc9, 0x79, 0x88, 0x45, 0xc8, 0x8e, 0x82, 0xf1, 0x30, 0x1c, 0x8c, 0x83, 0x42, 0xba, 0x94, 0xaa,
+	0x64, 0xa5, 0x8a, 0xcb, 0xbf, 0x32, 0x90, 0x8e, 0x8a, 0x62, 0x16, 0xd2, 0x6d, 0xd3, 0x6e, 0x88,
+	0x07, 0xfc, 0x72, 0xec, 0x86, 0x48, 0xe1, 0x3a, 0xac, 0x7a, 0x4d, 0x49, 0x7a, 0xdd, 0x27, 0xfb,
+	0x8c, 0x2c, 0xa7, 0x4d, 0x02, 0x70, 0x1b, 0xd6, 0xff, 0x01, 0x7d, 0xdd, 0x38, 0x11, 0x39, 0x44,
+	0x58, 0x31, 0xf4, 0x23, 0xf2, 0x8d, 0xa6, 0x6e, 0x59, 0x64, 0x37, 0x48, 0x54, 0x71, 0x05, 0x80,
+	0x31, 0xdb, 0xb1, 0x0d, 0x12, 0xfb, 0xb8, 0x09, 0x6b, 0x1c, 0x4b, 0x6a, 0x98, 0xae, 0x27, 0x75,
+	0xcf, 0x74, 0x6c, 0xf1, 0x22, 0xf2, 0x64, 0xb8, 0x4e, 0xb7, 0x88, 0x26, 0x3e, 0x86, 0xed, 0x7b,
+	0x08, 0x2e, 0x68, 0xa2, 0x80, 0x65, 0x26, 0x5d, 0x72, 0xdd, 0x48, 0x7e, 0x80, 0x05, 0xd8, 0x98,
+	0xd9, 0x1f, 0x49, 0x72, 0x9b, 0x8a, 0x79, 0xa9, 0x1a, 0x71, 0x3d, 0x47, 0x92, 0x78, 0xa5, 0x9a,
+	0xe5, 0x98, 0xfd, 0xde, 0x29, 0xbf, 0x8e, 0x1d, 0xab, 0x8e, 0x71, 0x03, 0x44, 0x12, 0x61, 0xdd,
+	0x09, 0xae, 0x42, 0x8e, 0x51, 0xa7, 0x76, 0x4c, 0x86, 0x27, 0x5e, 0x2b, 0x59, 0x0c, 0xf8, 0x96,
+	0xe9, 0x7a, 0xe2, 0x8d, 0x9a, 0x35, 0x4e, 0x8d, 0x77, 0x26, 0xde, 0xe2, 0x0e, 0x6c, 0xde, 0x81,
+	0xd9, 0xd8, 0x52, 0x6b, 0xe8, 0xd8, 0x49, 0x52, 0xb4, 0xd4, 0x1a, 0x3a, 0xf6, 0x9d, 0x2c, 0x5b,
+	0x0d, 0x5d, 0x27, 0xcb, 0x3c, 0x23, 0xe9, 0xb7, 0xc8, 0x75, 0xf5, 0x06, 0x89, 0xf7, 0xca, 0xcf,
+	0x68, 0x92, 0x71, 0x32, 0xc7, 0x5d, 0xf1, 0x01, 0xd7, 0x20, 0xcf, 0x84, 0x82, 0x3e, 0x26, 0x5d,
+	0xc8, 0x4b, 0x30, 0x9f, 0xf0, 0x09, 0x14, 0xee, 0x63, 0xb8, 0xfa, 0x21, 0x6e, 0x01, 0x32, 0x7b,
+	0xee, 0x74, 0xfc, 0xa6, 0x7e, 0x46, 0x7e, 0x4b, 0x37, 0x2d, 0xa1, 0xab, 0xe9, 0xdb, 0x9d, 0x9a,
+	0x65, 0xba, 0x4d, 0xbf, 0x4d, 0x24, 0x45, 0x4d, 0x4d, 0x9f, 0x84, 0xd9, 0xc9, 0x50, 0x9f, 0xe8,
+	0xb4, 0x43, 0xf2, 0x5c, 0x1c, 0xa9, 0x4f, 0xc4, 0xb1, 0x2f, 0xc9, 0x15, 0x0d, 0x85, 0x49, 0x6a,
+	0x5b, 0xa6, 0xa1, 0x7b, 0x24, 0x1c, 0xd5, 0x81, 0xc2, 0xd8, 0xaf, 0xad, 0x26, 0x32, 0x2c, 0x93,
+	0x6c, 0xcf, 0xa7, 0xcf, 0x6d, 0x53, 0x46, 0x7f, 0xf8, 0x69, 0xb2, 0x37, 0xb7, 0x53, 0x9b, 0x15,
+	0xbc, 0x4c, 0xf6, 0xa6, 0x60, 0xae, 0xdb, 0x47, 0x80, 0x25, 0x92, 0xd2, 0x91, 0xe2, 0xcf, 0x22,
+	0x16, 0x67, 0xf5, 0x0c, 0xc7, 0xf6, 0x74, 0xc3, 0x9b, 0xa5, 0xd7, 0x8b, 0x0b, 0xd9, 0x14, 0x3e,
+	0x83, 0xad, 0xbb, 0x1c, 0x7b, 0x10, 0xf3, 0xbb, 0xb0, 0x93, 0x2c, 0x71, 0xdb, 0xe2, 0x82, 0x25,
+	0xcf, 0xe1, 0xe9, 0x7f, 0x25, 0xec, 0x14, 0x44, 0xb2, 0xf2, 0x21, 0x64, 0x69, 0xf0, 0x23, 0xb8,
+	0x0e, 0x87, 0x01, 0x96, 0x21, 0x33, 0xbb, 0x42, 0x7c, 0x50, 0x72, 0xd5, 0xec, 0xfc, 0xa0, 0xc8,
+	0x39, 0x81, 0x02, 0x16, 0xc7, 0x57, 0x7d, 0x3e, 0x25, 0xcb, 0x32, 0x7a, 0x96, 0x0f, 0x60, 0x89,
+	0x46, 0xa3, 0x70, 0x84, 0x08, 0xe9, 0xaf, 0xe1, 0x45, 0x9c, 0x9b, 0x97, 0xfc, 0x8e, 0xae, 0xc9,
+	0xdc, 0x32, 0x4a, 0x79, 0xa4, 0x8c, 0x6a, 0xeb, 0x90, 0xbf, 0x0a, 0xb5, 0x49, 0xf0, 0x73, 0x72,
+	0x15, 0xdd, 0xa2, 0xde, 0x97, 0x85, 0x61, 0xaf, 0xf7, 0x90, 0x6f, 0xd2, 0xfe, 0xdf, 0x01, 0x00,
+	0x3e, 0x85, 0xda, 0xfd, 0x0e, 0x05, 0x00, 0x00,
 }
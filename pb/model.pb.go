@@ -47,7 +47,7 @@ func (x Thread_Type) String() string {
 	return proto.EnumName(Thread_Type_name, int32(x))
 }
 func (Thread_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{5, 0}
+	return fileDescriptor_model_19ebed94e7761565, []int{5, 0}
 }
 
 // Sharing controls if (Y/N) a thread can be shared
@@ -74,7 +74,7 @@ func (x Thread_Sharing) String() string {
 	return proto.EnumName(Thread_Sharing_name, int32(x))
 }
 func (Thread_Sharing) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{5, 1}
+	return fileDescriptor_model_19ebed94e7761565, []int{5, 1}
 }
 
 // State indicates the loading state
@@ -101,23 +101,88 @@ func (x Thread_State) String() string {
 	return proto.EnumName(Thread_State_name, int32(x))
 }
 func (Thread_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{5, 2}
+	return fileDescriptor_model_19ebed94e7761565, []int{5, 2}
+}
+
+// Role extends the access granted by the thread type, each role includes those below it
+type ThreadMember_Role int32
+
+const (
+	ThreadMember_DEFAULT   ThreadMember_Role = 0
+	ThreadMember_READER    ThreadMember_Role = 1
+	ThreadMember_ANNOTATOR ThreadMember_Role = 2
+	ThreadMember_WRITER    ThreadMember_Role = 3
+	ThreadMember_ADMIN     ThreadMember_Role = 4
+)
+
+var ThreadMember_Role_name = map[int32]string{
+	0: "DEFAULT",
+	1: "READER",
+	2: "ANNOTATOR",
+	3: "WRITER",
+	4: "ADMIN",
+}
+var ThreadMember_Role_value = map[string]int32{
+	"DEFAULT":   0,
+	"READER":    1,
+	"ANNOTATOR": 2,
+	"WRITER":    3,
+	"ADMIN":     4,
+}
+
+func (x ThreadMember_Role) String() string {
+	return proto.EnumName(ThreadMember_Role_name, int32(x))
+}
+func (ThreadMember_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{12, 0}
+}
+
+type ThreadMemberChange_Membership int32
+
+const (
+	ThreadMemberChange_KEPT    ThreadMemberChange_Membership = 0
+	ThreadMemberChange_ADDED   ThreadMemberChange_Membership = 1
+	ThreadMemberChange_REMOVED ThreadMemberChange_Membership = 2
+)
+
+var ThreadMemberChange_Membership_name = map[int32]string{
+	0: "KEPT",
+	1: "ADDED",
+	2: "REMOVED",
+}
+var ThreadMemberChange_Membership_value = map[string]int32{
+	"KEPT":    0,
+	"ADDED":   1,
+	"REMOVED": 2,
+}
+
+func (x ThreadMemberChange_Membership) String() string {
+	return proto.EnumName(ThreadMemberChange_Membership_name, int32(x))
+}
+func (ThreadMemberChange_Membership) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{13, 0}
 }
 
 type Block_BlockType int32
 
 const (
-	Block_MERGE    Block_BlockType = 0 // Deprecated: Do not use.
-	Block_IGNORE   Block_BlockType = 1
-	Block_FLAG     Block_BlockType = 2
-	Block_JOIN     Block_BlockType = 3
-	Block_ANNOUNCE Block_BlockType = 4
-	Block_LEAVE    Block_BlockType = 5
-	Block_TEXT     Block_BlockType = 6
-	Block_FILES    Block_BlockType = 7
-	Block_COMMENT  Block_BlockType = 8 // Deprecated: Do not use.
-	Block_LIKE     Block_BlockType = 9
-	Block_ADD      Block_BlockType = 50
+	Block_MERGE     Block_BlockType = 0 // Deprecated: Do not use.
+	Block_IGNORE    Block_BlockType = 1
+	Block_FLAG      Block_BlockType = 2
+	Block_JOIN      Block_BlockType = 3
+	Block_ANNOUNCE  Block_BlockType = 4
+	Block_LEAVE     Block_BlockType = 5
+	Block_TEXT      Block_BlockType = 6
+	Block_FILES     Block_BlockType = 7
+	Block_COMMENT   Block_BlockType = 8 // Deprecated: Do not use.
+	Block_LIKE      Block_BlockType = 9
+	Block_MEMBER    Block_BlockType = 10
+	Block_KEY       Block_BlockType = 11
+	Block_EDIT      Block_BlockType = 12
+	Block_REACTION  Block_BlockType = 13
+	Block_RETENTION Block_BlockType = 14
+	Block_SCHEMA    Block_BlockType = 15
+	Block_ADD       Block_BlockType = 50
 )
 
 var Block_BlockType_name = map[int32]string{
@@ -131,97 +196,177 @@ var Block_BlockType_name = map[int32]string{
 	7:  "FILES",
 	8:  "COMMENT",
 	9:  "LIKE",
+	10: "MEMBER",
+	11: "KEY",
+	12: "EDIT",
+	13: "REACTION",
+	14: "RETENTION",
+	15: "SCHEMA",
 	50: "ADD",
 }
 var Block_BlockType_value = map[string]int32{
-	"MERGE":    0,
-	"IGNORE":   1,
-	"FLAG":     2,
-	"JOIN":     3,
-	"ANNOUNCE": 4,
-	"LEAVE":    5,
-	"TEXT":     6,
-	"FILES":    7,
-	"COMMENT":  8,
-	"LIKE":     9,
-	"ADD":      50,
+	"MERGE":     0,
+	"IGNORE":    1,
+	"FLAG":      2,
+	"JOIN":      3,
+	"ANNOUNCE":  4,
+	"LEAVE":     5,
+	"TEXT":      6,
+	"FILES":     7,
+	"COMMENT":   8,
+	"LIKE":      9,
+	"MEMBER":    10,
+	"KEY":       11,
+	"EDIT":      12,
+	"REACTION":  13,
+	"RETENTION": 14,
+	"SCHEMA":    15,
+	"ADD":       50,
 }
 
 func (x Block_BlockType) String() string {
 	return proto.EnumName(Block_BlockType_name, int32(x))
 }
 func (Block_BlockType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{8, 0}
+	return fileDescriptor_model_19ebed94e7761565, []int{21, 0}
 }
 
 type Block_BlockStatus int32
 
 const (
-	Block_READY   Block_BlockStatus = 0
-	Block_QUEUED  Block_BlockStatus = 1
-	Block_PENDING Block_BlockStatus = 2
+	Block_READY    Block_BlockStatus = 0
+	Block_QUEUED   Block_BlockStatus = 1
+	Block_PENDING  Block_BlockStatus = 2
+	Block_DEFERRED Block_BlockStatus = 3
 )
 
 var Block_BlockStatus_name = map[int32]string{
 	0: "READY",
 	1: "QUEUED",
 	2: "PENDING",
+	3: "DEFERRED",
 }
 var Block_BlockStatus_value = map[string]int32{
-	"READY":   0,
-	"QUEUED":  1,
-	"PENDING": 2,
+	"READY":    0,
+	"QUEUED":   1,
+	"PENDING":  2,
+	"DEFERRED": 3,
 }
 
 func (x Block_BlockStatus) String() string {
 	return proto.EnumName(Block_BlockStatus_name, int32(x))
 }
 func (Block_BlockStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{8, 1}
+	return fileDescriptor_model_19ebed94e7761565, []int{21, 1}
+}
+
+type Block_BlockSort int32
+
+const (
+	Block_DATE   Block_BlockSort = 0
+	Block_CAUSAL Block_BlockSort = 1
+)
+
+var Block_BlockSort_name = map[int32]string{
+	0: "DATE",
+	1: "CAUSAL",
+}
+var Block_BlockSort_value = map[string]int32{
+	"DATE":   0,
+	"CAUSAL": 1,
+}
+
+func (x Block_BlockSort) String() string {
+	return proto.EnumName(Block_BlockSort_name, int32(x))
+}
+func (Block_BlockSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{21, 2}
+}
+
+// Encryption indicates how content is encrypted when a key is present
+type FileIndex_Encryption int32
+
+const (
+	FileIndex_AES_GCM        FileIndex_Encryption = 0
+	FileIndex_AES_GCM_STREAM FileIndex_Encryption = 1
+	FileIndex_AES_GCM_CHUNKS FileIndex_Encryption = 2
+)
+
+var FileIndex_Encryption_name = map[int32]string{
+	0: "AES_GCM",
+	1: "AES_GCM_STREAM",
+	2: "AES_GCM_CHUNKS",
+}
+var FileIndex_Encryption_value = map[string]int32{
+	"AES_GCM":        0,
+	"AES_GCM_STREAM": 1,
+	"AES_GCM_CHUNKS": 2,
+}
+
+func (x FileIndex_Encryption) String() string {
+	return proto.EnumName(FileIndex_Encryption_name, int32(x))
+}
+func (FileIndex_Encryption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{26, 0}
 }
 
 type Notification_Type int32
 
 const (
-	Notification_INVITE_RECEIVED     Notification_Type = 0
-	Notification_ACCOUNT_PEER_JOINED Notification_Type = 1
-	Notification_ACCOUNT_PEER_LEFT   Notification_Type = 8
-	Notification_PEER_JOINED         Notification_Type = 2
-	Notification_PEER_LEFT           Notification_Type = 3
-	Notification_MESSAGE_ADDED       Notification_Type = 4
-	Notification_FILES_ADDED         Notification_Type = 5
-	Notification_COMMENT_ADDED       Notification_Type = 6
-	Notification_LIKE_ADDED          Notification_Type = 7
+	Notification_INVITE_RECEIVED      Notification_Type = 0
+	Notification_ACCOUNT_PEER_JOINED  Notification_Type = 1
+	Notification_ACCOUNT_PEER_LEFT    Notification_Type = 8
+	Notification_PEER_JOINED          Notification_Type = 2
+	Notification_PEER_LEFT            Notification_Type = 3
+	Notification_MESSAGE_ADDED        Notification_Type = 4
+	Notification_FILES_ADDED          Notification_Type = 5
+	Notification_COMMENT_ADDED        Notification_Type = 6
+	Notification_LIKE_ADDED           Notification_Type = 7
+	Notification_EDIT_ADDED           Notification_Type = 9
+	Notification_REACTION_ADDED       Notification_Type = 10
+	Notification_MENTION_RECEIVED     Notification_Type = 11
+	Notification_CAFE_CLIENT_EXPIRING Notification_Type = 12
+	Notification_CAFE_QUOTA_EXCEEDED  Notification_Type = 13
 )
 
 var Notification_Type_name = map[int32]string{
-	0: "INVITE_RECEIVED",
-	1: "ACCOUNT_PEER_JOINED",
-	8: "ACCOUNT_PEER_LEFT",
-	2: "PEER_JOINED",
-	3: "PEER_LEFT",
-	4: "MESSAGE_ADDED",
-	5: "FILES_ADDED",
-	6: "COMMENT_ADDED",
-	7: "LIKE_ADDED",
+	0:  "INVITE_RECEIVED",
+	1:  "ACCOUNT_PEER_JOINED",
+	8:  "ACCOUNT_PEER_LEFT",
+	2:  "PEER_JOINED",
+	3:  "PEER_LEFT",
+	4:  "MESSAGE_ADDED",
+	5:  "FILES_ADDED",
+	6:  "COMMENT_ADDED",
+	7:  "LIKE_ADDED",
+	9:  "EDIT_ADDED",
+	10: "REACTION_ADDED",
+	11: "MENTION_RECEIVED",
+	12: "CAFE_CLIENT_EXPIRING",
+	13: "CAFE_QUOTA_EXCEEDED",
 }
 var Notification_Type_value = map[string]int32{
-	"INVITE_RECEIVED":     0,
-	"ACCOUNT_PEER_JOINED": 1,
-	"ACCOUNT_PEER_LEFT":   8,
-	"PEER_JOINED":         2,
-	"PEER_LEFT":           3,
-	"MESSAGE_ADDED":       4,
-	"FILES_ADDED":         5,
-	"COMMENT_ADDED":       6,
-	"LIKE_ADDED":          7,
+	"INVITE_RECEIVED":      0,
+	"ACCOUNT_PEER_JOINED":  1,
+	"ACCOUNT_PEER_LEFT":    8,
+	"PEER_JOINED":          2,
+	"PEER_LEFT":            3,
+	"MESSAGE_ADDED":        4,
+	"FILES_ADDED":          5,
+	"COMMENT_ADDED":        6,
+	"LIKE_ADDED":           7,
+	"EDIT_ADDED":           9,
+	"REACTION_ADDED":       10,
+	"MENTION_RECEIVED":     11,
+	"CAFE_CLIENT_EXPIRING": 12,
+	"CAFE_QUOTA_EXCEEDED":  13,
 }
 
 func (x Notification_Type) String() string {
 	return proto.EnumName(Notification_Type_name, int32(x))
 }
 func (Notification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{16, 0}
+	return fileDescriptor_model_19ebed94e7761565, []int{32, 0}
 }
 
 type CafeRequest_Type int32
@@ -253,7 +398,7 @@ func (x CafeRequest_Type) String() string {
 	return proto.EnumName(CafeRequest_Type_name, int32(x))
 }
 func (CafeRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{21, 0}
+	return fileDescriptor_model_19ebed94e7761565, []int{39, 0}
 }
 
 type CafeRequest_Status int32
@@ -279,7 +424,7 @@ func (x CafeRequest_Status) String() string {
 	return proto.EnumName(CafeRequest_Status_name, int32(x))
 }
 func (CafeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{21, 1}
+	return fileDescriptor_model_19ebed94e7761565, []int{39, 1}
 }
 
 type CafeHTTPRequest_Type int32
@@ -305,7 +450,106 @@ func (x CafeHTTPRequest_Type) String() string {
 	return proto.EnumName(CafeHTTPRequest_Type_name, int32(x))
 }
 func (CafeHTTPRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{24, 0}
+	return fileDescriptor_model_19ebed94e7761565, []int{42, 0}
+}
+
+type CafeClientGC_Action int32
+
+const (
+	CafeClientGC_SCHEDULE CafeClientGC_Action = 0
+	CafeClientGC_PENDING  CafeClientGC_Action = 1
+	CafeClientGC_CANCEL   CafeClientGC_Action = 2
+	CafeClientGC_DELETE   CafeClientGC_Action = 3
+)
+
+var CafeClientGC_Action_name = map[int32]string{
+	0: "SCHEDULE",
+	1: "PENDING",
+	2: "CANCEL",
+	3: "DELETE",
+}
+var CafeClientGC_Action_value = map[string]int32{
+	"SCHEDULE": 0,
+	"PENDING":  1,
+	"CANCEL":   2,
+	"DELETE":   3,
+}
+
+func (x CafeClientGC_Action) String() string {
+	return proto.EnumName(CafeClientGC_Action_name, int32(x))
+}
+func (CafeClientGC_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{47, 0}
+}
+
+type CafeToken_Scope int32
+
+const (
+	CafeToken_STORE   CafeToken_Scope = 0
+	CafeToken_INBOX   CafeToken_Scope = 1
+	CafeToken_THREADS CafeToken_Scope = 2
+	CafeToken_SEARCH  CafeToken_Scope = 3
+)
+
+var CafeToken_Scope_name = map[int32]string{
+	0: "STORE",
+	1: "INBOX",
+	2: "THREADS",
+	3: "SEARCH",
+}
+var CafeToken_Scope_value = map[string]int32{
+	"STORE":   0,
+	"INBOX":   1,
+	"THREADS": 2,
+	"SEARCH":  3,
+}
+
+func (x CafeToken_Scope) String() string {
+	return proto.EnumName(CafeToken_Scope_name, int32(x))
+}
+func (CafeToken_Scope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{49, 0}
+}
+
+type CafeReplication_Type int32
+
+const (
+	CafeReplication_CLIENT         CafeReplication_Type = 0
+	CafeReplication_REMOVE_CLIENT  CafeReplication_Type = 1
+	CafeReplication_STORE          CafeReplication_Type = 2
+	CafeReplication_UNSTORE        CafeReplication_Type = 3
+	CafeReplication_STORE_THREAD   CafeReplication_Type = 4
+	CafeReplication_UNSTORE_THREAD CafeReplication_Type = 5
+	CafeReplication_MESSAGE        CafeReplication_Type = 6
+	CafeReplication_DELETE_MESSAGE CafeReplication_Type = 7
+)
+
+var CafeReplication_Type_name = map[int32]string{
+	0: "CLIENT",
+	1: "REMOVE_CLIENT",
+	2: "STORE",
+	3: "UNSTORE",
+	4: "STORE_THREAD",
+	5: "UNSTORE_THREAD",
+	6: "MESSAGE",
+	7: "DELETE_MESSAGE",
+}
+var CafeReplication_Type_value = map[string]int32{
+	"CLIENT":         0,
+	"REMOVE_CLIENT":  1,
+	"STORE":          2,
+	"UNSTORE":        3,
+	"STORE_THREAD":   4,
+	"UNSTORE_THREAD": 5,
+	"MESSAGE":        6,
+	"DELETE_MESSAGE": 7,
+}
+
+func (x CafeReplication_Type) String() string {
+	return proto.EnumName(CafeReplication_Type_name, int32(x))
+}
+func (CafeReplication_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{54, 0}
 }
 
 type Peer struct {
@@ -325,7 +569,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{0}
+	return fileDescriptor_model_19ebed94e7761565, []int{0}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -405,7 +649,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{1}
+	return fileDescriptor_model_19ebed94e7761565, []int{1}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -445,7 +689,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{2}
+	return fileDescriptor_model_19ebed94e7761565, []int{2}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_User.Unmarshal(m, b)
@@ -501,7 +745,7 @@ func (m *Contact) Reset()         { *m = Contact{} }
 func (m *Contact) String() string { return proto.CompactTextString(m) }
 func (*Contact) ProtoMessage()    {}
 func (*Contact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{3}
+	return fileDescriptor_model_19ebed94e7761565, []int{3}
 }
 func (m *Contact) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Contact.Unmarshal(m, b)
@@ -567,7 +811,7 @@ func (m *ContactList) Reset()         { *m = ContactList{} }
 func (m *ContactList) String() string { return proto.CompactTextString(m) }
 func (*ContactList) ProtoMessage()    {}
 func (*ContactList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{4}
+	return fileDescriptor_model_19ebed94e7761565, []int{4}
 }
 func (m *ContactList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContactList.Unmarshal(m, b)
@@ -606,6 +850,7 @@ type Thread struct {
 	Whitelist []string       `protobuf:"bytes,9,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
 	State     Thread_State   `protobuf:"varint,10,opt,name=state,proto3,enum=Thread_State" json:"state,omitempty"` // Deprecated: Do not use.
 	Head      string         `protobuf:"bytes,11,opt,name=head,proto3" json:"head,omitempty"`
+	Keys      []*ThreadKey   `protobuf:"bytes,12,rep,name=keys,proto3" json:"keys,omitempty"`
 	// view info
 	HeadBlocks           []*Block `protobuf:"bytes,101,rep,name=head_blocks,json=headBlocks,proto3" json:"head_blocks,omitempty"`
 	SchemaNode           *Node    `protobuf:"bytes,102,opt,name=schema_node,json=schemaNode,proto3" json:"schema_node,omitempty"`
@@ -620,7 +865,7 @@ func (m *Thread) Reset()         { *m = Thread{} }
 func (m *Thread) String() string { return proto.CompactTextString(m) }
 func (*Thread) ProtoMessage()    {}
 func (*Thread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{5}
+	return fileDescriptor_model_19ebed94e7761565, []int{5}
 }
 func (m *Thread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Thread.Unmarshal(m, b)
@@ -718,6 +963,13 @@ func (m *Thread) GetHead() string {
 	return ""
 }
 
+func (m *Thread) GetKeys() []*ThreadKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 func (m *Thread) GetHeadBlocks() []*Block {
 	if m != nil {
 		return m.HeadBlocks
@@ -757,7 +1009,7 @@ func (m *ThreadList) Reset()         { *m = ThreadList{} }
 func (m *ThreadList) String() string { return proto.CompactTextString(m) }
 func (*ThreadList) ProtoMessage()    {}
 func (*ThreadList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{6}
+	return fileDescriptor_model_19ebed94e7761565, []int{6}
 }
 func (m *ThreadList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadList.Unmarshal(m, b)
@@ -797,7 +1049,7 @@ func (m *ThreadPeer) Reset()         { *m = ThreadPeer{} }
 func (m *ThreadPeer) String() string { return proto.CompactTextString(m) }
 func (*ThreadPeer) ProtoMessage()    {}
 func (*ThreadPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{7}
+	return fileDescriptor_model_19ebed94e7761565, []int{7}
 }
 func (m *ThreadPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadPeer.Unmarshal(m, b)
@@ -838,250 +1090,1002 @@ func (m *ThreadPeer) GetWelcomed() bool {
 	return false
 }
 
-type Block struct {
-	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Thread   string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
-	Author   string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Type     Block_BlockType      `protobuf:"varint,4,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
-	Date     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
-	Parents  []string             `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
-	Target   string               `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
-	Data     string               `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
-	Body     string               `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
-	Status   Block_BlockStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=Block_BlockStatus" json:"status,omitempty"`
-	Attempts int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
-	// view info
-	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ThreadSchema struct {
+	Thread               string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Version              int32                `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Hash                 string               `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Block) Reset()         { *m = Block{} }
-func (m *Block) String() string { return proto.CompactTextString(m) }
-func (*Block) ProtoMessage()    {}
-func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{8}
+func (m *ThreadSchema) Reset()         { *m = ThreadSchema{} }
+func (m *ThreadSchema) String() string { return proto.CompactTextString(m) }
+func (*ThreadSchema) ProtoMessage()    {}
+func (*ThreadSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{8}
 }
-func (m *Block) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block.Unmarshal(m, b)
+func (m *ThreadSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadSchema.Unmarshal(m, b)
 }
-func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+func (m *ThreadSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadSchema.Marshal(b, m, deterministic)
 }
-func (dst *Block) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block.Merge(dst, src)
+func (dst *ThreadSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadSchema.Merge(dst, src)
 }
-func (m *Block) XXX_Size() int {
-	return xxx_messageInfo_Block.Size(m)
+func (m *ThreadSchema) XXX_Size() int {
+	return xxx_messageInfo_ThreadSchema.Size(m)
 }
-func (m *Block) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block.DiscardUnknown(m)
+func (m *ThreadSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadSchema.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block proto.InternalMessageInfo
-
-func (m *Block) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_ThreadSchema proto.InternalMessageInfo
 
-func (m *Block) GetThread() string {
+func (m *ThreadSchema) GetThread() string {
 	if m != nil {
 		return m.Thread
 	}
 	return ""
 }
 
-func (m *Block) GetAuthor() string {
+func (m *ThreadSchema) GetVersion() int32 {
 	if m != nil {
-		return m.Author
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *Block) GetType() Block_BlockType {
+func (m *ThreadSchema) GetHash() string {
 	if m != nil {
-		return m.Type
+		return m.Hash
 	}
-	return Block_MERGE
+	return ""
 }
 
-func (m *Block) GetDate() *timestamp.Timestamp {
+func (m *ThreadSchema) GetDate() *timestamp.Timestamp {
 	if m != nil {
 		return m.Date
 	}
 	return nil
 }
 
-func (m *Block) GetParents() []string {
+type ThreadSchemaList struct {
+	Items                []*ThreadSchema `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ThreadSchemaList) Reset()         { *m = ThreadSchemaList{} }
+func (m *ThreadSchemaList) String() string { return proto.CompactTextString(m) }
+func (*ThreadSchemaList) ProtoMessage()    {}
+func (*ThreadSchemaList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{9}
+}
+func (m *ThreadSchemaList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadSchemaList.Unmarshal(m, b)
+}
+func (m *ThreadSchemaList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadSchemaList.Marshal(b, m, deterministic)
+}
+func (dst *ThreadSchemaList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadSchemaList.Merge(dst, src)
+}
+func (m *ThreadSchemaList) XXX_Size() int {
+	return xxx_messageInfo_ThreadSchemaList.Size(m)
+}
+func (m *ThreadSchemaList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadSchemaList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadSchemaList proto.InternalMessageInfo
+
+func (m *ThreadSchemaList) GetItems() []*ThreadSchema {
 	if m != nil {
-		return m.Parents
+		return m.Items
 	}
 	return nil
 }
 
-func (m *Block) GetTarget() string {
-	if m != nil {
-		return m.Target
-	}
-	return ""
+type ThreadKey struct {
+	Thread               string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Epoch                int32                `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Sk                   []byte               `protobuf:"bytes,3,opt,name=sk,proto3" json:"sk,omitempty"`
+	Block                string               `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Block) GetData() string {
+func (m *ThreadKey) Reset()         { *m = ThreadKey{} }
+func (m *ThreadKey) String() string { return proto.CompactTextString(m) }
+func (*ThreadKey) ProtoMessage()    {}
+func (*ThreadKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{10}
+}
+func (m *ThreadKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadKey.Unmarshal(m, b)
+}
+func (m *ThreadKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadKey.Marshal(b, m, deterministic)
+}
+func (dst *ThreadKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadKey.Merge(dst, src)
+}
+func (m *ThreadKey) XXX_Size() int {
+	return xxx_messageInfo_ThreadKey.Size(m)
+}
+func (m *ThreadKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadKey proto.InternalMessageInfo
+
+func (m *ThreadKey) GetThread() string {
 	if m != nil {
-		return m.Data
+		return m.Thread
 	}
 	return ""
 }
 
-func (m *Block) GetBody() string {
+func (m *ThreadKey) GetEpoch() int32 {
 	if m != nil {
-		return m.Body
+		return m.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (m *Block) GetStatus() Block_BlockStatus {
+func (m *ThreadKey) GetSk() []byte {
 	if m != nil {
-		return m.Status
+		return m.Sk
 	}
-	return Block_READY
+	return nil
 }
 
-func (m *Block) GetAttempts() int32 {
+func (m *ThreadKey) GetBlock() string {
 	if m != nil {
-		return m.Attempts
+		return m.Block
 	}
-	return 0
+	return ""
 }
 
-func (m *Block) GetUser() *User {
+func (m *ThreadKey) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.User
+		return m.Date
 	}
 	return nil
 }
 
-type BlockList struct {
-	Items                []*Block `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ThreadKeyList struct {
+	Items                []*ThreadKey `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *BlockList) Reset()         { *m = BlockList{} }
-func (m *BlockList) String() string { return proto.CompactTextString(m) }
-func (*BlockList) ProtoMessage()    {}
-func (*BlockList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{9}
+func (m *ThreadKeyList) Reset()         { *m = ThreadKeyList{} }
+func (m *ThreadKeyList) String() string { return proto.CompactTextString(m) }
+func (*ThreadKeyList) ProtoMessage()    {}
+func (*ThreadKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{11}
 }
-func (m *BlockList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockList.Unmarshal(m, b)
+func (m *ThreadKeyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadKeyList.Unmarshal(m, b)
 }
-func (m *BlockList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockList.Marshal(b, m, deterministic)
+func (m *ThreadKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadKeyList.Marshal(b, m, deterministic)
 }
-func (dst *BlockList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockList.Merge(dst, src)
+func (dst *ThreadKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadKeyList.Merge(dst, src)
 }
-func (m *BlockList) XXX_Size() int {
-	return xxx_messageInfo_BlockList.Size(m)
+func (m *ThreadKeyList) XXX_Size() int {
+	return xxx_messageInfo_ThreadKeyList.Size(m)
 }
-func (m *BlockList) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockList.DiscardUnknown(m)
+func (m *ThreadKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadKeyList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockList proto.InternalMessageInfo
+var xxx_messageInfo_ThreadKeyList proto.InternalMessageInfo
 
-func (m *BlockList) GetItems() []*Block {
+func (m *ThreadKeyList) GetItems() []*ThreadKey {
 	if m != nil {
 		return m.Items
 	}
 	return nil
 }
 
-type BlockMessage struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
-	Env                  *Envelope            `protobuf:"bytes,3,opt,name=env,proto3" json:"env,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+type ThreadMember struct {
+	Thread               string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role                 ThreadMember_Role    `protobuf:"varint,3,opt,name=role,proto3,enum=ThreadMember_Role" json:"role,omitempty"`
+	Removed              bool                 `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Block                string               `protobuf:"bytes,6,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *BlockMessage) Reset()         { *m = BlockMessage{} }
-func (m *BlockMessage) String() string { return proto.CompactTextString(m) }
-func (*BlockMessage) ProtoMessage()    {}
-func (*BlockMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{10}
+func (m *ThreadMember) Reset()         { *m = ThreadMember{} }
+func (m *ThreadMember) String() string { return proto.CompactTextString(m) }
+func (*ThreadMember) ProtoMessage()    {}
+func (*ThreadMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{12}
 }
-func (m *BlockMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockMessage.Unmarshal(m, b)
+func (m *ThreadMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadMember.Unmarshal(m, b)
 }
-func (m *BlockMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockMessage.Marshal(b, m, deterministic)
+func (m *ThreadMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadMember.Marshal(b, m, deterministic)
 }
-func (dst *BlockMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockMessage.Merge(dst, src)
+func (dst *ThreadMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadMember.Merge(dst, src)
 }
-func (m *BlockMessage) XXX_Size() int {
-	return xxx_messageInfo_BlockMessage.Size(m)
+func (m *ThreadMember) XXX_Size() int {
+	return xxx_messageInfo_ThreadMember.Size(m)
 }
-func (m *BlockMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockMessage.DiscardUnknown(m)
+func (m *ThreadMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadMember.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockMessage proto.InternalMessageInfo
+var xxx_messageInfo_ThreadMember proto.InternalMessageInfo
 
-func (m *BlockMessage) GetId() string {
+func (m *ThreadMember) GetThread() string {
 	if m != nil {
-		return m.Id
+		return m.Thread
 	}
 	return ""
 }
 
-func (m *BlockMessage) GetPeer() string {
+func (m *ThreadMember) GetAddress() string {
 	if m != nil {
-		return m.Peer
+		return m.Address
 	}
 	return ""
 }
 
-func (m *BlockMessage) GetEnv() *Envelope {
+func (m *ThreadMember) GetRole() ThreadMember_Role {
 	if m != nil {
-		return m.Env
+		return m.Role
 	}
-	return nil
+	return ThreadMember_DEFAULT
 }
 
-func (m *BlockMessage) GetDate() *timestamp.Timestamp {
+func (m *ThreadMember) GetRemoved() bool {
 	if m != nil {
-		return m.Date
+		return m.Removed
 	}
-	return nil
+	return false
 }
 
-type Invite struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Block                []byte               `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
-	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Inviter              *Peer                `protobuf:"bytes,4,opt,name=inviter,proto3" json:"inviter,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
-	Parents              []string             `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *ThreadMember) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ThreadMember) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+// ThreadMemberChange is a membership change at its position in thread history
+type ThreadMemberChange struct {
+	Block                string                        `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Thread               string                        `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Address              string                        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Clock                int64                         `protobuf:"varint,4,opt,name=clock,proto3" json:"clock,omitempty"`
+	Date                 *timestamp.Timestamp          `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Role                 ThreadMember_Role             `protobuf:"varint,6,opt,name=role,proto3,enum=ThreadMember_Role" json:"role,omitempty"`
+	Membership           ThreadMemberChange_Membership `protobuf:"varint,7,opt,name=membership,proto3,enum=ThreadMemberChange_Membership" json:"membership,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ThreadMemberChange) Reset()         { *m = ThreadMemberChange{} }
+func (m *ThreadMemberChange) String() string { return proto.CompactTextString(m) }
+func (*ThreadMemberChange) ProtoMessage()    {}
+func (*ThreadMemberChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{13}
+}
+func (m *ThreadMemberChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadMemberChange.Unmarshal(m, b)
+}
+func (m *ThreadMemberChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadMemberChange.Marshal(b, m, deterministic)
+}
+func (dst *ThreadMemberChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadMemberChange.Merge(dst, src)
+}
+func (m *ThreadMemberChange) XXX_Size() int {
+	return xxx_messageInfo_ThreadMemberChange.Size(m)
+}
+func (m *ThreadMemberChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadMemberChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadMemberChange proto.InternalMessageInfo
+
+func (m *ThreadMemberChange) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *ThreadMemberChange) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadMemberChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ThreadMemberChange) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ThreadMemberChange) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ThreadMemberChange) GetRole() ThreadMember_Role {
+	if m != nil {
+		return m.Role
+	}
+	return ThreadMember_DEFAULT
+}
+
+func (m *ThreadMemberChange) GetMembership() ThreadMemberChange_Membership {
+	if m != nil {
+		return m.Membership
+	}
+	return ThreadMemberChange_KEPT
+}
+
+type ThreadRetention struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	MaxAge               int64    `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	MaxBlocks            int32    `protobuf:"varint,3,opt,name=max_blocks,json=maxBlocks,proto3" json:"max_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadRetention) Reset()         { *m = ThreadRetention{} }
+func (m *ThreadRetention) String() string { return proto.CompactTextString(m) }
+func (*ThreadRetention) ProtoMessage()    {}
+func (*ThreadRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{14}
+}
+func (m *ThreadRetention) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRetention.Unmarshal(m, b)
+}
+func (m *ThreadRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRetention.Marshal(b, m, deterministic)
+}
+func (dst *ThreadRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRetention.Merge(dst, src)
+}
+func (m *ThreadRetention) XXX_Size() int {
+	return xxx_messageInfo_ThreadRetention.Size(m)
+}
+func (m *ThreadRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRetention proto.InternalMessageInfo
+
+func (m *ThreadRetention) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadRetention) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *ThreadRetention) GetMaxBlocks() int32 {
+	if m != nil {
+		return m.MaxBlocks
+	}
+	return 0
+}
+
+type ThreadRetentionList struct {
+	Items                []*ThreadRetention `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ThreadRetentionList) Reset()         { *m = ThreadRetentionList{} }
+func (m *ThreadRetentionList) String() string { return proto.CompactTextString(m) }
+func (*ThreadRetentionList) ProtoMessage()    {}
+func (*ThreadRetentionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{15}
+}
+func (m *ThreadRetentionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRetentionList.Unmarshal(m, b)
+}
+func (m *ThreadRetentionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRetentionList.Marshal(b, m, deterministic)
+}
+func (dst *ThreadRetentionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRetentionList.Merge(dst, src)
+}
+func (m *ThreadRetentionList) XXX_Size() int {
+	return xxx_messageInfo_ThreadRetentionList.Size(m)
+}
+func (m *ThreadRetentionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRetentionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRetentionList proto.InternalMessageInfo
+
+func (m *ThreadRetentionList) GetItems() []*ThreadRetention {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ThreadVerification struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Nodes                int32    `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Missing              []string `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing,omitempty"`
+	Undecryptable        []string `protobuf:"bytes,4,rep,name=undecryptable,proto3" json:"undecryptable,omitempty"`
+	Unindexed            []string `protobuf:"bytes,5,rep,name=unindexed,proto3" json:"unindexed,omitempty"`
+	Mismatched           []string `protobuf:"bytes,6,rep,name=mismatched,proto3" json:"mismatched,omitempty"`
+	Orphaned             []string `protobuf:"bytes,7,rep,name=orphaned,proto3" json:"orphaned,omitempty"`
+	Repaired             []string `protobuf:"bytes,8,rep,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadVerification) Reset()         { *m = ThreadVerification{} }
+func (m *ThreadVerification) String() string { return proto.CompactTextString(m) }
+func (*ThreadVerification) ProtoMessage()    {}
+func (*ThreadVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{16}
+}
+func (m *ThreadVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadVerification.Unmarshal(m, b)
+}
+func (m *ThreadVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadVerification.Marshal(b, m, deterministic)
+}
+func (dst *ThreadVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadVerification.Merge(dst, src)
+}
+func (m *ThreadVerification) XXX_Size() int {
+	return xxx_messageInfo_ThreadVerification.Size(m)
+}
+func (m *ThreadVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadVerification proto.InternalMessageInfo
+
+func (m *ThreadVerification) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadVerification) GetNodes() int32 {
+	if m != nil {
+		return m.Nodes
+	}
+	return 0
+}
+
+func (m *ThreadVerification) GetMissing() []string {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func (m *ThreadVerification) GetUndecryptable() []string {
+	if m != nil {
+		return m.Undecryptable
+	}
+	return nil
+}
+
+func (m *ThreadVerification) GetUnindexed() []string {
+	if m != nil {
+		return m.Unindexed
+	}
+	return nil
+}
+
+func (m *ThreadVerification) GetMismatched() []string {
+	if m != nil {
+		return m.Mismatched
+	}
+	return nil
+}
+
+func (m *ThreadVerification) GetOrphaned() []string {
+	if m != nil {
+		return m.Orphaned
+	}
+	return nil
+}
+
+func (m *ThreadVerification) GetRepaired() []string {
+	if m != nil {
+		return m.Repaired
+	}
+	return nil
+}
+
+// ThreadArchive is the header of a thread archive, followed by ThreadArchiveBlocks
+type ThreadArchive struct {
+	Version              int32           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Thread               *Thread         `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Schemas              []*ThreadSchema `protobuf:"bytes,3,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ThreadArchive) Reset()         { *m = ThreadArchive{} }
+func (m *ThreadArchive) String() string { return proto.CompactTextString(m) }
+func (*ThreadArchive) ProtoMessage()    {}
+func (*ThreadArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{17}
+}
+func (m *ThreadArchive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadArchive.Unmarshal(m, b)
+}
+func (m *ThreadArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadArchive.Marshal(b, m, deterministic)
+}
+func (dst *ThreadArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadArchive.Merge(dst, src)
+}
+func (m *ThreadArchive) XXX_Size() int {
+	return xxx_messageInfo_ThreadArchive.Size(m)
+}
+func (m *ThreadArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadArchive proto.InternalMessageInfo
+
+func (m *ThreadArchive) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ThreadArchive) GetThread() *Thread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
+func (m *ThreadArchive) GetSchemas() []*ThreadSchema {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+type ThreadArchiveBlock struct {
+	Cid                  []byte   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadArchiveBlock) Reset()         { *m = ThreadArchiveBlock{} }
+func (m *ThreadArchiveBlock) String() string { return proto.CompactTextString(m) }
+func (*ThreadArchiveBlock) ProtoMessage()    {}
+func (*ThreadArchiveBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{18}
+}
+func (m *ThreadArchiveBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadArchiveBlock.Unmarshal(m, b)
+}
+func (m *ThreadArchiveBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadArchiveBlock.Marshal(b, m, deterministic)
+}
+func (dst *ThreadArchiveBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadArchiveBlock.Merge(dst, src)
+}
+func (m *ThreadArchiveBlock) XXX_Size() int {
+	return xxx_messageInfo_ThreadArchiveBlock.Size(m)
+}
+func (m *ThreadArchiveBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadArchiveBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadArchiveBlock proto.InternalMessageInfo
+
+func (m *ThreadArchiveBlock) GetCid() []byte {
+	if m != nil {
+		return m.Cid
+	}
+	return nil
+}
+
+func (m *ThreadArchiveBlock) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ThreadMemberList struct {
+	Items                []*ThreadMember `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ThreadMemberList) Reset()         { *m = ThreadMemberList{} }
+func (m *ThreadMemberList) String() string { return proto.CompactTextString(m) }
+func (*ThreadMemberList) ProtoMessage()    {}
+func (*ThreadMemberList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{19}
+}
+func (m *ThreadMemberList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadMemberList.Unmarshal(m, b)
+}
+func (m *ThreadMemberList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadMemberList.Marshal(b, m, deterministic)
+}
+func (dst *ThreadMemberList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadMemberList.Merge(dst, src)
+}
+func (m *ThreadMemberList) XXX_Size() int {
+	return xxx_messageInfo_ThreadMemberList.Size(m)
+}
+func (m *ThreadMemberList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadMemberList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadMemberList proto.InternalMessageInfo
+
+func (m *ThreadMemberList) GetItems() []*ThreadMember {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ThreadMemberChangeList struct {
+	Items                []*ThreadMemberChange `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ThreadMemberChangeList) Reset()         { *m = ThreadMemberChangeList{} }
+func (m *ThreadMemberChangeList) String() string { return proto.CompactTextString(m) }
+func (*ThreadMemberChangeList) ProtoMessage()    {}
+func (*ThreadMemberChangeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{20}
+}
+func (m *ThreadMemberChangeList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadMemberChangeList.Unmarshal(m, b)
+}
+func (m *ThreadMemberChangeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadMemberChangeList.Marshal(b, m, deterministic)
+}
+func (dst *ThreadMemberChangeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadMemberChangeList.Merge(dst, src)
+}
+func (m *ThreadMemberChangeList) XXX_Size() int {
+	return xxx_messageInfo_ThreadMemberChangeList.Size(m)
+}
+func (m *ThreadMemberChangeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadMemberChangeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadMemberChangeList proto.InternalMessageInfo
+
+func (m *ThreadMemberChangeList) GetItems() []*ThreadMemberChange {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type Block struct {
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Thread   string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Author   string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Type     Block_BlockType      `protobuf:"varint,4,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
+	Date     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Parents  []string             `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
+	Target   string               `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	Data     string               `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	Body     string               `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	Status   Block_BlockStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=Block_BlockStatus" json:"status,omitempty"`
+	Attempts int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Expires  *timestamp.Timestamp `protobuf:"bytes,12,opt,name=expires,proto3" json:"expires,omitempty"`
+	Clock    int64                `protobuf:"varint,13,opt,name=clock,proto3" json:"clock,omitempty"`
+	// view info
+	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{21}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (dst *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(dst, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Block) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *Block) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Block) GetType() Block_BlockType {
+	if m != nil {
+		return m.Type
+	}
+	return Block_MERGE
+}
+
+func (m *Block) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Block) GetParents() []string {
+	if m != nil {
+		return m.Parents
+	}
+	return nil
+}
+
+func (m *Block) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Block) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Block) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Block) GetStatus() Block_BlockStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Block_READY
+}
+
+func (m *Block) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Block) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *Block) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *Block) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type BlockList struct {
+	Items                []*Block `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockList) Reset()         { *m = BlockList{} }
+func (m *BlockList) String() string { return proto.CompactTextString(m) }
+func (*BlockList) ProtoMessage()    {}
+func (*BlockList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{22}
+}
+func (m *BlockList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockList.Unmarshal(m, b)
+}
+func (m *BlockList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockList.Marshal(b, m, deterministic)
+}
+func (dst *BlockList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockList.Merge(dst, src)
+}
+func (m *BlockList) XXX_Size() int {
+	return xxx_messageInfo_BlockList.Size(m)
+}
+func (m *BlockList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockList proto.InternalMessageInfo
+
+func (m *BlockList) GetItems() []*Block {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type BlockMessage struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Env                  *Envelope            `protobuf:"bytes,3,opt,name=env,proto3" json:"env,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BlockMessage) Reset()         { *m = BlockMessage{} }
+func (m *BlockMessage) String() string { return proto.CompactTextString(m) }
+func (*BlockMessage) ProtoMessage()    {}
+func (*BlockMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{23}
+}
+func (m *BlockMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockMessage.Unmarshal(m, b)
+}
+func (m *BlockMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockMessage.Marshal(b, m, deterministic)
+}
+func (dst *BlockMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMessage.Merge(dst, src)
+}
+func (m *BlockMessage) XXX_Size() int {
+	return xxx_messageInfo_BlockMessage.Size(m)
+}
+func (m *BlockMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMessage proto.InternalMessageInfo
+
+func (m *BlockMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BlockMessage) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *BlockMessage) GetEnv() *Envelope {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *BlockMessage) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type Invite struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Block                []byte               `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Inviter              *Peer                `protobuf:"bytes,4,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Parents              []string             `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Invite) Reset()         { *m = Invite{} }
 func (m *Invite) String() string { return proto.CompactTextString(m) }
 func (*Invite) ProtoMessage()    {}
 func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{11}
+	return fileDescriptor_model_19ebed94e7761565, []int{24}
 }
 func (m *Invite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Invite.Unmarshal(m, b)
@@ -1154,7 +2158,7 @@ func (m *InviteList) Reset()         { *m = InviteList{} }
 func (m *InviteList) String() string { return proto.CompactTextString(m) }
 func (*InviteList) ProtoMessage()    {}
 func (*InviteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{12}
+	return fileDescriptor_model_19ebed94e7761565, []int{25}
 }
 func (m *InviteList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteList.Unmarshal(m, b)
@@ -1194,6 +2198,7 @@ type FileIndex struct {
 	Added                *timestamp.Timestamp `protobuf:"bytes,10,opt,name=added,proto3" json:"added,omitempty"`
 	Meta                 *_struct.Struct      `protobuf:"bytes,11,opt,name=meta,proto3" json:"meta,omitempty"`
 	Targets              []string             `protobuf:"bytes,12,rep,name=targets,proto3" json:"targets,omitempty"`
+	Encryption           FileIndex_Encryption `protobuf:"varint,13,opt,name=encryption,proto3,enum=FileIndex_Encryption" json:"encryption,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1203,7 +2208,7 @@ func (m *FileIndex) Reset()         { *m = FileIndex{} }
 func (m *FileIndex) String() string { return proto.CompactTextString(m) }
 func (*FileIndex) ProtoMessage()    {}
 func (*FileIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{13}
+	return fileDescriptor_model_19ebed94e7761565, []int{26}
 }
 func (m *FileIndex) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileIndex.Unmarshal(m, b)
@@ -1307,6 +2312,105 @@ func (m *FileIndex) GetTargets() []string {
 	return nil
 }
 
+func (m *FileIndex) GetEncryption() FileIndex_Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return FileIndex_AES_GCM
+}
+
+type FileChunk struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileChunk) Reset()         { *m = FileChunk{} }
+func (m *FileChunk) String() string { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()    {}
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{27}
+}
+func (m *FileChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChunk.Unmarshal(m, b)
+}
+func (m *FileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChunk.Marshal(b, m, deterministic)
+}
+func (dst *FileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChunk.Merge(dst, src)
+}
+func (m *FileChunk) XXX_Size() int {
+	return xxx_messageInfo_FileChunk.Size(m)
+}
+func (m *FileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChunk proto.InternalMessageInfo
+
+func (m *FileChunk) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *FileChunk) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *FileChunk) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type FileChunkList struct {
+	Items                []*FileChunk `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FileChunkList) Reset()         { *m = FileChunkList{} }
+func (m *FileChunkList) String() string { return proto.CompactTextString(m) }
+func (*FileChunkList) ProtoMessage()    {}
+func (*FileChunkList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{28}
+}
+func (m *FileChunkList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChunkList.Unmarshal(m, b)
+}
+func (m *FileChunkList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChunkList.Marshal(b, m, deterministic)
+}
+func (dst *FileChunkList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChunkList.Merge(dst, src)
+}
+func (m *FileChunkList) XXX_Size() int {
+	return xxx_messageInfo_FileChunkList.Size(m)
+}
+func (m *FileChunkList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChunkList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChunkList proto.InternalMessageInfo
+
+func (m *FileChunkList) GetItems() []*FileChunk {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type Node struct {
 	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
@@ -1324,7 +2428,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{14}
+	return fileDescriptor_model_19ebed94e7761565, []int{29}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -1400,6 +2504,7 @@ type Link struct {
 	Mill                 string            `protobuf:"bytes,4,opt,name=mill,proto3" json:"mill,omitempty"`
 	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Optional             bool              `protobuf:"varint,7,opt,name=optional,proto3" json:"optional,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1409,7 +2514,7 @@ func (m *Link) Reset()         { *m = Link{} }
 func (m *Link) String() string { return proto.CompactTextString(m) }
 func (*Link) ProtoMessage()    {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{15}
+	return fileDescriptor_model_19ebed94e7761565, []int{30}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Link.Unmarshal(m, b)
@@ -1471,6 +2576,75 @@ func (m *Link) GetJsonSchema() *_struct.Struct {
 	return nil
 }
 
+func (m *Link) GetOptional() bool {
+	if m != nil {
+		return m.Optional
+	}
+	return false
+}
+
+type SchemaDiff struct {
+	Added                []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed              []string `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Changed              []string `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"`
+	Compatible           bool     `protobuf:"varint,4,opt,name=compatible,proto3" json:"compatible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchemaDiff) Reset()         { *m = SchemaDiff{} }
+func (m *SchemaDiff) String() string { return proto.CompactTextString(m) }
+func (*SchemaDiff) ProtoMessage()    {}
+func (*SchemaDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{31}
+}
+func (m *SchemaDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchemaDiff.Unmarshal(m, b)
+}
+func (m *SchemaDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchemaDiff.Marshal(b, m, deterministic)
+}
+func (dst *SchemaDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaDiff.Merge(dst, src)
+}
+func (m *SchemaDiff) XXX_Size() int {
+	return xxx_messageInfo_SchemaDiff.Size(m)
+}
+func (m *SchemaDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaDiff proto.InternalMessageInfo
+
+func (m *SchemaDiff) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *SchemaDiff) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *SchemaDiff) GetChanged() []string {
+	if m != nil {
+		return m.Changed
+	}
+	return nil
+}
+
+func (m *SchemaDiff) GetCompatible() bool {
+	if m != nil {
+		return m.Compatible
+	}
+	return false
+}
+
 type Notification struct {
 	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Date        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
@@ -1493,7 +2667,7 @@ func (m *Notification) Reset()         { *m = Notification{} }
 func (m *Notification) String() string { return proto.CompactTextString(m) }
 func (*Notification) ProtoMessage()    {}
 func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{16}
+	return fileDescriptor_model_19ebed94e7761565, []int{32}
 }
 func (m *Notification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Notification.Unmarshal(m, b)
@@ -1601,7 +2775,7 @@ func (m *NotificationList) Reset()         { *m = NotificationList{} }
 func (m *NotificationList) String() string { return proto.CompactTextString(m) }
 func (*NotificationList) ProtoMessage()    {}
 func (*NotificationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{17}
+	return fileDescriptor_model_19ebed94e7761565, []int{33}
 }
 func (m *NotificationList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotificationList.Unmarshal(m, b)
@@ -1644,7 +2818,7 @@ func (m *Cafe) Reset()         { *m = Cafe{} }
 func (m *Cafe) String() string { return proto.CompactTextString(m) }
 func (*Cafe) ProtoMessage()    {}
 func (*Cafe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{18}
+	return fileDescriptor_model_19ebed94e7761565, []int{34}
 }
 func (m *Cafe) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Cafe.Unmarshal(m, b)
@@ -1715,6 +2889,7 @@ type CafeSession struct {
 	Subject              string               `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
 	Type                 string               `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
 	Cafe                 *Cafe                `protobuf:"bytes,8,opt,name=cafe,proto3" json:"cafe,omitempty"`
+	Usage                *CafeUsage           `protobuf:"bytes,9,opt,name=usage,proto3" json:"usage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1724,7 +2899,7 @@ func (m *CafeSession) Reset()         { *m = CafeSession{} }
 func (m *CafeSession) String() string { return proto.CompactTextString(m) }
 func (*CafeSession) ProtoMessage()    {}
 func (*CafeSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{19}
+	return fileDescriptor_model_19ebed94e7761565, []int{35}
 }
 func (m *CafeSession) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeSession.Unmarshal(m, b)
@@ -1800,6 +2975,13 @@ func (m *CafeSession) GetCafe() *Cafe {
 	return nil
 }
 
+func (m *CafeSession) GetUsage() *CafeUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type CafeSessionList struct {
 	Items                []*CafeSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1811,7 +2993,7 @@ func (m *CafeSessionList) Reset()         { *m = CafeSessionList{} }
 func (m *CafeSessionList) String() string { return proto.CompactTextString(m) }
 func (*CafeSessionList) ProtoMessage()    {}
 func (*CafeSessionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{20}
+	return fileDescriptor_model_19ebed94e7761565, []int{36}
 }
 func (m *CafeSessionList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeSessionList.Unmarshal(m, b)
@@ -1838,6 +3020,123 @@ func (m *CafeSessionList) GetItems() []*CafeSession {
 	return nil
 }
 
+// zero values are unlimited
+type CafeQuota struct {
+	Bytes                int64    `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Objects              int32    `protobuf:"varint,2,opt,name=objects,proto3" json:"objects,omitempty"`
+	Threads              int32    `protobuf:"varint,3,opt,name=threads,proto3" json:"threads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeQuota) Reset()         { *m = CafeQuota{} }
+func (m *CafeQuota) String() string { return proto.CompactTextString(m) }
+func (*CafeQuota) ProtoMessage()    {}
+func (*CafeQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{37}
+}
+func (m *CafeQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeQuota.Unmarshal(m, b)
+}
+func (m *CafeQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeQuota.Marshal(b, m, deterministic)
+}
+func (dst *CafeQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeQuota.Merge(dst, src)
+}
+func (m *CafeQuota) XXX_Size() int {
+	return xxx_messageInfo_CafeQuota.Size(m)
+}
+func (m *CafeQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeQuota proto.InternalMessageInfo
+
+func (m *CafeQuota) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *CafeQuota) GetObjects() int32 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *CafeQuota) GetThreads() int32 {
+	if m != nil {
+		return m.Threads
+	}
+	return 0
+}
+
+type CafeUsage struct {
+	Bytes                int64      `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Objects              int32      `protobuf:"varint,2,opt,name=objects,proto3" json:"objects,omitempty"`
+	Threads              int32      `protobuf:"varint,3,opt,name=threads,proto3" json:"threads,omitempty"`
+	Quota                *CafeQuota `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CafeUsage) Reset()         { *m = CafeUsage{} }
+func (m *CafeUsage) String() string { return proto.CompactTextString(m) }
+func (*CafeUsage) ProtoMessage()    {}
+func (*CafeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{38}
+}
+func (m *CafeUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeUsage.Unmarshal(m, b)
+}
+func (m *CafeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeUsage.Marshal(b, m, deterministic)
+}
+func (dst *CafeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeUsage.Merge(dst, src)
+}
+func (m *CafeUsage) XXX_Size() int {
+	return xxx_messageInfo_CafeUsage.Size(m)
+}
+func (m *CafeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeUsage proto.InternalMessageInfo
+
+func (m *CafeUsage) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *CafeUsage) GetObjects() int32 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *CafeUsage) GetThreads() int32 {
+	if m != nil {
+		return m.Threads
+	}
+	return 0
+}
+
+func (m *CafeUsage) GetQuota() *CafeQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
 type CafeRequest struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
@@ -1861,7 +3160,7 @@ func (m *CafeRequest) Reset()         { *m = CafeRequest{} }
 func (m *CafeRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeRequest) ProtoMessage()    {}
 func (*CafeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{21}
+	return fileDescriptor_model_19ebed94e7761565, []int{39}
 }
 func (m *CafeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRequest.Unmarshal(m, b)
@@ -1983,7 +3282,7 @@ func (m *CafeRequestList) Reset()         { *m = CafeRequestList{} }
 func (m *CafeRequestList) String() string { return proto.CompactTextString(m) }
 func (*CafeRequestList) ProtoMessage()    {}
 func (*CafeRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{22}
+	return fileDescriptor_model_19ebed94e7761565, []int{40}
 }
 func (m *CafeRequestList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRequestList.Unmarshal(m, b)
@@ -2031,7 +3330,7 @@ func (m *CafeSyncGroupStatus) Reset()         { *m = CafeSyncGroupStatus{} }
 func (m *CafeSyncGroupStatus) String() string { return proto.CompactTextString(m) }
 func (*CafeSyncGroupStatus) ProtoMessage()    {}
 func (*CafeSyncGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{23}
+	return fileDescriptor_model_19ebed94e7761565, []int{41}
 }
 func (m *CafeSyncGroupStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeSyncGroupStatus.Unmarshal(m, b)
@@ -2142,7 +3441,7 @@ func (m *CafeHTTPRequest) Reset()         { *m = CafeHTTPRequest{} }
 func (m *CafeHTTPRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeHTTPRequest) ProtoMessage()    {}
 func (*CafeHTTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{24}
+	return fileDescriptor_model_19ebed94e7761565, []int{42}
 }
 func (m *CafeHTTPRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeHTTPRequest.Unmarshal(m, b)
@@ -2204,7 +3503,7 @@ func (m *CafeMessage) Reset()         { *m = CafeMessage{} }
 func (m *CafeMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeMessage) ProtoMessage()    {}
 func (*CafeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{25}
+	return fileDescriptor_model_19ebed94e7761565, []int{43}
 }
 func (m *CafeMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeMessage.Unmarshal(m, b)
@@ -2265,7 +3564,7 @@ func (m *CafeClientNonce) Reset()         { *m = CafeClientNonce{} }
 func (m *CafeClientNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeClientNonce) ProtoMessage()    {}
 func (*CafeClientNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{26}
+	return fileDescriptor_model_19ebed94e7761565, []int{44}
 }
 func (m *CafeClientNonce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientNonce.Unmarshal(m, b)
@@ -2307,21 +3606,25 @@ func (m *CafeClientNonce) GetDate() *timestamp.Timestamp {
 }
 
 type CafeClient struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Created              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
-	Seen                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
-	Token                string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Created *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Seen    *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
+	Token   string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Quota   *CafeQuota           `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
+	Expires *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	// view info
+	Usage                *CafeUsage `protobuf:"bytes,101,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CafeClient) Reset()         { *m = CafeClient{} }
 func (m *CafeClient) String() string { return proto.CompactTextString(m) }
 func (*CafeClient) ProtoMessage()    {}
 func (*CafeClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{27}
+	return fileDescriptor_model_19ebed94e7761565, []int{45}
 }
 func (m *CafeClient) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClient.Unmarshal(m, b)
@@ -2350,30 +3653,51 @@ func (m *CafeClient) GetId() string {
 
 func (m *CafeClient) GetAddress() string {
 	if m != nil {
-		return m.Address
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CafeClient) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *CafeClient) GetSeen() *timestamp.Timestamp {
+	if m != nil {
+		return m.Seen
+	}
+	return nil
+}
+
+func (m *CafeClient) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
 	return ""
 }
 
-func (m *CafeClient) GetCreated() *timestamp.Timestamp {
+func (m *CafeClient) GetQuota() *CafeQuota {
 	if m != nil {
-		return m.Created
+		return m.Quota
 	}
 	return nil
 }
 
-func (m *CafeClient) GetSeen() *timestamp.Timestamp {
+func (m *CafeClient) GetExpires() *timestamp.Timestamp {
 	if m != nil {
-		return m.Seen
+		return m.Expires
 	}
 	return nil
 }
 
-func (m *CafeClient) GetToken() string {
+func (m *CafeClient) GetUsage() *CafeUsage {
 	if m != nil {
-		return m.Token
+		return m.Usage
 	}
-	return ""
+	return nil
 }
 
 type CafeClientList struct {
@@ -2387,7 +3711,7 @@ func (m *CafeClientList) Reset()         { *m = CafeClientList{} }
 func (m *CafeClientList) String() string { return proto.CompactTextString(m) }
 func (*CafeClientList) ProtoMessage()    {}
 func (*CafeClientList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{28}
+	return fileDescriptor_model_19ebed94e7761565, []int{46}
 }
 func (m *CafeClientList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientList.Unmarshal(m, b)
@@ -2414,10 +3738,115 @@ func (m *CafeClientList) GetItems() []*CafeClient {
 	return nil
 }
 
+type CafeClientGC struct {
+	Client               *CafeClient         `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Action               CafeClientGC_Action `protobuf:"varint,2,opt,name=action,proto3,enum=CafeClientGC_Action" json:"action,omitempty"`
+	Messages             int32               `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CafeClientGC) Reset()         { *m = CafeClientGC{} }
+func (m *CafeClientGC) String() string { return proto.CompactTextString(m) }
+func (*CafeClientGC) ProtoMessage()    {}
+func (*CafeClientGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{47}
+}
+func (m *CafeClientGC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientGC.Unmarshal(m, b)
+}
+func (m *CafeClientGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientGC.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientGC.Merge(dst, src)
+}
+func (m *CafeClientGC) XXX_Size() int {
+	return xxx_messageInfo_CafeClientGC.Size(m)
+}
+func (m *CafeClientGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientGC proto.InternalMessageInfo
+
+func (m *CafeClientGC) GetClient() *CafeClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CafeClientGC) GetAction() CafeClientGC_Action {
+	if m != nil {
+		return m.Action
+	}
+	return CafeClientGC_SCHEDULE
+}
+
+func (m *CafeClientGC) GetMessages() int32 {
+	if m != nil {
+		return m.Messages
+	}
+	return 0
+}
+
+type CafeGCReport struct {
+	Items                []*CafeClientGC `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	DryRun               bool            `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CafeGCReport) Reset()         { *m = CafeGCReport{} }
+func (m *CafeGCReport) String() string { return proto.CompactTextString(m) }
+func (*CafeGCReport) ProtoMessage()    {}
+func (*CafeGCReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{48}
+}
+func (m *CafeGCReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeGCReport.Unmarshal(m, b)
+}
+func (m *CafeGCReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeGCReport.Marshal(b, m, deterministic)
+}
+func (dst *CafeGCReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeGCReport.Merge(dst, src)
+}
+func (m *CafeGCReport) XXX_Size() int {
+	return xxx_messageInfo_CafeGCReport.Size(m)
+}
+func (m *CafeGCReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeGCReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeGCReport proto.InternalMessageInfo
+
+func (m *CafeGCReport) GetItems() []*CafeClientGC {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *CafeGCReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type CafeToken struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Quota                *CafeQuota           `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	MaxRegistrations     int32                `protobuf:"varint,6,opt,name=max_registrations,json=maxRegistrations,proto3" json:"max_registrations,omitempty"`
+	Registrations        int32                `protobuf:"varint,7,opt,name=registrations,proto3" json:"registrations,omitempty"`
+	Scopes               []CafeToken_Scope    `protobuf:"varint,8,rep,packed,name=scopes,proto3,enum=CafeToken_Scope" json:"scopes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2427,7 +3856,7 @@ func (m *CafeToken) Reset()         { *m = CafeToken{} }
 func (m *CafeToken) String() string { return proto.CompactTextString(m) }
 func (*CafeToken) ProtoMessage()    {}
 func (*CafeToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{29}
+	return fileDescriptor_model_19ebed94e7761565, []int{49}
 }
 func (m *CafeToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeToken.Unmarshal(m, b)
@@ -2468,6 +3897,41 @@ func (m *CafeToken) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *CafeToken) GetQuota() *CafeQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *CafeToken) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *CafeToken) GetMaxRegistrations() int32 {
+	if m != nil {
+		return m.MaxRegistrations
+	}
+	return 0
+}
+
+func (m *CafeToken) GetRegistrations() int32 {
+	if m != nil {
+		return m.Registrations
+	}
+	return 0
+}
+
+func (m *CafeToken) GetScopes() []CafeToken_Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 type CafeClientThread struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
@@ -2481,7 +3945,7 @@ func (m *CafeClientThread) Reset()         { *m = CafeClientThread{} }
 func (m *CafeClientThread) String() string { return proto.CompactTextString(m) }
 func (*CafeClientThread) ProtoMessage()    {}
 func (*CafeClientThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{30}
+	return fileDescriptor_model_19ebed94e7761565, []int{50}
 }
 func (m *CafeClientThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientThread.Unmarshal(m, b)
@@ -2522,6 +3986,106 @@ func (m *CafeClientThread) GetCiphertext() []byte {
 	return nil
 }
 
+type CafeClientThreadList struct {
+	Items                []*CafeClientThread `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CafeClientThreadList) Reset()         { *m = CafeClientThreadList{} }
+func (m *CafeClientThreadList) String() string { return proto.CompactTextString(m) }
+func (*CafeClientThreadList) ProtoMessage()    {}
+func (*CafeClientThreadList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{51}
+}
+func (m *CafeClientThreadList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientThreadList.Unmarshal(m, b)
+}
+func (m *CafeClientThreadList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientThreadList.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientThreadList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientThreadList.Merge(dst, src)
+}
+func (m *CafeClientThreadList) XXX_Size() int {
+	return xxx_messageInfo_CafeClientThreadList.Size(m)
+}
+func (m *CafeClientThreadList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientThreadList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientThreadList proto.InternalMessageInfo
+
+func (m *CafeClientThreadList) GetItems() []*CafeClientThread {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeClientObject struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Size                 int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientObject) Reset()         { *m = CafeClientObject{} }
+func (m *CafeClientObject) String() string { return proto.CompactTextString(m) }
+func (*CafeClientObject) ProtoMessage()    {}
+func (*CafeClientObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{52}
+}
+func (m *CafeClientObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientObject.Unmarshal(m, b)
+}
+func (m *CafeClientObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientObject.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientObject.Merge(dst, src)
+}
+func (m *CafeClientObject) XXX_Size() int {
+	return xxx_messageInfo_CafeClientObject.Size(m)
+}
+func (m *CafeClientObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientObject proto.InternalMessageInfo
+
+func (m *CafeClientObject) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClientObject) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeClientObject) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CafeClientObject) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
 type CafeClientMessage struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
@@ -2536,7 +4100,7 @@ func (m *CafeClientMessage) Reset()         { *m = CafeClientMessage{} }
 func (m *CafeClientMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeClientMessage) ProtoMessage()    {}
 func (*CafeClientMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_1bbc4f25d1e9f97f, []int{31}
+	return fileDescriptor_model_19ebed94e7761565, []int{53}
 }
 func (m *CafeClientMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientMessage.Unmarshal(m, b)
@@ -2584,6 +4148,209 @@ func (m *CafeClientMessage) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+type CafeReplication struct {
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer     string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Client   string               `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Type     CafeReplication_Type `protobuf:"varint,4,opt,name=type,proto3,enum=CafeReplication_Type" json:"type,omitempty"`
+	Target   string               `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Date     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Attempts int32                `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// payload, added when sent
+	Object               *CafeClientObject  `protobuf:"bytes,101,opt,name=object,proto3" json:"object,omitempty"`
+	Thread               *CafeClientThread  `protobuf:"bytes,102,opt,name=thread,proto3" json:"thread,omitempty"`
+	Message              *CafeClientMessage `protobuf:"bytes,103,opt,name=message,proto3" json:"message,omitempty"`
+	Env                  []byte             `protobuf:"bytes,104,opt,name=env,proto3" json:"env,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeReplication) Reset()         { *m = CafeReplication{} }
+func (m *CafeReplication) String() string { return proto.CompactTextString(m) }
+func (*CafeReplication) ProtoMessage()    {}
+func (*CafeReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{54}
+}
+func (m *CafeReplication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplication.Unmarshal(m, b)
+}
+func (m *CafeReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplication.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplication.Merge(dst, src)
+}
+func (m *CafeReplication) XXX_Size() int {
+	return xxx_messageInfo_CafeReplication.Size(m)
+}
+func (m *CafeReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplication proto.InternalMessageInfo
+
+func (m *CafeReplication) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetType() CafeReplication_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeReplication_CLIENT
+}
+
+func (m *CafeReplication) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *CafeReplication) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *CafeReplication) GetObject() *CafeClientObject {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *CafeReplication) GetThread() *CafeClientThread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
+func (m *CafeReplication) GetMessage() *CafeClientMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *CafeReplication) GetEnv() []byte {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+type CafeClusterPeer struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pending              int32    `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeClusterPeer) Reset()         { *m = CafeClusterPeer{} }
+func (m *CafeClusterPeer) String() string { return proto.CompactTextString(m) }
+func (*CafeClusterPeer) ProtoMessage()    {}
+func (*CafeClusterPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{55}
+}
+func (m *CafeClusterPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClusterPeer.Unmarshal(m, b)
+}
+func (m *CafeClusterPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClusterPeer.Marshal(b, m, deterministic)
+}
+func (dst *CafeClusterPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClusterPeer.Merge(dst, src)
+}
+func (m *CafeClusterPeer) XXX_Size() int {
+	return xxx_messageInfo_CafeClusterPeer.Size(m)
+}
+func (m *CafeClusterPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClusterPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClusterPeer proto.InternalMessageInfo
+
+func (m *CafeClusterPeer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClusterPeer) GetPending() int32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+type CafeClusterPeerList struct {
+	Items                []*CafeClusterPeer `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeClusterPeerList) Reset()         { *m = CafeClusterPeerList{} }
+func (m *CafeClusterPeerList) String() string { return proto.CompactTextString(m) }
+func (*CafeClusterPeerList) ProtoMessage()    {}
+func (*CafeClusterPeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_19ebed94e7761565, []int{56}
+}
+func (m *CafeClusterPeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClusterPeerList.Unmarshal(m, b)
+}
+func (m *CafeClusterPeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClusterPeerList.Marshal(b, m, deterministic)
+}
+func (dst *CafeClusterPeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClusterPeerList.Merge(dst, src)
+}
+func (m *CafeClusterPeerList) XXX_Size() int {
+	return xxx_messageInfo_CafeClusterPeerList.Size(m)
+}
+func (m *CafeClusterPeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClusterPeerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClusterPeerList proto.InternalMessageInfo
+
+func (m *CafeClusterPeerList) GetItems() []*CafeClusterPeer {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Peer)(nil), "Peer")
 	proto.RegisterType((*PeerList)(nil), "PeerList")
@@ -2593,22 +4360,40 @@ func init() {
 	proto.RegisterType((*Thread)(nil), "Thread")
 	proto.RegisterType((*ThreadList)(nil), "ThreadList")
 	proto.RegisterType((*ThreadPeer)(nil), "ThreadPeer")
+	proto.RegisterType((*ThreadSchema)(nil), "ThreadSchema")
+	proto.RegisterType((*ThreadSchemaList)(nil), "ThreadSchemaList")
+	proto.RegisterType((*ThreadKey)(nil), "ThreadKey")
+	proto.RegisterType((*ThreadKeyList)(nil), "ThreadKeyList")
+	proto.RegisterType((*ThreadMember)(nil), "ThreadMember")
+	proto.RegisterType((*ThreadMemberChange)(nil), "ThreadMemberChange")
+	proto.RegisterType((*ThreadRetention)(nil), "ThreadRetention")
+	proto.RegisterType((*ThreadRetentionList)(nil), "ThreadRetentionList")
+	proto.RegisterType((*ThreadVerification)(nil), "ThreadVerification")
+	proto.RegisterType((*ThreadArchive)(nil), "ThreadArchive")
+	proto.RegisterType((*ThreadArchiveBlock)(nil), "ThreadArchiveBlock")
+	proto.RegisterType((*ThreadMemberList)(nil), "ThreadMemberList")
+	proto.RegisterType((*ThreadMemberChangeList)(nil), "ThreadMemberChangeList")
 	proto.RegisterType((*Block)(nil), "Block")
 	proto.RegisterType((*BlockList)(nil), "BlockList")
 	proto.RegisterType((*BlockMessage)(nil), "BlockMessage")
 	proto.RegisterType((*Invite)(nil), "Invite")
 	proto.RegisterType((*InviteList)(nil), "InviteList")
 	proto.RegisterType((*FileIndex)(nil), "FileIndex")
+	proto.RegisterType((*FileChunk)(nil), "FileChunk")
+	proto.RegisterType((*FileChunkList)(nil), "FileChunkList")
 	proto.RegisterType((*Node)(nil), "Node")
 	proto.RegisterMapType((map[string]*Link)(nil), "Node.LinksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "Node.OptsEntry")
 	proto.RegisterType((*Link)(nil), "Link")
 	proto.RegisterMapType((map[string]string)(nil), "Link.OptsEntry")
+	proto.RegisterType((*SchemaDiff)(nil), "SchemaDiff")
 	proto.RegisterType((*Notification)(nil), "Notification")
 	proto.RegisterType((*NotificationList)(nil), "NotificationList")
 	proto.RegisterType((*Cafe)(nil), "Cafe")
 	proto.RegisterType((*CafeSession)(nil), "CafeSession")
 	proto.RegisterType((*CafeSessionList)(nil), "CafeSessionList")
+	proto.RegisterType((*CafeQuota)(nil), "CafeQuota")
+	proto.RegisterType((*CafeUsage)(nil), "CafeUsage")
 	proto.RegisterType((*CafeRequest)(nil), "CafeRequest")
 	proto.RegisterType((*CafeRequestList)(nil), "CafeRequestList")
 	proto.RegisterType((*CafeSyncGroupStatus)(nil), "CafeSyncGroupStatus")
@@ -2618,169 +4403,266 @@ func init() {
 	proto.RegisterType((*CafeClientNonce)(nil), "CafeClientNonce")
 	proto.RegisterType((*CafeClient)(nil), "CafeClient")
 	proto.RegisterType((*CafeClientList)(nil), "CafeClientList")
+	proto.RegisterType((*CafeClientGC)(nil), "CafeClientGC")
+	proto.RegisterType((*CafeGCReport)(nil), "CafeGCReport")
 	proto.RegisterType((*CafeToken)(nil), "CafeToken")
 	proto.RegisterType((*CafeClientThread)(nil), "CafeClientThread")
+	proto.RegisterType((*CafeClientThreadList)(nil), "CafeClientThreadList")
+	proto.RegisterType((*CafeClientObject)(nil), "CafeClientObject")
 	proto.RegisterType((*CafeClientMessage)(nil), "CafeClientMessage")
+	proto.RegisterType((*CafeReplication)(nil), "CafeReplication")
+	proto.RegisterType((*CafeClusterPeer)(nil), "CafeClusterPeer")
+	proto.RegisterType((*CafeClusterPeerList)(nil), "CafeClusterPeerList")
 	proto.RegisterEnum("Thread_Type", Thread_Type_name, Thread_Type_value)
 	proto.RegisterEnum("Thread_Sharing", Thread_Sharing_name, Thread_Sharing_value)
 	proto.RegisterEnum("Thread_State", Thread_State_name, Thread_State_value)
+	proto.RegisterEnum("ThreadMember_Role", ThreadMember_Role_name, ThreadMember_Role_value)
+	proto.RegisterEnum("ThreadMemberChange_Membership", ThreadMemberChange_Membership_name, ThreadMemberChange_Membership_value)
 	proto.RegisterEnum("Block_BlockType", Block_BlockType_name, Block_BlockType_value)
 	proto.RegisterEnum("Block_BlockStatus", Block_BlockStatus_name, Block_BlockStatus_value)
+	proto.RegisterEnum("Block_BlockSort", Block_BlockSort_name, Block_BlockSort_value)
+	proto.RegisterEnum("FileIndex_Encryption", FileIndex_Encryption_name, FileIndex_Encryption_value)
 	proto.RegisterEnum("Notification_Type", Notification_Type_name, Notification_Type_value)
 	proto.RegisterEnum("CafeRequest_Type", CafeRequest_Type_name, CafeRequest_Type_value)
 	proto.RegisterEnum("CafeRequest_Status", CafeRequest_Status_name, CafeRequest_Status_value)
 	proto.RegisterEnum("CafeHTTPRequest_Type", CafeHTTPRequest_Type_name, CafeHTTPRequest_Type_value)
-}
-
-func init() { proto.RegisterFile("model.proto", fileDescriptor_model_1bbc4f25d1e9f97f) }
-
-var fileDescriptor_model_1bbc4f25d1e9f97f = []byte{
-	// 2350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x48, 0x80, 0x7f, 0x1e, 0x29, 0x0b, 0x5e, 0x3b, 0x0e, 0x2c, 0xdb, 0xb1, 0x03, 0x37,
-	0xfe, 0x13, 0xa7, 0x4c, 0x2a, 0xb7, 0xb5, 0x27, 0x97, 0x0e, 0x4d, 0xc1, 0x32, 0x5b, 0x8a, 0x64,
-	0x41, 0xca, 0x4d, 0x72, 0xe1, 0x40, 0xc0, 0x4a, 0x44, 0x44, 0x02, 0x0c, 0x00, 0x3a, 0x56, 0x66,
-	0x3a, 0xb9, 0x75, 0x7a, 0xe8, 0x07, 0xe8, 0xa1, 0x5f, 0xa1, 0x97, 0x7e, 0x86, 0x7e, 0x8f, 0x5e,
-	0xdb, 0x53, 0x2f, 0x9d, 0x9e, 0x3a, 0x9d, 0xce, 0x7b, 0xbb, 0x4b, 0x82, 0x96, 0xac, 0x4a, 0x1d,
-	0xf7, 0x22, 0xed, 0xfb, 0xb3, 0xfb, 0xde, 0xbe, 0xfd, 0xbd, 0x3f, 0x20, 0xd4, 0xa6, 0x71, 0xc0,
-	0x27, 0x8d, 0x59, 0x12, 0x67, 0xf1, 0xe6, 0xed, 0xc3, 0x38, 0x3e, 0x9c, 0xf0, 0x4f, 0x89, 0xda,
-	0x9f, 0x1f, 0x7c, 0x9a, 0x85, 0x53, 0x9e, 0x66, 0xde, 0x74, 0x26, 0x15, 0x6e, 0xbe, 0xa9, 0x90,
-	0x66, 0xc9, 0xdc, 0xcf, 0xa4, 0x74, 0x7d, 0xca, 0xd3, 0xd4, 0x3b, 0xe4, 0x82, 0xb4, 0xff, 0xa6,
-	0x81, 0xde, 0xe7, 0x3c, 0x61, 0x97, 0xa0, 0x10, 0x06, 0x96, 0x76, 0x47, 0x7b, 0x50, 0x75, 0x0b,
-	0x61, 0xc0, 0x2c, 0x28, 0x7b, 0x41, 0x90, 0xf0, 0x34, 0xb5, 0x0a, 0xc4, 0x54, 0x24, 0x63, 0xa0,
-	0x47, 0xde, 0x94, 0x5b, 0x45, 0x62, 0xd3, 0x9a, 0x5d, 0x83, 0x92, 0xf7, 0xca, 0xcb, 0xbc, 0xc4,
-	0xd2, 0x89, 0x2b, 0x29, 0x76, 0x1b, 0xca, 0x61, 0xb4, 0x1f, 0xbf, 0xe6, 0xa9, 0x65, 0xdc, 0x29,
-	0x3e, 0xa8, 0x6d, 0x19, 0x8d, 0x96, 0x77, 0xc0, 0x5d, 0xc5, 0x65, 0x3f, 0x86, 0xb2, 0x9f, 0x70,
-	0x2f, 0xe3, 0x81, 0x55, 0xba, 0xa3, 0x3d, 0xa8, 0x6d, 0x6d, 0x36, 0x84, 0xfb, 0x0d, 0xe5, 0x7e,
-	0x63, 0xa8, 0xee, 0xe7, 0x2a, 0x55, 0xdc, 0x35, 0x9f, 0x05, 0xb4, 0xab, 0xfc, 0xdf, 0x77, 0x49,
-	0x55, 0xfb, 0x3e, 0x54, 0xf0, 0xaa, 0x9d, 0x30, 0xcd, 0xd8, 0x0d, 0x30, 0xc2, 0x8c, 0x4f, 0x53,
-	0x4b, 0x93, 0x6e, 0xa1, 0xc4, 0x15, 0x3c, 0xbb, 0x03, 0xfa, 0x5e, 0xca, 0x93, 0x7c, 0x0c, 0xb4,
-	0xd3, 0x63, 0x50, 0x38, 0x35, 0x06, 0xc5, 0x7c, 0x0c, 0xec, 0xdf, 0x68, 0x50, 0x6e, 0xc5, 0x51,
-	0xe6, 0xf9, 0xd9, 0xbb, 0x39, 0x11, 0x9d, 0x9f, 0x71, 0x9e, 0xa4, 0x96, 0xbe, 0xe2, 0x3c, 0xf1,
-	0xd0, 0x44, 0x36, 0x4e, 0xb8, 0x17, 0x88, 0x90, 0x57, 0x5d, 0x45, 0xda, 0x3f, 0x84, 0x9a, 0xf4,
-	0x83, 0x42, 0xf0, 0xc1, 0x6a, 0x08, 0x2a, 0x0d, 0x29, 0x54, 0x51, 0xf8, 0xab, 0x0e, 0xa5, 0x21,
-	0x6d, 0x3d, 0x01, 0x0e, 0x13, 0x8a, 0x47, 0xfc, 0x58, 0xfa, 0x8a, 0x4b, 0xd4, 0x48, 0x8f, 0xc8,
-	0xcd, 0xba, 0x5b, 0x48, 0x8f, 0x16, 0xd7, 0xd1, 0x57, 0xaf, 0x93, 0xfa, 0x63, 0x3e, 0xf5, 0x2c,
-	0x43, 0x5c, 0x47, 0x50, 0xec, 0x26, 0x54, 0xc3, 0x28, 0xcc, 0x42, 0x2f, 0x8b, 0x13, 0x42, 0x41,
-	0xd5, 0x5d, 0x32, 0xd8, 0x1d, 0xd0, 0xb3, 0xe3, 0x19, 0xa7, 0x87, 0xbe, 0xb4, 0x55, 0x6f, 0x08,
-	0x97, 0x1a, 0xc3, 0xe3, 0x19, 0x77, 0x49, 0xc2, 0x1e, 0x42, 0x39, 0x1d, 0x7b, 0x49, 0x18, 0x1d,
-	0x5a, 0x15, 0x52, 0xda, 0x50, 0x4a, 0x03, 0xc1, 0x76, 0x95, 0x1c, 0x4d, 0x7d, 0x3b, 0x0e, 0x33,
-	0x3e, 0x09, 0xd3, 0xcc, 0xaa, 0x52, 0x78, 0x96, 0x0c, 0x76, 0x1f, 0x8c, 0x34, 0xf3, 0x32, 0x6e,
-	0x01, 0x1d, 0xb3, 0xbe, 0x38, 0x06, 0x99, 0xcf, 0x0a, 0x96, 0xe6, 0x0a, 0x39, 0xde, 0x6e, 0xcc,
-	0xbd, 0xc0, 0xaa, 0x89, 0xdb, 0xe1, 0x9a, 0xdd, 0x87, 0x1a, 0xfe, 0x1f, 0xed, 0x4f, 0x62, 0xff,
-	0x28, 0xb5, 0x38, 0x05, 0xb5, 0xd4, 0x78, 0x86, 0xa4, 0x0b, 0x28, 0xa2, 0x65, 0xca, 0xee, 0x41,
-	0x4d, 0x5c, 0x7c, 0x14, 0xc5, 0x01, 0xb7, 0x0e, 0x08, 0xc0, 0x46, 0xa3, 0x1b, 0x07, 0xdc, 0x05,
-	0x21, 0xc1, 0x35, 0xbb, 0x0d, 0x35, 0x3a, 0x6b, 0xe4, 0xc7, 0xf3, 0x28, 0xb3, 0x0e, 0xef, 0x68,
-	0x0f, 0x0c, 0x17, 0x88, 0xd5, 0x42, 0x0e, 0xbb, 0x05, 0x80, 0x4f, 0x2e, 0xe5, 0x63, 0x92, 0x57,
-	0x91, 0x43, 0x62, 0xfb, 0x29, 0xe8, 0x18, 0x24, 0x56, 0x83, 0x72, 0xdf, 0x6d, 0xbf, 0x6c, 0x0e,
-	0x1d, 0x73, 0x8d, 0xad, 0x43, 0xd5, 0x75, 0x9a, 0xdb, 0xa3, 0x5e, 0xb7, 0xf3, 0xa5, 0xa9, 0x31,
-	0x80, 0x52, 0x7f, 0xef, 0x59, 0xa7, 0xdd, 0x32, 0x0b, 0xac, 0x02, 0x7a, 0xaf, 0xef, 0x74, 0xcd,
-	0xa2, 0xfd, 0x53, 0x28, 0xcb, 0xc8, 0xb1, 0x4b, 0x00, 0xdd, 0xde, 0x70, 0x34, 0x78, 0xd1, 0x74,
-	0x9d, 0x6d, 0x73, 0x8d, 0x6d, 0x40, 0xad, 0xdd, 0x7d, 0xd9, 0x1e, 0x3a, 0xb9, 0x13, 0xa4, 0xb0,
-	0x60, 0x3f, 0x01, 0x83, 0x42, 0xc5, 0x4c, 0xa8, 0x77, 0x7a, 0xcd, 0xed, 0x76, 0x77, 0x67, 0x34,
-	0x6c, 0xb6, 0x3b, 0xe6, 0x1a, 0xaa, 0x21, 0xc7, 0xd9, 0x36, 0xb5, 0xbc, 0xf4, 0x85, 0xd3, 0xc4,
-	0x8d, 0x8f, 0x00, 0x44, 0xa8, 0x09, 0x98, 0xb7, 0x56, 0x81, 0x59, 0x96, 0xcf, 0xa0, 0x70, 0xd9,
-	0x57, 0xca, 0xa7, 0xd6, 0xad, 0x6b, 0x50, 0x12, 0x78, 0x97, 0xe8, 0x94, 0x14, 0xdb, 0x84, 0xca,
-	0xb7, 0x7c, 0xe2, 0xc7, 0x53, 0x1e, 0x10, 0x4c, 0x2b, 0xee, 0x82, 0xb6, 0xff, 0xa0, 0x83, 0x41,
-	0x8f, 0x73, 0xee, 0xd3, 0x30, 0x33, 0xe7, 0xd9, 0x38, 0x5e, 0x66, 0x26, 0x51, 0xec, 0x07, 0x12,
-	0xac, 0x3a, 0x01, 0xc8, 0x14, 0xaf, 0x2f, 0xfe, 0xe6, 0x00, 0xdb, 0x00, 0x1d, 0x2b, 0x12, 0xa5,
-	0xc1, 0xd9, 0xb5, 0x8b, 0xf4, 0x30, 0xa5, 0x67, 0x5e, 0xc2, 0xa3, 0x2c, 0xb5, 0x4a, 0x22, 0xa5,
-	0x25, 0x49, 0xfe, 0x79, 0xc9, 0x21, 0xcf, 0x28, 0x3d, 0xd0, 0x3f, 0xa2, 0x10, 0xa0, 0x81, 0x97,
-	0x79, 0x56, 0x55, 0x00, 0x14, 0xd7, 0xc8, 0xdb, 0x8f, 0x83, 0x63, 0xca, 0x91, 0xaa, 0x4b, 0x6b,
-	0xf6, 0x31, 0x94, 0x10, 0xd1, 0xf3, 0x54, 0x42, 0x9e, 0xe5, 0x3d, 0x1e, 0x90, 0xc4, 0x95, 0x1a,
-	0x18, 0x41, 0x2f, 0xcb, 0xf8, 0x74, 0x96, 0xa5, 0x04, 0x7c, 0xc3, 0x5d, 0xd0, 0xec, 0x3a, 0xe8,
-	0xf3, 0x94, 0x27, 0x16, 0x97, 0x60, 0xc6, 0xf2, 0xe9, 0x12, 0xcb, 0xfe, 0x9d, 0x06, 0xd5, 0x45,
-	0x00, 0xd8, 0x3a, 0x18, 0xbb, 0x8e, 0xbb, 0xe3, 0x98, 0x6b, 0x9b, 0x85, 0x0a, 0xa1, 0xa7, 0xbd,
-	0xd3, 0xed, 0xb9, 0x8e, 0xa9, 0x21, 0xfe, 0x9e, 0x77, 0x9a, 0x3b, 0x02, 0x89, 0x3f, 0xef, 0xb5,
-	0xbb, 0x66, 0x91, 0xd5, 0xa1, 0xd2, 0xec, 0x76, 0x7b, 0x7b, 0xdd, 0x96, 0x63, 0xea, 0xac, 0x0a,
-	0x46, 0xc7, 0x69, 0xbe, 0x74, 0x4c, 0x03, 0x55, 0x86, 0xce, 0x17, 0x43, 0xb3, 0x84, 0xcc, 0xe7,
-	0xed, 0x8e, 0x33, 0x30, 0xcb, 0x6c, 0x03, 0xca, 0xad, 0xde, 0xee, 0xae, 0xd3, 0x1d, 0x9a, 0x15,
-	0x3a, 0xbe, 0x02, 0x7a, 0xa7, 0xfd, 0x0b, 0xc7, 0xac, 0xb2, 0x32, 0x14, 0x9b, 0xdb, 0xdb, 0xe6,
-	0x96, 0xfd, 0x23, 0xa8, 0xe5, 0x2e, 0x87, 0xbb, 0x31, 0x1f, 0xbe, 0x14, 0x10, 0xfd, 0xe5, 0x9e,
-	0xb3, 0x47, 0x10, 0xc5, 0x9c, 0x71, 0xba, 0x08, 0x51, 0xb3, 0x60, 0x3f, 0x94, 0x17, 0x20, 0x70,
-	0xde, 0x5c, 0x05, 0xa7, 0x4a, 0x70, 0x89, 0xcd, 0xef, 0xa1, 0x4e, 0xf4, 0xae, 0x68, 0xb2, 0x27,
-	0xf0, 0xc4, 0x40, 0xc7, 0x04, 0x55, 0x55, 0x1e, 0xd7, 0xec, 0x06, 0x14, 0x79, 0xf4, 0x8a, 0x80,
-	0x54, 0xdb, 0xaa, 0x36, 0x9c, 0xe8, 0x15, 0x9f, 0xc4, 0x33, 0xee, 0x22, 0x77, 0x01, 0x15, 0xfd,
-	0x7c, 0x50, 0xb1, 0xff, 0xa8, 0x41, 0xa9, 0x1d, 0xbd, 0x0a, 0xb3, 0x93, 0xb6, 0xaf, 0x82, 0x41,
-	0xc5, 0x83, 0x8c, 0xd7, 0x5d, 0x41, 0x9c, 0xda, 0xcd, 0xa9, 0x6b, 0xe3, 0x19, 0x89, 0xb4, 0x2b,
-	0x3b, 0x8c, 0xe2, 0xbe, 0x3b, 0x00, 0x63, 0xe6, 0x0b, 0x77, 0x4f, 0xcf, 0x7c, 0x21, 0x53, 0xd1,
-	0xfd, 0x73, 0x01, 0xaa, 0xcf, 0xc3, 0x09, 0x6f, 0x47, 0x01, 0x7f, 0x8d, 0x9e, 0x4f, 0xc3, 0xc9,
-	0x44, 0xde, 0x90, 0xd6, 0x88, 0x51, 0x7f, 0xcc, 0xfd, 0xa3, 0x74, 0x3e, 0x95, 0x31, 0x5e, 0xd0,
-	0xd4, 0x7e, 0xe2, 0x79, 0xe2, 0xab, 0xbb, 0x4a, 0x0a, 0xcf, 0x89, 0x11, 0xd3, 0xb2, 0x55, 0xe1,
-	0x9a, 0x0a, 0xbc, 0x97, 0x8e, 0x65, 0xa3, 0xa2, 0xb5, 0x6a, 0x7a, 0xa5, 0x65, 0xd3, 0xbb, 0x0a,
-	0xc6, 0x94, 0x07, 0xa1, 0x27, 0x93, 0x4f, 0x10, 0x8b, 0x88, 0x56, 0x72, 0x11, 0x65, 0xa0, 0xa7,
-	0xe1, 0x77, 0x9c, 0xf2, 0xb1, 0xe8, 0xd2, 0x9a, 0x7d, 0x06, 0x86, 0x17, 0x04, 0x3c, 0xa0, 0xd4,
-	0x3b, 0x3b, 0x8a, 0x42, 0x91, 0x3d, 0x02, 0x7d, 0xca, 0x33, 0x8f, 0xb2, 0xaf, 0xb6, 0xf5, 0xfe,
-	0x89, 0x0d, 0x03, 0x1a, 0xf4, 0x5c, 0x52, 0xa2, 0x39, 0x80, 0x8a, 0x41, 0x6a, 0xd5, 0xe5, 0x1c,
-	0x20, 0x48, 0xfb, 0x2f, 0x05, 0xd0, 0xa9, 0xc3, 0x28, 0x4f, 0xb5, 0x9c, 0xa7, 0x26, 0x14, 0x67,
-	0x61, 0x44, 0xc1, 0xab, 0xb8, 0xb8, 0xc4, 0x9e, 0x39, 0x9b, 0x78, 0x61, 0x94, 0xf1, 0xd7, 0x99,
-	0x2c, 0x9d, 0x4b, 0xc6, 0xe2, 0x15, 0xf4, 0xdc, 0x2b, 0xdc, 0x95, 0x11, 0x15, 0x23, 0xdf, 0x06,
-	0xb5, 0xb6, 0x46, 0x6f, 0x96, 0xa5, 0x4e, 0x94, 0x25, 0xc7, 0x32, 0xc4, 0x4f, 0xa1, 0xf6, 0x75,
-	0x1a, 0x47, 0x23, 0x39, 0x12, 0x94, 0xce, 0xbe, 0x13, 0xa0, 0xee, 0x40, 0xcc, 0x0b, 0xf7, 0xc0,
-	0x98, 0x84, 0xd1, 0x51, 0x6a, 0x55, 0xe8, 0x7c, 0x53, 0x9c, 0xdf, 0x41, 0x96, 0x30, 0x20, 0xc4,
-	0x9b, 0x4f, 0xa0, 0xba, 0x30, 0xaa, 0x5e, 0x4f, 0x5b, 0x79, 0xbd, 0x57, 0xde, 0x64, 0xae, 0x46,
-	0x2e, 0x41, 0x7c, 0x5e, 0x78, 0xaa, 0x6d, 0xfe, 0x0c, 0x60, 0x79, 0xda, 0x29, 0x3b, 0x6f, 0xe4,
-	0x77, 0x62, 0x76, 0xa0, 0x76, 0xee, 0x00, 0xfb, 0x1f, 0x1a, 0xe8, 0xc8, 0xc3, 0xbd, 0xf3, 0x54,
-	0x05, 0x18, 0x97, 0xff, 0x97, 0xf8, 0xa2, 0xa9, 0x77, 0x17, 0xdf, 0xff, 0x39, 0x6e, 0xf6, 0xdf,
-	0x8b, 0x50, 0xef, 0xc6, 0x59, 0x78, 0x10, 0xfa, 0x5e, 0x16, 0xc6, 0xd1, 0x89, 0x12, 0xa4, 0xea,
-	0x46, 0xe1, 0x9c, 0x75, 0xe3, 0x2a, 0x18, 0x9e, 0x9f, 0x2d, 0xba, 0xac, 0x20, 0x10, 0xd9, 0xe9,
-	0x7c, 0xff, 0x6b, 0xee, 0x67, 0x32, 0x2a, 0x8a, 0x64, 0x1f, 0x42, 0x5d, 0x2e, 0x47, 0x01, 0x4f,
-	0x7d, 0x99, 0xbe, 0x35, 0xc9, 0xdb, 0xe6, 0xa9, 0xbf, 0xac, 0x82, 0x22, 0x8f, 0x65, 0x15, 0x7c,
-	0x5b, 0x1f, 0xbd, 0x27, 0xfb, 0x79, 0x45, 0x76, 0xc7, 0xfc, 0xed, 0xf2, 0x23, 0xa8, 0xea, 0xad,
-	0xd5, 0x5c, 0x6f, 0x65, 0xa0, 0xd3, 0xe4, 0x00, 0xf4, 0xa4, 0xb4, 0x3e, 0xab, 0x4f, 0xfe, 0x49,
-	0x93, 0xf3, 0xda, 0x15, 0xd8, 0x90, 0x23, 0x96, 0xeb, 0xb4, 0x9c, 0xf6, 0x4b, 0x9a, 0xbb, 0xde,
-	0x87, 0x2b, 0xcd, 0x56, 0xab, 0xb7, 0xd7, 0x1d, 0x8e, 0xfa, 0x8e, 0xe3, 0x8e, 0xb0, 0x3f, 0x52,
-	0xa7, 0x7a, 0x0f, 0x2e, 0xaf, 0x08, 0x3a, 0xce, 0xf3, 0xa1, 0x59, 0xc1, 0x39, 0x2d, 0xaf, 0x57,
-	0xc0, 0xc1, 0x6f, 0x29, 0x2f, 0xb2, 0xcb, 0xb0, 0xbe, 0xeb, 0x0c, 0x06, 0xcd, 0x1d, 0x67, 0xd4,
-	0xdc, 0xc6, 0xb1, 0x4c, 0xc7, 0x2d, 0xd4, 0x48, 0x25, 0xc3, 0x40, 0x1d, 0xd9, 0x4e, 0x25, 0xab,
-	0x84, 0xe3, 0x20, 0x36, 0x54, 0x49, 0x97, 0xed, 0x27, 0x60, 0xe6, 0x43, 0x42, 0x45, 0xfc, 0xee,
-	0x6a, 0x11, 0x5f, 0x5f, 0x09, 0x9a, 0x2a, 0xe5, 0xbf, 0xd5, 0x40, 0xc7, 0x2f, 0xc1, 0x45, 0x47,
-	0xd4, 0x72, 0x1d, 0xf1, 0xed, 0xdf, 0x9e, 0x26, 0x14, 0xbd, 0x59, 0x28, 0xe1, 0x80, 0x4b, 0xac,
-	0xf8, 0x04, 0x1f, 0x3f, 0x56, 0x39, 0xb2, 0xa0, 0xa9, 0xbe, 0xe1, 0x88, 0x2d, 0xab, 0x38, 0xae,
-	0x29, 0x23, 0x93, 0x89, 0xaa, 0xe2, 0xf3, 0x64, 0x62, 0xff, 0x53, 0x83, 0x1a, 0xba, 0x32, 0xe0,
-	0x69, 0x7a, 0x1a, 0x68, 0x71, 0xd6, 0xf3, 0xfd, 0xa5, 0x33, 0x92, 0x62, 0x9f, 0x40, 0x91, 0xbf,
-	0x9e, 0xc9, 0xbe, 0x7d, 0x16, 0x96, 0x51, 0x0d, 0xef, 0x94, 0xf0, 0x83, 0x84, 0xa7, 0x63, 0x05,
-	0x5a, 0x49, 0x62, 0x52, 0x24, 0x78, 0xd0, 0x39, 0x9a, 0x69, 0x22, 0x4f, 0x52, 0xf0, 0x2f, 0xad,
-	0xc2, 0x9f, 0xe5, 0x3e, 0x95, 0xaa, 0x12, 0x99, 0xd7, 0x41, 0xf7, 0xbd, 0x03, 0x81, 0xe0, 0xc5,
-	0xe7, 0x37, 0xb1, 0xec, 0x9f, 0xc0, 0x46, 0xee, 0xde, 0xf4, 0x76, 0xf6, 0xea, 0xdb, 0xd5, 0x1b,
-	0x39, 0x05, 0xf5, 0x74, 0xbf, 0xd7, 0x45, 0xbc, 0x5c, 0xfe, 0xcd, 0x9c, 0xa7, 0xd9, 0xb9, 0x66,
-	0x9c, 0x65, 0x7e, 0x15, 0x57, 0xf2, 0x4b, 0x79, 0xa7, 0x9f, 0xf0, 0x0e, 0x13, 0xf5, 0x30, 0x89,
-	0xe7, 0x33, 0xd9, 0x47, 0x05, 0x81, 0xdf, 0x3c, 0xe9, 0x71, 0xe4, 0x8f, 0x84, 0x08, 0xc4, 0xc7,
-	0x22, 0x72, 0x76, 0x48, 0xfc, 0x91, 0x8c, 0x80, 0x41, 0xf9, 0x7a, 0xb9, 0x91, 0xf3, 0xb3, 0x71,
-	0xca, 0x00, 0x5e, 0x3a, 0x67, 0x1d, 0x52, 0xed, 0xbb, 0x9c, 0x6b, 0xdf, 0x8f, 0x16, 0xa3, 0x73,
-	0x95, 0x8c, 0x5d, 0x59, 0x31, 0x76, 0x81, 0xd9, 0xf9, 0x16, 0x00, 0xdd, 0x66, 0x44, 0x26, 0xea,
-	0x64, 0xa2, 0x4a, 0x9c, 0x81, 0xb0, 0x73, 0x59, 0x88, 0xb3, 0xc4, 0x8b, 0xd2, 0x03, 0x9e, 0x24,
-	0x3c, 0xb0, 0xd6, 0x49, 0xcb, 0x24, 0xc1, 0x70, 0xc9, 0xb7, 0x7b, 0xb2, 0x86, 0x54, 0xc1, 0x18,
-	0x0c, 0x71, 0xac, 0x5e, 0xc3, 0x51, 0x76, 0xaf, 0x2b, 0x88, 0x22, 0x7e, 0x7a, 0xd1, 0x72, 0x34,
-	0x7c, 0x81, 0x63, 0xaf, 0xa9, 0x31, 0x06, 0x97, 0xa4, 0x58, 0xf1, 0x68, 0xce, 0x6e, 0x77, 0x9f,
-	0xf5, 0xbe, 0x30, 0x0b, 0xf6, 0x27, 0x50, 0x92, 0x93, 0x72, 0x19, 0x8a, 0x5d, 0xe7, 0x57, 0xe2,
-	0x40, 0x35, 0x1b, 0x6b, 0x38, 0xa0, 0xb7, 0x7a, 0xbb, 0xfd, 0x8e, 0x33, 0x74, 0xcc, 0x82, 0x42,
-	0x94, 0x0c, 0xc2, 0xdb, 0x11, 0x25, 0x15, 0x14, 0xa2, 0xfe, 0x55, 0x80, 0x2b, 0x04, 0x34, 0xf5,
-	0x8e, 0xd2, 0xe4, 0x9b, 0xc8, 0xba, 0x01, 0xd5, 0x68, 0x3e, 0x1d, 0x65, 0x71, 0xe6, 0x4d, 0x08,
-	0x5e, 0x86, 0x5b, 0x89, 0xe6, 0xd3, 0x21, 0xd2, 0xf8, 0xb9, 0x8c, 0xc2, 0x19, 0x8f, 0x82, 0x30,
-	0x3a, 0x24, 0x9c, 0x19, 0x2e, 0x44, 0xf3, 0x69, 0x5f, 0x70, 0xb0, 0x39, 0xa0, 0x82, 0x1f, 0x4f,
-	0x67, 0x13, 0x2e, 0x47, 0x6a, 0xc3, 0xc5, 0x4d, 0x2d, 0xc9, 0x22, 0x74, 0x85, 0xdf, 0x71, 0x69,
-	0xc1, 0x10, 0x4f, 0x81, 0x1c, 0x61, 0x02, 0xdb, 0x0b, 0x8a, 0x95, 0x8d, 0x12, 0x29, 0xd4, 0x90,
-	0xa7, 0x8c, 0xdc, 0x85, 0x75, 0x52, 0x59, 0x58, 0x11, 0x90, 0xa1, 0x7d, 0x0b, 0x33, 0x1f, 0xcb,
-	0x27, 0x4d, 0x47, 0x39, 0x6b, 0x15, 0x52, 0xdc, 0x10, 0x82, 0xc1, 0xc2, 0xe6, 0x67, 0x70, 0x35,
-	0xaf, 0xbb, 0x38, 0x57, 0x4c, 0x92, 0x6c, 0xa9, 0xbe, 0x38, 0xfd, 0x2a, 0x18, 0x3c, 0x49, 0xe2,
-	0xc4, 0xda, 0x12, 0x89, 0x43, 0x04, 0xbb, 0x0e, 0x15, 0x5a, 0x8c, 0xc2, 0xc0, 0x7a, 0x2c, 0xca,
-	0x06, 0xd1, 0xed, 0xc0, 0xfe, 0xb7, 0x26, 0x9e, 0xed, 0xc5, 0x70, 0xd8, 0x57, 0x49, 0xfd, 0x50,
-	0x26, 0x92, 0x46, 0xd8, 0x7e, 0xaf, 0xf1, 0x86, 0x3c, 0x9f, 0x4c, 0xb2, 0xa2, 0x16, 0x16, 0x15,
-	0x95, 0x3d, 0x81, 0xf2, 0x98, 0x7b, 0x01, 0x4f, 0x52, 0xab, 0x48, 0xaf, 0x7e, 0xeb, 0xc4, 0xfe,
-	0x17, 0x42, 0x2e, 0x06, 0x16, 0xa5, 0x4d, 0xa5, 0xc3, 0xcb, 0x54, 0x85, 0xa4, 0xf5, 0xe6, 0xe7,
-	0x50, 0xcf, 0x2b, 0x5f, 0x68, 0x20, 0xf9, 0x48, 0xa6, 0x43, 0x19, 0x8a, 0xfd, 0xbd, 0xa1, 0xb9,
-	0x86, 0x1f, 0x84, 0xfd, 0xde, 0x60, 0x28, 0x7e, 0xb7, 0xd8, 0x76, 0x24, 0x6c, 0x7f, 0x2d, 0x0a,
-	0xda, 0x45, 0x3e, 0xda, 0x54, 0x05, 0x29, 0x9e, 0xb3, 0x82, 0xe4, 0x0b, 0x80, 0xbe, 0x5a, 0x00,
-	0xec, 0x6f, 0x44, 0xf8, 0x5b, 0x93, 0x90, 0x47, 0x59, 0x37, 0x8e, 0x7c, 0xbe, 0xbc, 0x92, 0x96,
-	0xbb, 0xd2, 0x19, 0x7d, 0xf1, 0x82, 0xee, 0xe0, 0xb0, 0x01, 0x4b, 0x9b, 0x17, 0xf8, 0xf1, 0x37,
-	0xf7, 0x7b, 0x6d, 0xf1, 0xfc, 0xbf, 0xd7, 0x36, 0x40, 0x4f, 0x39, 0x8f, 0xce, 0xf3, 0x15, 0x8b,
-	0x7a, 0x78, 0xfd, 0x2c, 0x3e, 0xe2, 0x91, 0xec, 0xdc, 0x82, 0xb0, 0x1f, 0xc3, 0xa5, 0xa5, 0xcf,
-	0x54, 0x5c, 0x3e, 0x5c, 0x2d, 0x2e, 0xb5, 0xc6, 0x52, 0xae, 0x6a, 0x8b, 0x07, 0x55, 0x64, 0x0e,
-	0xf1, 0x84, 0xd3, 0x3e, 0x89, 0x97, 0xc8, 0xa9, 0xab, 0x30, 0x5f, 0x34, 0x98, 0x5f, 0x81, 0xb9,
-	0xb4, 0xfb, 0x96, 0x5f, 0x4c, 0xaf, 0x41, 0xc9, 0x27, 0xb9, 0x1a, 0x22, 0x04, 0xc5, 0x3e, 0x00,
-	0xf0, 0xc3, 0xd9, 0x98, 0x27, 0x8b, 0xe9, 0xbf, 0xee, 0xe6, 0x38, 0xf6, 0xf7, 0x70, 0x79, 0x79,
-	0xf6, 0x45, 0x00, 0xba, 0x34, 0x58, 0x5c, 0x31, 0x78, 0xc1, 0x1f, 0x14, 0x9e, 0x5d, 0x81, 0xf5,
-	0x30, 0x6e, 0xa0, 0x2f, 0x21, 0xaa, 0xed, 0x7f, 0x55, 0x98, 0xed, 0xef, 0x97, 0x48, 0xfd, 0xf1,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xe1, 0xc7, 0xef, 0x99, 0x18, 0x00, 0x00,
+	proto.RegisterEnum("CafeClientGC_Action", CafeClientGC_Action_name, CafeClientGC_Action_value)
+	proto.RegisterEnum("CafeToken_Scope", CafeToken_Scope_name, CafeToken_Scope_value)
+	proto.RegisterEnum("CafeReplication_Type", CafeReplication_Type_name, CafeReplication_Type_value)
+}
+
+func init() { proto.RegisterFile("model.proto", fileDescriptor_model_19ebed94e7761565) }
+
+var fileDescriptor_model_19ebed94e7761565 = []byte{
+	// 3672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x73, 0xe3, 0x46,
+	0x76, 0x1f, 0x10, 0x00, 0x3f, 0x1e, 0xa9, 0x11, 0x06, 0x23, 0xdb, 0xf4, 0xf8, 0x6b, 0x0c, 0xef,
+	0x8e, 0x67, 0xd6, 0x0e, 0x76, 0x23, 0x67, 0xd7, 0x2e, 0x6f, 0x25, 0x2e, 0x0e, 0x85, 0xd1, 0x30,
+	0x43, 0x91, 0x72, 0x93, 0x9c, 0xb5, 0xf7, 0xc2, 0x82, 0xc8, 0x96, 0x88, 0x15, 0x09, 0xd0, 0x00,
+	0x28, 0x4b, 0x5b, 0x95, 0xec, 0x25, 0x95, 0xca, 0x2d, 0x39, 0xee, 0x2d, 0xff, 0x40, 0xf2, 0x0f,
+	0xec, 0x21, 0x7f, 0x45, 0x8e, 0xa9, 0x4a, 0xe5, 0x98, 0x4b, 0xce, 0x49, 0x55, 0x2a, 0x95, 0x7a,
+	0xaf, 0xbb, 0x01, 0x50, 0xa2, 0x66, 0xa4, 0x94, 0x73, 0x61, 0xf5, 0xfb, 0xe8, 0xaf, 0xd7, 0xef,
+	0xbd, 0xfe, 0xbd, 0x06, 0xa1, 0xbe, 0x88, 0xa6, 0x7c, 0xee, 0x2e, 0xe3, 0x28, 0x8d, 0x1e, 0x7c,
+	0x70, 0x12, 0x45, 0x27, 0x73, 0xfe, 0x53, 0xa2, 0x8e, 0x56, 0xc7, 0x3f, 0x4d, 0x83, 0x05, 0x4f,
+	0x52, 0x7f, 0xb1, 0x94, 0x0a, 0xef, 0x5e, 0x56, 0x48, 0xd2, 0x78, 0x35, 0x49, 0xa5, 0x74, 0x6b,
+	0xc1, 0x93, 0xc4, 0x3f, 0xe1, 0x82, 0x74, 0xfe, 0x5d, 0x03, 0xe3, 0x90, 0xf3, 0xd8, 0xbe, 0x0b,
+	0xa5, 0x60, 0xda, 0xd4, 0x1e, 0x6a, 0x8f, 0x6b, 0xac, 0x14, 0x4c, 0xed, 0x26, 0x54, 0xfc, 0xe9,
+	0x34, 0xe6, 0x49, 0xd2, 0x2c, 0x11, 0x53, 0x91, 0xb6, 0x0d, 0x46, 0xe8, 0x2f, 0x78, 0x53, 0x27,
+	0x36, 0xb5, 0xed, 0x37, 0xa1, 0xec, 0x9f, 0xf9, 0xa9, 0x1f, 0x37, 0x0d, 0xe2, 0x4a, 0xca, 0xfe,
+	0x00, 0x2a, 0x41, 0x78, 0x14, 0x9d, 0xf3, 0xa4, 0x69, 0x3e, 0xd4, 0x1f, 0xd7, 0x77, 0x4d, 0xb7,
+	0xed, 0x1f, 0x73, 0xa6, 0xb8, 0xf6, 0x9f, 0x40, 0x65, 0x12, 0x73, 0x3f, 0xe5, 0xd3, 0x66, 0xf9,
+	0xa1, 0xf6, 0xb8, 0xbe, 0xfb, 0xc0, 0x15, 0xcb, 0x77, 0xd5, 0xf2, 0xdd, 0xa1, 0xda, 0x1f, 0x53,
+	0xaa, 0xd8, 0x6b, 0xb5, 0x9c, 0x52, 0xaf, 0xca, 0xeb, 0x7b, 0x49, 0x55, 0xe7, 0x63, 0xa8, 0xe2,
+	0x56, 0xbb, 0x41, 0x92, 0xda, 0xef, 0x80, 0x19, 0xa4, 0x7c, 0x91, 0x34, 0x35, 0xb9, 0x2c, 0x94,
+	0x30, 0xc1, 0x73, 0xba, 0x60, 0x8c, 0x12, 0x1e, 0x17, 0x6d, 0xa0, 0x6d, 0xb6, 0x41, 0x69, 0xa3,
+	0x0d, 0xf4, 0xa2, 0x0d, 0x9c, 0xbf, 0xd6, 0xa0, 0xd2, 0x8e, 0xc2, 0xd4, 0x9f, 0xa4, 0x3f, 0xcc,
+	0x88, 0xb8, 0xf8, 0x25, 0xe7, 0x71, 0xd2, 0x34, 0xd6, 0x16, 0x4f, 0x3c, 0x9c, 0x22, 0x9d, 0xc5,
+	0xdc, 0x9f, 0x0a, 0x93, 0xd7, 0x98, 0x22, 0x9d, 0x3f, 0x82, 0xba, 0x5c, 0x07, 0x99, 0xe0, 0xfd,
+	0x75, 0x13, 0x54, 0x5d, 0x29, 0x54, 0x56, 0xf8, 0x1b, 0x13, 0xca, 0x43, 0xea, 0x7a, 0xc5, 0x39,
+	0x2c, 0xd0, 0x4f, 0xf9, 0x85, 0x5c, 0x2b, 0x36, 0x51, 0x23, 0x39, 0xa5, 0x65, 0x36, 0x58, 0x29,
+	0x39, 0xcd, 0xb6, 0x63, 0xac, 0x6f, 0x27, 0x99, 0xcc, 0xf8, 0xc2, 0x6f, 0x9a, 0x62, 0x3b, 0x82,
+	0xb2, 0xdf, 0x85, 0x5a, 0x10, 0x06, 0x69, 0xe0, 0xa7, 0x51, 0x4c, 0x5e, 0x50, 0x63, 0x39, 0xc3,
+	0x7e, 0x08, 0x46, 0x7a, 0xb1, 0xe4, 0x74, 0xd0, 0x77, 0x77, 0x1b, 0xae, 0x58, 0x92, 0x3b, 0xbc,
+	0x58, 0x72, 0x46, 0x12, 0xfb, 0x09, 0x54, 0x92, 0x99, 0x1f, 0x07, 0xe1, 0x49, 0xb3, 0x4a, 0x4a,
+	0xdb, 0x4a, 0x69, 0x20, 0xd8, 0x4c, 0xc9, 0x71, 0xaa, 0xef, 0x67, 0x41, 0xca, 0xe7, 0x41, 0x92,
+	0x36, 0x6b, 0x64, 0x9e, 0x9c, 0x61, 0x7f, 0x0c, 0x66, 0x92, 0xfa, 0x29, 0x6f, 0x02, 0x0d, 0xb3,
+	0x95, 0x0d, 0x83, 0xcc, 0xa7, 0xa5, 0xa6, 0xc6, 0x84, 0x1c, 0x77, 0x37, 0xe3, 0xfe, 0xb4, 0x59,
+	0x17, 0xbb, 0xc3, 0xb6, 0xfd, 0x3e, 0x18, 0xa7, 0xfc, 0x22, 0x69, 0x36, 0xc8, 0x9a, 0x20, 0xfb,
+	0xbe, 0xe0, 0x17, 0x8c, 0xf8, 0xf6, 0xc7, 0x50, 0x47, 0xbd, 0xf1, 0xd1, 0x3c, 0x9a, 0x9c, 0x26,
+	0x4d, 0x4e, 0x6a, 0x65, 0xf7, 0x29, 0x92, 0x0c, 0x50, 0x44, 0xcd, 0xc4, 0x7e, 0x04, 0x75, 0x61,
+	0x98, 0x71, 0x18, 0x4d, 0x79, 0xf3, 0x98, 0x1c, 0xdc, 0x74, 0x7b, 0xd1, 0x94, 0x33, 0x10, 0x12,
+	0x6c, 0xdb, 0x1f, 0x40, 0x9d, 0xc6, 0x1a, 0x4f, 0xa2, 0x55, 0x98, 0x36, 0x4f, 0x1e, 0x6a, 0x8f,
+	0x4d, 0x06, 0xc4, 0x6a, 0x23, 0xc7, 0x7e, 0x0f, 0x00, 0x5d, 0x42, 0xca, 0x67, 0x24, 0xaf, 0x21,
+	0x87, 0xc4, 0xce, 0x17, 0x60, 0xa0, 0x11, 0xed, 0x3a, 0x54, 0x0e, 0x59, 0xe7, 0x65, 0x6b, 0xe8,
+	0x59, 0x77, 0xec, 0x2d, 0xa8, 0x31, 0xaf, 0xb5, 0x37, 0xee, 0xf7, 0xba, 0xdf, 0x5a, 0x9a, 0x0d,
+	0x50, 0x3e, 0x1c, 0x3d, 0xed, 0x76, 0xda, 0x56, 0xc9, 0xae, 0x82, 0xd1, 0x3f, 0xf4, 0x7a, 0x96,
+	0xee, 0xfc, 0x02, 0x2a, 0xd2, 0xb2, 0xf6, 0x5d, 0x80, 0x5e, 0x7f, 0x38, 0x1e, 0x3c, 0x6f, 0x31,
+	0x6f, 0xcf, 0xba, 0x63, 0x6f, 0x43, 0xbd, 0xd3, 0x7b, 0xd9, 0x19, 0x7a, 0x85, 0x11, 0xa4, 0xb0,
+	0xe4, 0x7c, 0x0e, 0x26, 0x99, 0xd2, 0xb6, 0xa0, 0xd1, 0xed, 0xb7, 0xf6, 0x3a, 0xbd, 0xfd, 0xf1,
+	0xb0, 0xd5, 0xe9, 0x5a, 0x77, 0x50, 0x0d, 0x39, 0xde, 0x9e, 0xa5, 0x15, 0xa5, 0xcf, 0xbd, 0x16,
+	0x76, 0xfc, 0x04, 0x40, 0x98, 0x93, 0x1c, 0xf7, 0xbd, 0x75, 0xc7, 0xad, 0x48, 0x53, 0x2b, 0xbf,
+	0x3d, 0x54, 0xca, 0x1b, 0xf3, 0xda, 0x9b, 0x50, 0x16, 0xf1, 0x20, 0xbd, 0x57, 0x52, 0xf6, 0x03,
+	0xa8, 0x7e, 0xcf, 0xe7, 0x93, 0x68, 0xc1, 0xa7, 0xe4, 0xc6, 0x55, 0x96, 0xd1, 0xce, 0x5f, 0x69,
+	0xd0, 0x10, 0x43, 0x0e, 0x84, 0xc7, 0xe6, 0x83, 0x68, 0x6b, 0x83, 0x34, 0xa1, 0x72, 0xc6, 0xe3,
+	0x24, 0x88, 0x42, 0x1a, 0xdd, 0x64, 0x8a, 0x24, 0x8f, 0xf1, 0x93, 0x99, 0x4a, 0x9a, 0xd8, 0xb6,
+	0x5d, 0x30, 0x30, 0x31, 0x35, 0x8d, 0xd7, 0xa6, 0x30, 0xd2, 0x73, 0x3e, 0x07, 0xab, 0xb8, 0x0a,
+	0xb2, 0xc5, 0x47, 0xeb, 0xb6, 0xd8, 0x72, 0x8b, 0x1a, 0xca, 0x22, 0x7f, 0xab, 0x41, 0x2d, 0x73,
+	0xc7, 0x6b, 0x17, 0xbf, 0x03, 0x26, 0x5f, 0x46, 0x93, 0x99, 0x5c, 0xba, 0x20, 0xae, 0x04, 0xf6,
+	0x0e, 0x98, 0xe4, 0x62, 0x32, 0xb2, 0x05, 0x91, 0x6d, 0xc5, 0xbc, 0xe1, 0x56, 0xfe, 0x18, 0xb6,
+	0xb2, 0x05, 0xd1, 0x3e, 0x1e, 0xae, 0xef, 0xa3, 0x18, 0x3e, 0x6a, 0x13, 0x25, 0x75, 0x08, 0x07,
+	0x7c, 0x71, 0xc4, 0xe3, 0x57, 0x1d, 0xc2, 0x35, 0x37, 0xd7, 0x23, 0x30, 0xe2, 0x68, 0x2e, 0x6e,
+	0xae, 0xbb, 0xbb, 0xb6, 0x5b, 0x1c, 0xce, 0x65, 0xd1, 0x9c, 0x33, 0x92, 0xe3, 0x08, 0x31, 0x5f,
+	0x44, 0x67, 0x7c, 0x4a, 0xbb, 0xac, 0x32, 0x45, 0xde, 0x76, 0x9f, 0xb9, 0xb5, 0xca, 0x05, 0x6b,
+	0x39, 0x1e, 0x18, 0x38, 0x1b, 0x46, 0xde, 0x9e, 0xf7, 0xac, 0x35, 0xea, 0x0e, 0x45, 0x04, 0x60,
+	0xe4, 0x79, 0xcc, 0xd2, 0x30, 0x0a, 0x5b, 0xbd, 0x5e, 0x7f, 0xd8, 0x1a, 0xf6, 0x99, 0x55, 0x42,
+	0xd1, 0xaf, 0x58, 0x67, 0xe8, 0x31, 0x4b, 0xb7, 0x6b, 0x60, 0xb6, 0xf6, 0x0e, 0x3a, 0x3d, 0xcb,
+	0x70, 0xfe, 0x50, 0x02, 0xbb, 0xb8, 0x85, 0xf6, 0xcc, 0x0f, 0x4f, 0x0a, 0x73, 0x6a, 0xc5, 0x13,
+	0xba, 0xce, 0xef, 0x0b, 0xd6, 0xd2, 0xd7, 0xad, 0xb5, 0x03, 0xe6, 0x24, 0x3b, 0x69, 0x9d, 0x09,
+	0xe2, 0xd6, 0x16, 0x50, 0x36, 0x2f, 0xbf, 0xc6, 0xe6, 0x7f, 0x06, 0xb0, 0x20, 0x66, 0x32, 0x0b,
+	0x96, 0x32, 0xd9, 0xbf, 0xef, 0x5e, 0xdd, 0x9e, 0x7b, 0x90, 0x69, 0xb1, 0x42, 0x0f, 0xc7, 0x05,
+	0xc8, 0x25, 0x98, 0xab, 0x5e, 0x78, 0x87, 0x68, 0x56, 0xb2, 0x97, 0xc8, 0x2b, 0x75, 0xa8, 0x30,
+	0xef, 0xa0, 0xff, 0x92, 0x72, 0x91, 0x0f, 0xdb, 0x32, 0x6d, 0xf0, 0x94, 0x87, 0x29, 0xc6, 0xe8,
+	0x75, 0x0e, 0xf5, 0x16, 0x54, 0x16, 0xfe, 0xf9, 0xd8, 0x3f, 0x11, 0xb7, 0xb3, 0xce, 0xca, 0x0b,
+	0xff, 0xbc, 0x75, 0xc2, 0x31, 0xc1, 0xa2, 0x40, 0x66, 0x74, 0x5d, 0x24, 0xd8, 0x85, 0x7f, 0x2e,
+	0x12, 0xb9, 0xf3, 0xa7, 0x70, 0xff, 0xd2, 0x14, 0xe4, 0xea, 0x8f, 0xd6, 0x5d, 0xdd, 0x72, 0x2f,
+	0x29, 0x29, 0x87, 0xff, 0x4f, 0x4d, 0x1d, 0xef, 0x4b, 0x1e, 0x07, 0xc7, 0xc1, 0xc4, 0x7f, 0xe5,
+	0x2a, 0x77, 0xc0, 0xc4, 0xfb, 0x22, 0x51, 0xe1, 0x4b, 0x04, 0x1e, 0xef, 0x22, 0x48, 0x12, 0xbc,
+	0x1b, 0x75, 0x81, 0x06, 0x24, 0x69, 0xff, 0x08, 0xb6, 0x56, 0xe1, 0x94, 0x4f, 0xe2, 0x8b, 0x65,
+	0xea, 0x1f, 0xcd, 0x39, 0x81, 0x89, 0x1a, 0x5b, 0x67, 0xe2, 0x85, 0xb9, 0x0a, 0x83, 0x70, 0xca,
+	0xcf, 0xf9, 0x54, 0xe2, 0x89, 0x9c, 0x61, 0xbf, 0x0f, 0xb0, 0x08, 0x92, 0x85, 0x9f, 0x4e, 0x66,
+	0x04, 0xe0, 0x50, 0x5c, 0xe0, 0x60, 0x52, 0x8d, 0xe2, 0xe5, 0xcc, 0x0f, 0x09, 0xa8, 0xa1, 0x34,
+	0xa3, 0x51, 0x16, 0xf3, 0xa5, 0x1f, 0xc4, 0x7c, 0xda, 0xac, 0x0a, 0x99, 0xa2, 0x9d, 0x44, 0xa5,
+	0x87, 0x56, 0x3c, 0x99, 0x05, 0x67, 0xbc, 0x98, 0x58, 0xb5, 0xf5, 0xc4, 0xfa, 0xc1, 0x9a, 0x5f,
+	0x17, 0x6e, 0x03, 0x65, 0x97, 0x8f, 0xa1, 0x22, 0x2e, 0xcd, 0xa4, 0xa9, 0x6f, 0xca, 0x91, 0x4a,
+	0xea, 0x7c, 0x09, 0xf6, 0xda, 0xa4, 0x74, 0x8a, 0x08, 0x75, 0x26, 0xf2, 0x02, 0x69, 0x30, 0x6c,
+	0x62, 0x2a, 0x9f, 0xfa, 0xa9, 0x4f, 0xf3, 0x35, 0xc8, 0xcb, 0xfd, 0x3c, 0x35, 0x0b, 0x1f, 0x7c,
+	0x55, 0x6a, 0x16, 0x1a, 0xea, 0x90, 0xdb, 0xf0, 0xe6, 0x55, 0x1f, 0xa7, 0xee, 0x4f, 0xd6, 0xbb,
+	0xdf, 0xdf, 0x10, 0x0b, 0x6a, 0x90, 0x7f, 0x33, 0xc1, 0x14, 0xab, 0xbd, 0xe9, 0x6d, 0x87, 0xc8,
+	0x72, 0x95, 0xce, 0xa2, 0x1c, 0x59, 0x12, 0x65, 0xff, 0x48, 0x82, 0x2d, 0x83, 0xe2, 0xcf, 0x12,
+	0xe8, 0x44, 0xfc, 0x16, 0x00, 0xd7, 0x6d, 0x73, 0x40, 0x13, 0x2a, 0x4b, 0x3f, 0xe6, 0x61, 0x9a,
+	0x48, 0x1f, 0x51, 0x24, 0xad, 0xcf, 0x8f, 0x4f, 0x78, 0xda, 0xac, 0xc8, 0xf5, 0x11, 0x95, 0xd9,
+	0xb8, 0x46, 0x5c, 0x6a, 0x23, 0xef, 0x28, 0x9a, 0x5e, 0x10, 0xc6, 0xab, 0x31, 0x6a, 0xdb, 0x3f,
+	0x81, 0x32, 0x22, 0xb2, 0x55, 0x22, 0x21, 0x9b, 0x5d, 0x5c, 0xf1, 0x80, 0x24, 0x4c, 0x6a, 0xa0,
+	0xc3, 0xf9, 0x69, 0xca, 0x17, 0xcb, 0x34, 0x21, 0xe0, 0x66, 0xb2, 0x8c, 0xc6, 0x82, 0x82, 0x9f,
+	0x2f, 0x83, 0x98, 0x23, 0x7e, 0x7b, 0x6d, 0x41, 0x21, 0x55, 0xf3, 0x0c, 0xb9, 0x55, 0xcc, 0x90,
+	0x6f, 0x83, 0xb1, 0x4a, 0x78, 0xdc, 0xe4, 0x12, 0xb8, 0x61, 0x29, 0xc1, 0x88, 0xe5, 0xfc, 0xb3,
+	0x06, 0xb5, 0xcc, 0x98, 0xf6, 0x16, 0x98, 0x07, 0x1e, 0xdb, 0xf7, 0xac, 0x3b, 0x0f, 0x4a, 0x55,
+	0x42, 0x4a, 0x9d, 0xfd, 0x5e, 0x9f, 0x79, 0x96, 0x86, 0xf9, 0xeb, 0x59, 0xb7, 0xb5, 0x2f, 0x50,
+	0xd7, 0x9f, 0xf7, 0x3b, 0x3d, 0x4b, 0xb7, 0x1b, 0x50, 0xc5, 0x4b, 0x61, 0xd4, 0x6b, 0x7b, 0x96,
+	0x81, 0x79, 0xad, 0xeb, 0xb5, 0x5e, 0x7a, 0x96, 0x89, 0x2a, 0x43, 0xef, 0x9b, 0xa1, 0x55, 0x46,
+	0xe6, 0xb3, 0x4e, 0xd7, 0x1b, 0x58, 0x15, 0x7b, 0x1b, 0x2a, 0xed, 0xfe, 0xc1, 0x81, 0xd7, 0x1b,
+	0x5a, 0x55, 0x1a, 0xbe, 0x0a, 0x46, 0xb7, 0xf3, 0xc2, 0xb3, 0x6a, 0x38, 0xd1, 0x81, 0x77, 0xf0,
+	0xd4, 0x63, 0x16, 0xd8, 0x15, 0xd0, 0x5f, 0x78, 0xdf, 0x5a, 0x75, 0x14, 0x7b, 0x7b, 0x9d, 0xa1,
+	0xd5, 0xc0, 0x79, 0x98, 0xd7, 0x6a, 0x0f, 0x3b, 0xfd, 0x9e, 0xb5, 0x25, 0x00, 0xe1, 0xd0, 0xeb,
+	0x11, 0x79, 0x97, 0xe0, 0x5c, 0xfb, 0xb9, 0x77, 0xd0, 0xb2, 0xb6, 0xb1, 0x6f, 0x6b, 0x6f, 0xcf,
+	0xda, 0x75, 0xbe, 0x82, 0x7a, 0xc1, 0xe0, 0xb8, 0x0a, 0xbc, 0xc9, 0xbe, 0x15, 0x97, 0xda, 0xd7,
+	0x23, 0x6f, 0xa4, 0xd2, 0xef, 0xa1, 0xd7, 0x43, 0x58, 0x67, 0x95, 0x70, 0x92, 0x3d, 0xef, 0x99,
+	0xc7, 0x10, 0x18, 0xea, 0xce, 0x87, 0xd2, 0x2c, 0x83, 0x28, 0x4e, 0x71, 0x25, 0x7b, 0x02, 0x8c,
+	0x02, 0x94, 0xdb, 0xad, 0xd1, 0xa0, 0xd5, 0xb5, 0x34, 0xe7, 0x89, 0x54, 0xa1, 0xd8, 0x78, 0x77,
+	0x3d, 0x36, 0x14, 0x8a, 0x96, 0xe1, 0xf0, 0x3b, 0x68, 0x10, 0x7d, 0x20, 0x2a, 0xdd, 0x2b, 0x41,
+	0x61, 0x83, 0x81, 0x28, 0x58, 0x95, 0x5a, 0xd8, 0xb6, 0xdf, 0x01, 0x9d, 0x87, 0x67, 0x14, 0x0d,
+	0xf5, 0xdd, 0x9a, 0xeb, 0x85, 0x67, 0x7c, 0x1e, 0x2d, 0x39, 0x43, 0xee, 0xad, 0x81, 0xda, 0x3f,
+	0x6a, 0x50, 0xee, 0x84, 0x67, 0x41, 0x7a, 0x75, 0xee, 0xec, 0x72, 0x16, 0xd9, 0x43, 0x10, 0x1b,
+	0x4b, 0x6a, 0x2a, 0x9d, 0x71, 0x8c, 0x58, 0xce, 0x2b, 0xcb, 0x3c, 0xc5, 0xfd, 0xe1, 0xa2, 0x10,
+	0xe1, 0xb5, 0x58, 0xee, 0x66, 0x78, 0x2d, 0x64, 0xca, 0xba, 0xff, 0xa4, 0x43, 0xed, 0x59, 0x30,
+	0xe7, 0x1d, 0xbc, 0x03, 0x70, 0xe5, 0x8b, 0x60, 0x3e, 0x97, 0x3b, 0xa4, 0x36, 0x06, 0xda, 0x64,
+	0xc6, 0x27, 0xa7, 0xc9, 0x6a, 0x21, 0x6d, 0x9c, 0xd1, 0x54, 0x03, 0x46, 0xab, 0x78, 0xa2, 0xf6,
+	0x2a, 0x29, 0x1c, 0x27, 0xc2, 0xc0, 0x94, 0xf5, 0x22, 0xb6, 0x33, 0xcc, 0x6c, 0x16, 0x30, 0xb3,
+	0xac, 0x3c, 0xcb, 0x79, 0xe5, 0xb9, 0x03, 0xe6, 0x82, 0x4f, 0x03, 0x5f, 0x66, 0x10, 0x41, 0x64,
+	0x16, 0xad, 0x16, 0x2c, 0x6a, 0x83, 0x91, 0x04, 0xbf, 0xe5, 0x94, 0x54, 0x74, 0x46, 0x6d, 0xfb,
+	0x67, 0x60, 0xfa, 0xd3, 0x29, 0x9f, 0x36, 0xe1, 0xb5, 0x56, 0x14, 0x8a, 0xf6, 0x27, 0x60, 0x2c,
+	0x78, 0xea, 0x53, 0x0a, 0xa9, 0xef, 0xbe, 0x75, 0xa5, 0xc3, 0x80, 0x5e, 0x5b, 0x18, 0x29, 0x51,
+	0x31, 0x4e, 0x19, 0x4d, 0xd4, 0x85, 0x35, 0xa6, 0x48, 0xfb, 0xe7, 0x00, 0x3c, 0xa4, 0x7b, 0x16,
+	0x2f, 0xb5, 0x2d, 0xca, 0x5e, 0x6f, 0xb8, 0x99, 0x61, 0x5d, 0x2f, 0x13, 0xb2, 0x82, 0xa2, 0xd3,
+	0x02, 0xc8, 0x25, 0x18, 0x52, 0x2d, 0x6f, 0x30, 0xde, 0x6f, 0x1f, 0x58, 0x77, 0x6c, 0x1b, 0xee,
+	0x4a, 0x62, 0x3c, 0x18, 0x32, 0xaf, 0x75, 0x60, 0x69, 0x45, 0x5e, 0xfb, 0xf9, 0xa8, 0xf7, 0x62,
+	0x60, 0x95, 0x1c, 0x4f, 0x9c, 0x5f, 0x7b, 0xb6, 0x0a, 0x4f, 0x33, 0x1b, 0x6b, 0x57, 0x6d, 0x5c,
+	0xa8, 0xee, 0x95, 0xe5, 0xf4, 0xdc, 0x72, 0x08, 0xe1, 0xb3, 0x61, 0x36, 0x43, 0xf8, 0x4c, 0xac,
+	0x5c, 0xe7, 0x5f, 0x4b, 0x60, 0x50, 0xe9, 0xaa, 0x4e, 0x47, 0x2b, 0x9c, 0x8e, 0x05, 0xfa, 0x32,
+	0x10, 0x75, 0x53, 0x95, 0x61, 0x13, 0xb1, 0xc7, 0x72, 0xee, 0x07, 0x61, 0xca, 0xcf, 0x53, 0x59,
+	0x93, 0xe5, 0x8c, 0xcc, 0xf3, 0x8c, 0x82, 0xe7, 0x7d, 0x24, 0xbd, 0x48, 0xbc, 0x35, 0x6d, 0x53,
+	0xcd, 0xec, 0xf6, 0x97, 0x69, 0xe2, 0x85, 0x69, 0x7c, 0x21, 0xdd, 0xea, 0x0b, 0xa8, 0xff, 0x26,
+	0x89, 0xc2, 0xb1, 0x7c, 0x8b, 0x28, 0xbf, 0xfa, 0x1c, 0x01, 0x75, 0x65, 0xd9, 0xf7, 0x08, 0xcc,
+	0x79, 0x10, 0x9e, 0x26, 0xcd, 0xaa, 0x44, 0x6e, 0x34, 0x7e, 0x17, 0x59, 0x62, 0x02, 0x21, 0x7e,
+	0xf0, 0x39, 0xd4, 0xb2, 0x49, 0x95, 0x35, 0xb5, 0x35, 0x8f, 0x3d, 0xf3, 0xe7, 0x2b, 0xf5, 0xd6,
+	0x23, 0x88, 0x2f, 0x4b, 0x5f, 0x68, 0x0f, 0xbe, 0x02, 0xc8, 0x47, 0xdb, 0xd0, 0xf3, 0x9d, 0x62,
+	0x4f, 0xcc, 0x08, 0xa8, 0x5d, 0x18, 0xc0, 0xf9, 0xbb, 0x12, 0x18, 0xc8, 0xc3, 0xbe, 0xab, 0x44,
+	0x19, 0x18, 0x9b, 0xff, 0x2f, 0xf6, 0xc5, 0xa9, 0x7e, 0x40, 0xfb, 0x22, 0x5c, 0x24, 0xc7, 0xf6,
+	0xe7, 0x14, 0xcd, 0x55, 0x96, 0xd1, 0xff, 0x67, 0x9b, 0x3a, 0x67, 0x00, 0x62, 0xf8, 0xbd, 0xe0,
+	0xf8, 0x18, 0xf5, 0x44, 0xbc, 0x6b, 0x14, 0x8e, 0x82, 0x28, 0x16, 0x7c, 0x25, 0x11, 0xa6, 0x92,
+	0x44, 0xc9, 0x84, 0xb0, 0xd6, 0x54, 0xe1, 0x67, 0x49, 0x22, 0xf6, 0x9d, 0x44, 0x8b, 0xa5, 0x9f,
+	0x06, 0x02, 0x3c, 0xe3, 0x72, 0x0b, 0x1c, 0xe7, 0x5f, 0x0c, 0x68, 0xf4, 0xa2, 0x34, 0x07, 0xee,
+	0x97, 0xaf, 0x02, 0x95, 0xbf, 0x4b, 0x37, 0xaf, 0x25, 0xfd, 0x49, 0x9a, 0x41, 0x36, 0x41, 0xe0,
+	0x02, 0x93, 0xd5, 0xd1, 0x6f, 0xf8, 0x24, 0x95, 0x27, 0xa5, 0x48, 0xfb, 0x43, 0x68, 0xc8, 0xe6,
+	0x78, 0xca, 0x93, 0x89, 0x4c, 0xa3, 0x75, 0xc9, 0xdb, 0xe3, 0xc9, 0x64, 0x73, 0x79, 0x7a, 0x2d,
+	0x28, 0x7b, 0x24, 0xc1, 0x61, 0x55, 0x42, 0xad, 0xe2, 0xee, 0x8a, 0xef, 0x71, 0x0a, 0xa8, 0xd5,
+	0x0a, 0x40, 0xcd, 0x06, 0x83, 0x60, 0x28, 0x90, 0x9d, 0xa8, 0xfd, 0x2a, 0xa0, 0xf4, 0xf7, 0x25,
+	0xf9, 0x38, 0x75, 0x1f, 0xb6, 0xe5, 0x7b, 0x12, 0xf3, 0xda, 0x5e, 0xe7, 0x25, 0x3d, 0x32, 0xbd,
+	0x05, 0xf7, 0x5b, 0xed, 0x76, 0x7f, 0xd4, 0x1b, 0x8e, 0x0f, 0x3d, 0x8f, 0x8d, 0x11, 0x20, 0x11,
+	0xc4, 0x78, 0x03, 0xee, 0xad, 0x09, 0xba, 0xde, 0xb3, 0xa1, 0x55, 0xc5, 0x47, 0xa9, 0xa2, 0x5e,
+	0x09, 0x41, 0x4d, 0x2e, 0xd7, 0xed, 0x7b, 0xb0, 0x75, 0xe0, 0x0d, 0x06, 0xad, 0x7d, 0x6f, 0x2c,
+	0x6a, 0x45, 0x03, 0xbb, 0x10, 0x92, 0x92, 0x0c, 0x13, 0x75, 0x24, 0x9e, 0x92, 0xac, 0x32, 0xbe,
+	0x7d, 0x21, 0xa2, 0x92, 0x74, 0x05, 0x69, 0x84, 0x50, 0x92, 0xae, 0x61, 0xf2, 0x55, 0x40, 0x4a,
+	0xf2, 0xc0, 0xde, 0x01, 0xeb, 0x40, 0x80, 0xa9, 0x7c, 0x43, 0x75, 0xbb, 0x09, 0x3b, 0xed, 0xd6,
+	0x33, 0x6f, 0xdc, 0xee, 0x76, 0x70, 0x02, 0xef, 0x9b, 0xc3, 0x0e, 0x43, 0x9c, 0xd4, 0xc0, 0xad,
+	0x92, 0xe4, 0xeb, 0x51, 0x7f, 0xd8, 0x1a, 0x7b, 0xdf, 0xb4, 0x3d, 0x0f, 0x07, 0xda, 0xc2, 0x8a,
+	0xa3, 0x68, 0xff, 0xcd, 0x15, 0x47, 0x51, 0x23, 0x7b, 0xd6, 0xd5, 0xc0, 0xc0, 0x37, 0xf8, 0x0c,
+	0x06, 0x69, 0x05, 0x18, 0x74, 0xfd, 0xdb, 0x89, 0x05, 0xba, 0xbf, 0x0c, 0xa4, 0xef, 0x61, 0x13,
+	0xa3, 0x95, 0x7c, 0x75, 0x12, 0xa9, 0x24, 0x91, 0xd1, 0x94, 0xe0, 0xf1, 0xf1, 0x52, 0x5e, 0xdd,
+	0xd8, 0xa6, 0x94, 0x14, 0xcf, 0xd5, 0xd5, 0xbd, 0x8a, 0xe7, 0xce, 0xef, 0x4b, 0x50, 0xc7, 0xa5,
+	0x0c, 0x78, 0x92, 0x6c, 0x8a, 0x10, 0xac, 0x52, 0x26, 0x93, 0x7c, 0x31, 0x92, 0xb2, 0x3f, 0x05,
+	0x9d, 0x9f, 0x2f, 0x9b, 0xfa, 0x6b, 0x03, 0x07, 0xd5, 0x44, 0x70, 0x1f, 0xc7, 0x3c, 0x99, 0xa9,
+	0x08, 0x91, 0x24, 0x46, 0x60, 0x8c, 0x03, 0xdd, 0x00, 0x41, 0xc5, 0x72, 0x24, 0x15, 0x6b, 0xe5,
+	0xf5, 0x58, 0xb3, 0x0b, 0x8f, 0xd4, 0x35, 0x19, 0x06, 0x6f, 0x83, 0x31, 0xf1, 0x8f, 0x45, 0xb8,
+	0x64, 0x1f, 0x3e, 0x88, 0x85, 0x57, 0xe5, 0x0a, 0xa1, 0x29, 0x85, 0x08, 0x5e, 0x95, 0x28, 0x1b,
+	0x21, 0x87, 0x09, 0x81, 0xf3, 0x73, 0xd8, 0x2e, 0x58, 0x86, 0x4e, 0xd7, 0x59, 0x3f, 0xdd, 0x86,
+	0x5b, 0x50, 0x50, 0x87, 0x3b, 0x82, 0x1a, 0x72, 0xbf, 0x5e, 0x45, 0xa9, 0x4f, 0xd1, 0x7d, 0x91,
+	0x72, 0xf1, 0xa9, 0x41, 0x67, 0x82, 0xc0, 0x4d, 0x44, 0xb4, 0x68, 0xf5, 0x52, 0xa0, 0xc8, 0xe2,
+	0x97, 0x03, 0xf1, 0x96, 0xa1, 0x48, 0xe7, 0x7b, 0xa8, 0x65, 0x2b, 0xfc, 0xe1, 0x86, 0x45, 0x33,
+	0x7c, 0x87, 0x2b, 0x6d, 0x1a, 0x05, 0x33, 0xd0, 0xda, 0x99, 0x10, 0x38, 0xbf, 0x37, 0x84, 0x87,
+	0x30, 0xfe, 0xdd, 0x8a, 0x27, 0xe9, 0x8d, 0xa0, 0x7c, 0x9e, 0xbe, 0xf4, 0xb5, 0xf4, 0xa5, 0xce,
+	0xc3, 0xb8, 0x7a, 0x1e, 0x3b, 0x60, 0x9e, 0xc4, 0xd1, 0x6a, 0x29, 0xe1, 0xa2, 0x20, 0xf0, 0x79,
+	0x27, 0xb9, 0x08, 0x27, 0x63, 0x21, 0x02, 0x12, 0xd5, 0x90, 0xb3, 0x4f, 0xe2, 0x1f, 0xcb, 0x33,
+	0x37, 0x29, 0x1d, 0xde, 0x73, 0x0b, 0xeb, 0x74, 0x37, 0x14, 0xcb, 0xe5, 0x1b, 0xa6, 0x79, 0x85,
+	0xb5, 0x2a, 0x05, 0x94, 0xfa, 0x49, 0x56, 0xe6, 0xd6, 0x68, 0xb2, 0xfb, 0x6b, 0x93, 0xdd, 0xa2,
+	0xce, 0x7d, 0x0f, 0x80, 0x76, 0x33, 0xa6, 0x29, 0x1a, 0x34, 0x45, 0x8d, 0x38, 0x03, 0x31, 0xcf,
+	0x3d, 0x21, 0x4e, 0x63, 0x3f, 0x4c, 0x8e, 0x79, 0x8c, 0x8f, 0x33, 0xa2, 0xb8, 0xb5, 0x48, 0x30,
+	0xcc, 0xf9, 0x4e, 0x5f, 0xa6, 0xe8, 0x1a, 0x98, 0x83, 0x21, 0x96, 0xad, 0x77, 0x10, 0x8f, 0x8e,
+	0x7a, 0x82, 0xd0, 0xf1, 0x19, 0x9f, 0x9a, 0xe3, 0xe1, 0x73, 0x2c, 0x07, 0x05, 0x1a, 0x1d, 0xf5,
+	0xd6, 0x78, 0x54, 0xc7, 0x76, 0x7a, 0x4f, 0xfb, 0xdf, 0x58, 0x25, 0xe7, 0x53, 0x28, 0xcb, 0x0a,
+	0xb2, 0x02, 0x7a, 0xcf, 0xfb, 0x95, 0x75, 0xa7, 0x58, 0x33, 0x6a, 0x58, 0x33, 0xb6, 0xfb, 0x07,
+	0x87, 0x5d, 0x6f, 0xe8, 0x59, 0x25, 0x15, 0x21, 0xd2, 0x08, 0xd7, 0x47, 0x88, 0x54, 0x50, 0x11,
+	0xf2, 0xdf, 0x25, 0xb8, 0x4f, 0x81, 0xa3, 0xce, 0x51, 0x4e, 0x79, 0xd9, 0xb3, 0xde, 0x81, 0x5a,
+	0xb8, 0x5a, 0x8c, 0xd3, 0x28, 0xf5, 0xe7, 0xd2, 0xa3, 0xab, 0xe1, 0x6a, 0x31, 0x44, 0x1a, 0x3f,
+	0xbd, 0xa0, 0x70, 0xc9, 0xc3, 0xa9, 0x78, 0x59, 0x43, 0x31, 0x84, 0xab, 0xc5, 0xa1, 0xe0, 0xe0,
+	0xdd, 0x8b, 0x0a, 0x08, 0x07, 0xe6, 0x5c, 0x56, 0x8e, 0x26, 0xc3, 0x4e, 0x6d, 0xc9, 0x22, 0xef,
+	0x0a, 0x7e, 0xcb, 0xe5, 0x0c, 0xa6, 0x38, 0x0a, 0xe4, 0x88, 0x29, 0xf0, 0xf6, 0x46, 0xb1, 0x9a,
+	0xa3, 0x4c, 0x0a, 0x75, 0xe4, 0xa9, 0x49, 0x3e, 0x82, 0x2d, 0x52, 0xc9, 0x66, 0x11, 0x2e, 0x43,
+	0xfd, 0xb2, 0x69, 0x7e, 0x22, 0x8f, 0x34, 0x19, 0x17, 0x66, 0xab, 0x92, 0xe2, 0xb6, 0x10, 0x0c,
+	0xb2, 0x39, 0x7f, 0x06, 0x3b, 0x45, 0xdd, 0x6c, 0x5c, 0x51, 0x30, 0xd9, 0xb9, 0x7a, 0x36, 0x3a,
+	0x7e, 0x33, 0x88, 0xe3, 0x28, 0x6e, 0xee, 0x8a, 0xc0, 0x21, 0xc2, 0x7e, 0x1b, 0xaa, 0xd4, 0x18,
+	0x07, 0xd3, 0xe6, 0x67, 0x22, 0x51, 0x12, 0xdd, 0x99, 0x3a, 0xff, 0xa3, 0x89, 0x63, 0x7b, 0x3e,
+	0x1c, 0x1e, 0xaa, 0xa0, 0x7e, 0x22, 0x03, 0x49, 0x93, 0x45, 0xd0, 0x25, 0x79, 0x31, 0x98, 0xe4,
+	0x1d, 0x52, 0xca, 0xee, 0x10, 0xfb, 0x73, 0xa8, 0xe0, 0xb7, 0x33, 0xfc, 0x1a, 0x2a, 0x9e, 0xf7,
+	0xde, 0xbb, 0xd2, 0xff, 0xb9, 0x90, 0x0b, 0x8c, 0xaa, 0xb4, 0x29, 0x75, 0xf8, 0xa9, 0xba, 0x13,
+	0xa8, 0xfd, 0xe0, 0x4b, 0x68, 0x14, 0x95, 0x6f, 0x85, 0x33, 0x7f, 0x2c, 0xc3, 0xa1, 0x02, 0xfa,
+	0xe1, 0x08, 0x5f, 0x9e, 0xab, 0x60, 0x1c, 0xf6, 0x07, 0x43, 0xf1, 0x0d, 0x6c, 0xcf, 0x93, 0x6e,
+	0xfb, 0x17, 0x22, 0xa1, 0xdd, 0xe6, 0x6d, 0x42, 0x65, 0x10, 0xfd, 0x86, 0x19, 0xa4, 0x98, 0x00,
+	0x8c, 0xf5, 0x04, 0xe0, 0x7c, 0x27, 0xcc, 0xdf, 0x9e, 0x07, 0x3c, 0x4c, 0x7b, 0x51, 0x38, 0xe1,
+	0xf9, 0x96, 0xb4, 0xc2, 0x96, 0x5e, 0x81, 0x04, 0x6e, 0xb9, 0x1c, 0xe7, 0x1f, 0x4a, 0x00, 0xf9,
+	0x9c, 0xb7, 0xf8, 0xa3, 0x41, 0xe1, 0xbf, 0x01, 0xfa, 0xcd, 0xff, 0x1b, 0xe0, 0x82, 0x91, 0x70,
+	0x1e, 0xde, 0xe4, 0xb1, 0x06, 0xf5, 0x70, 0xfb, 0x69, 0x74, 0xca, 0x43, 0x89, 0x55, 0x04, 0x91,
+	0x5f, 0x4d, 0xe5, 0x6b, 0xae, 0xa6, 0xe2, 0x93, 0x61, 0xe5, 0xe6, 0x4f, 0x86, 0xd9, 0xcd, 0xcf,
+	0xaf, 0xbb, 0xf9, 0x3f, 0x83, 0xbb, 0xb9, 0xb5, 0x28, 0xad, 0x7d, 0xb8, 0x9e, 0xd6, 0xea, 0x6e,
+	0x2e, 0x57, 0x59, 0xed, 0x0f, 0x1a, 0x34, 0x72, 0xee, 0x7e, 0xdb, 0xfe, 0x08, 0xca, 0x13, 0x6a,
+	0x93, 0xa5, 0x2f, 0x75, 0x92, 0x22, 0xfb, 0x53, 0xc4, 0x57, 0xa9, 0xfa, 0x5a, 0x79, 0x77, 0x77,
+	0xc7, 0x2d, 0x8e, 0xe1, 0xb6, 0x48, 0xc6, 0xa4, 0x0e, 0xba, 0x95, 0xfc, 0xef, 0x88, 0xba, 0xc8,
+	0x33, 0xda, 0xf9, 0x25, 0x94, 0x85, 0x36, 0x26, 0x69, 0x7c, 0x20, 0xdc, 0x1b, 0x75, 0xbd, 0xcb,
+	0xf9, 0x9b, 0x9e, 0xf3, 0x7a, 0x6d, 0xaf, 0x6b, 0x95, 0x0a, 0x21, 0xa1, 0x3b, 0x5d, 0xb1, 0xf6,
+	0xfd, 0x36, 0xe3, 0xcb, 0x28, 0xde, 0x00, 0x63, 0x8b, 0xab, 0x92, 0x3b, 0xc6, 0x8f, 0x32, 0xd3,
+	0xf8, 0x62, 0x1c, 0xaf, 0x54, 0x49, 0x5b, 0x9e, 0xc6, 0x17, 0x6c, 0x15, 0x3a, 0xff, 0x51, 0x12,
+	0x60, 0x65, 0x48, 0xe7, 0xb8, 0xe1, 0xfd, 0x2d, 0x8f, 0xdf, 0x86, 0x72, 0xf6, 0xdb, 0x46, 0xd8,
+	0x6b, 0x81, 0x4b, 0xd1, 0x3b, 0xcc, 0x9b, 0x7b, 0xc7, 0x27, 0x70, 0x0f, 0x3f, 0x28, 0xc5, 0xfc,
+	0x24, 0x48, 0xd2, 0x98, 0x60, 0x7b, 0x42, 0x1e, 0x68, 0x32, 0x6b, 0xe1, 0x9f, 0xb3, 0x22, 0x1f,
+	0x3f, 0xe0, 0xac, 0x2b, 0x56, 0x48, 0x71, 0x9d, 0x69, 0x3f, 0xc6, 0x3f, 0x5d, 0x44, 0x4b, 0x2e,
+	0x1e, 0x2d, 0xf0, 0x4d, 0x3f, 0x33, 0x8e, 0x3b, 0x40, 0x01, 0x93, 0x72, 0xe7, 0x17, 0x60, 0x12,
+	0xa3, 0x78, 0xa1, 0x67, 0xb7, 0x33, 0x3d, 0xdf, 0x8a, 0x4b, 0x7b, 0x20, 0x8e, 0x6f, 0xe0, 0xb5,
+	0x58, 0xfb, 0xb9, 0xa5, 0x3b, 0xbf, 0x06, 0x2b, 0x3f, 0xa0, 0x6b, 0xfe, 0x30, 0xf2, 0x66, 0xe6,
+	0x8e, 0x12, 0xc9, 0x0b, 0x8a, 0x8a, 0xe8, 0x60, 0x39, 0xe3, 0x71, 0xf6, 0x06, 0xd1, 0x60, 0x05,
+	0x8e, 0xf3, 0x15, 0xec, 0x5c, 0x1e, 0xbb, 0x2b, 0xff, 0xa9, 0x51, 0x74, 0x91, 0x7b, 0xee, 0x65,
+	0x2d, 0x15, 0x18, 0x7f, 0x59, 0x5c, 0x5c, 0x5f, 0x80, 0xf5, 0x9b, 0x2e, 0x6e, 0xc3, 0xab, 0xd7,
+	0xad, 0x9f, 0x82, 0x7f, 0x07, 0xf7, 0xf2, 0xf9, 0x6f, 0x93, 0xf4, 0xf3, 0x45, 0xe9, 0x6b, 0x8b,
+	0xba, 0xed, 0x02, 0xfe, 0x4b, 0x57, 0x38, 0x69, 0x39, 0xbf, 0xee, 0x25, 0xe2, 0x36, 0xf3, 0x3f,
+	0x59, 0xfb, 0x42, 0xf4, 0x86, 0x7b, 0x69, 0xec, 0xe2, 0x65, 0x9d, 0x03, 0x71, 0x73, 0x0d, 0x88,
+	0xdf, 0x16, 0x11, 0x17, 0xef, 0xb3, 0xca, 0x25, 0x40, 0xfb, 0x04, 0xca, 0xa2, 0xce, 0x90, 0x09,
+	0xf5, 0x9e, 0x7b, 0xf9, 0xb8, 0x99, 0x54, 0x40, 0x55, 0xf9, 0x2d, 0xec, 0xf8, 0xa1, 0xb6, 0xd9,
+	0x69, 0xa4, 0x82, 0xfd, 0x29, 0x54, 0x64, 0x6a, 0xa3, 0xbf, 0xd5, 0xd4, 0x77, 0x6d, 0xf7, 0xca,
+	0x29, 0x32, 0xa5, 0x62, 0x5b, 0xe2, 0xdb, 0xc1, 0x4c, 0x7c, 0x22, 0xe4, 0xe1, 0x19, 0xfe, 0x61,
+	0x44, 0x80, 0x01, 0x4c, 0x79, 0x54, 0xd3, 0x5b, 0x77, 0xf0, 0x05, 0x41, 0x7c, 0x7e, 0x96, 0x65,
+	0xbe, 0xa5, 0xe5, 0x91, 0x56, 0x7a, 0x35, 0x74, 0x36, 0x36, 0x40, 0x67, 0x13, 0xbb, 0xc8, 0x67,
+	0x0b, 0xab, 0x8c, 0x0a, 0x22, 0xa1, 0x8e, 0x15, 0xaf, 0xe2, 0xfc, 0x52, 0x5d, 0xf6, 0xab, 0x24,
+	0xe5, 0xf1, 0x75, 0x7f, 0xf3, 0x53, 0x08, 0x53, 0x96, 0x6d, 0x92, 0xc4, 0xaf, 0xd7, 0x97, 0x3a,
+	0x6f, 0xfe, 0x7a, 0x7d, 0x49, 0x49, 0x06, 0xde, 0xd3, 0xfb, 0xb0, 0x15, 0x44, 0x2e, 0x06, 0x71,
+	0x80, 0x67, 0x7b, 0xf4, 0xeb, 0xd2, 0xf2, 0xe8, 0xa8, 0x4c, 0x67, 0xfc, 0xd9, 0xff, 0x0e, 0x00,
+	0x66, 0x59, 0xef, 0x22, 0xd1, 0x28, 0x00, 0x00,
 }
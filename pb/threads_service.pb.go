@@ -20,6 +20,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ThreadMembership_Action int32
+
+const (
+	ThreadMembership_ADD    ThreadMembership_Action = 0
+	ThreadMembership_REMOVE ThreadMembership_Action = 1
+	ThreadMembership_GRANT  ThreadMembership_Action = 2
+	ThreadMembership_REVOKE ThreadMembership_Action = 3
+)
+
+var ThreadMembership_Action_name = map[int32]string{
+	0: "ADD",
+	1: "REMOVE",
+	2: "GRANT",
+	3: "REVOKE",
+}
+var ThreadMembership_Action_value = map[string]int32{
+	"ADD":    0,
+	"REMOVE": 1,
+	"GRANT":  2,
+	"REVOKE": 3,
+}
+
+func (x ThreadMembership_Action) String() string {
+	return proto.EnumName(ThreadMembership_Action_name, int32(x))
+}
+func (ThreadMembership_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{9, 0}
+}
+
 // for wire transport
 type ThreadEnvelope struct {
 	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
@@ -37,7 +66,7 @@ func (m *ThreadEnvelope) Reset()         { *m = ThreadEnvelope{} }
 func (m *ThreadEnvelope) String() string { return proto.CompactTextString(m) }
 func (*ThreadEnvelope) ProtoMessage()    {}
 func (*ThreadEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{0}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{0}
 }
 func (m *ThreadEnvelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadEnvelope.Unmarshal(m, b)
@@ -112,7 +141,7 @@ func (m *ThreadEnvelopeAck) Reset()         { *m = ThreadEnvelopeAck{} }
 func (m *ThreadEnvelopeAck) String() string { return proto.CompactTextString(m) }
 func (*ThreadEnvelopeAck) ProtoMessage()    {}
 func (*ThreadEnvelopeAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{1}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{1}
 }
 func (m *ThreadEnvelopeAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadEnvelopeAck.Unmarshal(m, b)
@@ -152,7 +181,7 @@ func (m *ThreadBlock) Reset()         { *m = ThreadBlock{} }
 func (m *ThreadBlock) String() string { return proto.CompactTextString(m) }
 func (*ThreadBlock) ProtoMessage()    {}
 func (*ThreadBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{2}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{2}
 }
 func (m *ThreadBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadBlock.Unmarshal(m, b)
@@ -198,6 +227,9 @@ type ThreadBlockHeader struct {
 	Parents              []string             `protobuf:"bytes,2,rep,name=parents,proto3" json:"parents,omitempty"` // Deprecated: Do not use.
 	Author               string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
 	Address              string               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	Clock                int64                `protobuf:"varint,6,opt,name=clock,proto3" json:"clock,omitempty"`
+	Epoch                int32                `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -207,7 +239,7 @@ func (m *ThreadBlockHeader) Reset()         { *m = ThreadBlockHeader{} }
 func (m *ThreadBlockHeader) String() string { return proto.CompactTextString(m) }
 func (*ThreadBlockHeader) ProtoMessage()    {}
 func (*ThreadBlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{3}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{3}
 }
 func (m *ThreadBlockHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadBlockHeader.Unmarshal(m, b)
@@ -256,6 +288,27 @@ func (m *ThreadBlockHeader) GetAddress() string {
 	return ""
 }
 
+func (m *ThreadBlockHeader) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *ThreadBlockHeader) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ThreadBlockHeader) GetEpoch() int32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 type ThreadAdd struct {
 	Inviter              *Peer    `protobuf:"bytes,1,opt,name=inviter,proto3" json:"inviter,omitempty"`
 	Thread               *Thread  `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
@@ -269,7 +322,7 @@ func (m *ThreadAdd) Reset()         { *m = ThreadAdd{} }
 func (m *ThreadAdd) String() string { return proto.CompactTextString(m) }
 func (*ThreadAdd) ProtoMessage()    {}
 func (*ThreadAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{4}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{4}
 }
 func (m *ThreadAdd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadAdd.Unmarshal(m, b)
@@ -322,7 +375,7 @@ func (m *ThreadIgnore) Reset()         { *m = ThreadIgnore{} }
 func (m *ThreadIgnore) String() string { return proto.CompactTextString(m) }
 func (*ThreadIgnore) ProtoMessage()    {}
 func (*ThreadIgnore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{5}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{5}
 }
 func (m *ThreadIgnore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadIgnore.Unmarshal(m, b)
@@ -361,7 +414,7 @@ func (m *ThreadFlag) Reset()         { *m = ThreadFlag{} }
 func (m *ThreadFlag) String() string { return proto.CompactTextString(m) }
 func (*ThreadFlag) ProtoMessage()    {}
 func (*ThreadFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{6}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{6}
 }
 func (m *ThreadFlag) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadFlag.Unmarshal(m, b)
@@ -400,7 +453,7 @@ func (m *ThreadJoin) Reset()         { *m = ThreadJoin{} }
 func (m *ThreadJoin) String() string { return proto.CompactTextString(m) }
 func (*ThreadJoin) ProtoMessage()    {}
 func (*ThreadJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{7}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{7}
 }
 func (m *ThreadJoin) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadJoin.Unmarshal(m, b)
@@ -446,7 +499,7 @@ func (m *ThreadAnnounce) Reset()         { *m = ThreadAnnounce{} }
 func (m *ThreadAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ThreadAnnounce) ProtoMessage()    {}
 func (*ThreadAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{8}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{8}
 }
 func (m *ThreadAnnounce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadAnnounce.Unmarshal(m, b)
@@ -480,6 +533,190 @@ func (m *ThreadAnnounce) GetName() string {
 	return ""
 }
 
+type ThreadMembership struct {
+	Address              string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Action               ThreadMembership_Action `protobuf:"varint,2,opt,name=action,proto3,enum=ThreadMembership_Action" json:"action,omitempty"`
+	Role                 ThreadMember_Role       `protobuf:"varint,3,opt,name=role,proto3,enum=ThreadMember_Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ThreadMembership) Reset()         { *m = ThreadMembership{} }
+func (m *ThreadMembership) String() string { return proto.CompactTextString(m) }
+func (*ThreadMembership) ProtoMessage()    {}
+func (*ThreadMembership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{9}
+}
+func (m *ThreadMembership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadMembership.Unmarshal(m, b)
+}
+func (m *ThreadMembership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadMembership.Marshal(b, m, deterministic)
+}
+func (dst *ThreadMembership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadMembership.Merge(dst, src)
+}
+func (m *ThreadMembership) XXX_Size() int {
+	return xxx_messageInfo_ThreadMembership.Size(m)
+}
+func (m *ThreadMembership) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadMembership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadMembership proto.InternalMessageInfo
+
+func (m *ThreadMembership) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ThreadMembership) GetAction() ThreadMembership_Action {
+	if m != nil {
+		return m.Action
+	}
+	return ThreadMembership_ADD
+}
+
+func (m *ThreadMembership) GetRole() ThreadMember_Role {
+	if m != nil {
+		return m.Role
+	}
+	return ThreadMember_DEFAULT
+}
+
+type ThreadRetentionPolicy struct {
+	MaxAge               int64    `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	MaxBlocks            int32    `protobuf:"varint,2,opt,name=max_blocks,json=maxBlocks,proto3" json:"max_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadRetentionPolicy) Reset()         { *m = ThreadRetentionPolicy{} }
+func (m *ThreadRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ThreadRetentionPolicy) ProtoMessage()    {}
+func (*ThreadRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{10}
+}
+func (m *ThreadRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRetentionPolicy.Unmarshal(m, b)
+}
+func (m *ThreadRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRetentionPolicy.Marshal(b, m, deterministic)
+}
+func (dst *ThreadRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRetentionPolicy.Merge(dst, src)
+}
+func (m *ThreadRetentionPolicy) XXX_Size() int {
+	return xxx_messageInfo_ThreadRetentionPolicy.Size(m)
+}
+func (m *ThreadRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRetentionPolicy proto.InternalMessageInfo
+
+func (m *ThreadRetentionPolicy) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *ThreadRetentionPolicy) GetMaxBlocks() int32 {
+	if m != nil {
+		return m.MaxBlocks
+	}
+	return 0
+}
+
+type ThreadSchemaUpdate struct {
+	Versions             []*ThreadSchema `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ThreadSchemaUpdate) Reset()         { *m = ThreadSchemaUpdate{} }
+func (m *ThreadSchemaUpdate) String() string { return proto.CompactTextString(m) }
+func (*ThreadSchemaUpdate) ProtoMessage()    {}
+func (*ThreadSchemaUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{11}
+}
+func (m *ThreadSchemaUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadSchemaUpdate.Unmarshal(m, b)
+}
+func (m *ThreadSchemaUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadSchemaUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ThreadSchemaUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadSchemaUpdate.Merge(dst, src)
+}
+func (m *ThreadSchemaUpdate) XXX_Size() int {
+	return xxx_messageInfo_ThreadSchemaUpdate.Size(m)
+}
+func (m *ThreadSchemaUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadSchemaUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadSchemaUpdate proto.InternalMessageInfo
+
+func (m *ThreadSchemaUpdate) GetVersions() []*ThreadSchema {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type ThreadRekey struct {
+	Epoch                int32             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Keys                 map[string][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ThreadRekey) Reset()         { *m = ThreadRekey{} }
+func (m *ThreadRekey) String() string { return proto.CompactTextString(m) }
+func (*ThreadRekey) ProtoMessage()    {}
+func (*ThreadRekey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{12}
+}
+func (m *ThreadRekey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRekey.Unmarshal(m, b)
+}
+func (m *ThreadRekey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRekey.Marshal(b, m, deterministic)
+}
+func (dst *ThreadRekey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRekey.Merge(dst, src)
+}
+func (m *ThreadRekey) XXX_Size() int {
+	return xxx_messageInfo_ThreadRekey.Size(m)
+}
+func (m *ThreadRekey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRekey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRekey proto.InternalMessageInfo
+
+func (m *ThreadRekey) GetEpoch() int32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ThreadRekey) GetKeys() map[string][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type ThreadMessage struct {
 	Body                 string   `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -491,7 +728,7 @@ func (m *ThreadMessage) Reset()         { *m = ThreadMessage{} }
 func (m *ThreadMessage) String() string { return proto.CompactTextString(m) }
 func (*ThreadMessage) ProtoMessage()    {}
 func (*ThreadMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{9}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{13}
 }
 func (m *ThreadMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadMessage.Unmarshal(m, b)
@@ -518,6 +755,60 @@ func (m *ThreadMessage) GetBody() string {
 	return ""
 }
 
+type ThreadEdit struct {
+	Body                 string            `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Data                 string            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Keys                 map[string]string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ThreadEdit) Reset()         { *m = ThreadEdit{} }
+func (m *ThreadEdit) String() string { return proto.CompactTextString(m) }
+func (*ThreadEdit) ProtoMessage()    {}
+func (*ThreadEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{14}
+}
+func (m *ThreadEdit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadEdit.Unmarshal(m, b)
+}
+func (m *ThreadEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadEdit.Marshal(b, m, deterministic)
+}
+func (dst *ThreadEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadEdit.Merge(dst, src)
+}
+func (m *ThreadEdit) XXX_Size() int {
+	return xxx_messageInfo_ThreadEdit.Size(m)
+}
+func (m *ThreadEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadEdit proto.InternalMessageInfo
+
+func (m *ThreadEdit) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *ThreadEdit) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ThreadEdit) GetKeys() map[string]string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type ThreadFiles struct {
 	Target               string            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"` // Deprecated: Do not use.
 	Body                 string            `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
@@ -531,7 +822,7 @@ func (m *ThreadFiles) Reset()         { *m = ThreadFiles{} }
 func (m *ThreadFiles) String() string { return proto.CompactTextString(m) }
 func (*ThreadFiles) ProtoMessage()    {}
 func (*ThreadFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{10}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{15}
 }
 func (m *ThreadFiles) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadFiles.Unmarshal(m, b)
@@ -586,7 +877,7 @@ func (m *ThreadComment) Reset()         { *m = ThreadComment{} }
 func (m *ThreadComment) String() string { return proto.CompactTextString(m) }
 func (*ThreadComment) ProtoMessage()    {}
 func (*ThreadComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{11}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{16}
 }
 func (m *ThreadComment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadComment.Unmarshal(m, b)
@@ -632,7 +923,7 @@ func (m *ThreadLike) Reset()         { *m = ThreadLike{} }
 func (m *ThreadLike) String() string { return proto.CompactTextString(m) }
 func (*ThreadLike) ProtoMessage()    {}
 func (*ThreadLike) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_dc83fc8030da8efe, []int{12}
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{17}
 }
 func (m *ThreadLike) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadLike.Unmarshal(m, b)
@@ -659,6 +950,44 @@ func (m *ThreadLike) GetTarget() string {
 	return ""
 }
 
+type ThreadReaction struct {
+	Emoji                string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadReaction) Reset()         { *m = ThreadReaction{} }
+func (m *ThreadReaction) String() string { return proto.CompactTextString(m) }
+func (*ThreadReaction) ProtoMessage()    {}
+func (*ThreadReaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_4c1918b4c91090dc, []int{18}
+}
+func (m *ThreadReaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadReaction.Unmarshal(m, b)
+}
+func (m *ThreadReaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadReaction.Marshal(b, m, deterministic)
+}
+func (dst *ThreadReaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadReaction.Merge(dst, src)
+}
+func (m *ThreadReaction) XXX_Size() int {
+	return xxx_messageInfo_ThreadReaction.Size(m)
+}
+func (m *ThreadReaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadReaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadReaction proto.InternalMessageInfo
+
+func (m *ThreadReaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ThreadEnvelope)(nil), "ThreadEnvelope")
 	proto.RegisterType((*ThreadEnvelopeAck)(nil), "ThreadEnvelopeAck")
@@ -669,56 +998,83 @@ func init() {
 	proto.RegisterType((*ThreadFlag)(nil), "ThreadFlag")
 	proto.RegisterType((*ThreadJoin)(nil), "ThreadJoin")
 	proto.RegisterType((*ThreadAnnounce)(nil), "ThreadAnnounce")
+	proto.RegisterType((*ThreadMembership)(nil), "ThreadMembership")
+	proto.RegisterType((*ThreadRetentionPolicy)(nil), "ThreadRetentionPolicy")
+	proto.RegisterType((*ThreadSchemaUpdate)(nil), "ThreadSchemaUpdate")
+	proto.RegisterType((*ThreadRekey)(nil), "ThreadRekey")
+	proto.RegisterMapType((map[string][]byte)(nil), "ThreadRekey.KeysEntry")
 	proto.RegisterType((*ThreadMessage)(nil), "ThreadMessage")
+	proto.RegisterType((*ThreadEdit)(nil), "ThreadEdit")
+	proto.RegisterMapType((map[string]string)(nil), "ThreadEdit.KeysEntry")
 	proto.RegisterType((*ThreadFiles)(nil), "ThreadFiles")
 	proto.RegisterMapType((map[string]string)(nil), "ThreadFiles.KeysEntry")
 	proto.RegisterType((*ThreadComment)(nil), "ThreadComment")
 	proto.RegisterType((*ThreadLike)(nil), "ThreadLike")
+	proto.RegisterType((*ThreadReaction)(nil), "ThreadReaction")
+	proto.RegisterEnum("ThreadMembership_Action", ThreadMembership_Action_name, ThreadMembership_Action_value)
 }
 
 func init() {
-	proto.RegisterFile("threads_service.proto", fileDescriptor_threads_service_dc83fc8030da8efe)
-}
-
-var fileDescriptor_threads_service_dc83fc8030da8efe = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x45, 0xb2, 0x62, 0xe3, 0x71, 0x12, 0xd2, 0x6d, 0x1a, 0x14, 0x53, 0x88, 0x51, 0x7a, 0x30,
-	0x39, 0x28, 0xe0, 0x1e, 0x5a, 0x72, 0x09, 0x4e, 0x49, 0xe8, 0x27, 0x14, 0x91, 0x53, 0x2f, 0x65,
-	0x6d, 0x4d, 0xe5, 0xc5, 0xf2, 0xae, 0xd8, 0x5d, 0x9b, 0xea, 0x57, 0xf4, 0xd0, 0x3f, 0x50, 0xfa,
-	0x4b, 0x8b, 0xf6, 0x43, 0x71, 0x9a, 0x36, 0x17, 0xb3, 0x6f, 0xe6, 0xed, 0xce, 0x9b, 0x99, 0x27,
-	0xc3, 0x33, 0xbd, 0x90, 0x48, 0x73, 0xf5, 0x55, 0xa1, 0xdc, 0xb0, 0x39, 0xa6, 0x95, 0x14, 0x5a,
-	0x0c, 0x8f, 0x0b, 0x21, 0x8a, 0x12, 0xcf, 0x0d, 0x9a, 0xad, 0xbf, 0x9d, 0x53, 0x5e, 0xbb, 0xd4,
-	0xc9, 0xdf, 0x29, 0xcd, 0x56, 0xa8, 0x34, 0x5d, 0x55, 0x8e, 0x30, 0x58, 0x89, 0x1c, 0x4b, 0x0b,
-	0x92, 0x5f, 0x01, 0xec, 0xdf, 0x9a, 0x12, 0xd7, 0x7c, 0x83, 0xa5, 0xa8, 0x90, 0x1c, 0x41, 0xd7,
-	0x16, 0x8d, 0x83, 0x51, 0x30, 0xee, 0x67, 0x0e, 0x91, 0x23, 0x88, 0x16, 0x54, 0x2d, 0xe2, 0xb0,
-	0x89, 0x5e, 0x85, 0x71, 0x90, 0x19, 0x4c, 0x12, 0x80, 0x39, 0xab, 0x16, 0x28, 0x35, 0x7e, 0xd7,
-	0x71, 0x67, 0x14, 0x8c, 0x77, 0x4d, 0x76, 0x2b, 0x4a, 0x0e, 0xa0, 0xa3, 0x58, 0x11, 0x47, 0x4d,
-	0x32, 0x6b, 0x8e, 0x84, 0x40, 0xc4, 0x45, 0x8e, 0xf1, 0x8e, 0x09, 0x99, 0x33, 0x39, 0x84, 0x9d,
-	0x59, 0x29, 0xe6, 0xcb, 0xb8, 0x6b, 0x82, 0x16, 0x24, 0xa7, 0xf0, 0xe4, 0xbe, 0xc2, 0xe9, 0x7c,
-	0x49, 0xf6, 0x21, 0x64, 0x5e, 0x60, 0xc8, 0xf2, 0xe4, 0x47, 0x00, 0x03, 0xcb, 0xba, 0x6a, 0x2e,
-	0x91, 0x33, 0xe8, 0x2e, 0x90, 0xe6, 0x28, 0x0d, 0x67, 0x30, 0x21, 0xe9, 0x56, 0xf6, 0xad, 0xc9,
-	0x64, 0x8e, 0x41, 0x5e, 0x40, 0xa4, 0xeb, 0x0a, 0x4d, 0x63, 0xfb, 0x93, 0x83, 0xd4, 0x70, 0xec,
-	0xef, 0x6d, 0x5d, 0x61, 0x66, 0xb2, 0x24, 0x85, 0x5e, 0x45, 0xeb, 0x52, 0xd0, 0xdc, 0xf4, 0x38,
-	0x98, 0x1c, 0xa6, 0x76, 0xd2, 0xa9, 0x9f, 0x74, 0x3a, 0xe5, 0x75, 0xe6, 0x49, 0xc9, 0xcf, 0xc0,
-	0xeb, 0xde, 0xaa, 0x49, 0x52, 0x88, 0x72, 0xaa, 0xd1, 0xa9, 0x1a, 0x3e, 0x78, 0xe2, 0xd6, 0x2f,
-	0x2b, 0x33, 0x3c, 0xf2, 0xbc, 0xa9, 0x2a, 0x91, 0x6b, 0x15, 0x87, 0xa3, 0x8e, 0x9b, 0xbb, 0x0f,
-	0x35, 0xab, 0xa2, 0x6b, 0xbd, 0x10, 0xd2, 0x48, 0xea, 0x67, 0x0e, 0x91, 0x18, 0x7a, 0x34, 0xcf,
-	0x25, 0x2a, 0x65, 0x46, 0xde, 0xcf, 0x3c, 0x4c, 0x0a, 0xe8, 0x5b, 0x51, 0xd3, 0x3c, 0x27, 0x27,
-	0xd0, 0x63, 0x7c, 0xc3, 0x74, 0x3b, 0xa5, 0x9d, 0xf4, 0x33, 0xa2, 0xcc, 0x7c, 0x94, 0x9c, 0xb4,
-	0x56, 0x08, 0x4d, 0xbe, 0xe7, 0xa6, 0xd8, 0x7a, 0x22, 0xf6, 0x2f, 0xa0, 0x53, 0xe0, 0x61, 0x72,
-	0x06, 0xbb, 0x96, 0xfb, 0xae, 0xe0, 0x42, 0x5a, 0x57, 0x51, 0x59, 0xa0, 0x6e, 0x5d, 0x65, 0xd0,
-	0x45, 0x18, 0x07, 0xc9, 0x18, 0xc0, 0x72, 0x6f, 0x4a, 0x5a, 0x3c, 0xca, 0x9c, 0x7a, 0xe6, 0x7b,
-	0xc1, 0xf8, 0x5d, 0x75, 0xe9, 0xa8, 0xad, 0xf0, 0x63, 0x88, 0x2a, 0x44, 0xe9, 0x64, 0xbb, 0xb6,
-	0x4c, 0x28, 0xb9, 0xf4, 0x86, 0x9f, 0x72, 0x2e, 0xd6, 0x7c, 0x8e, 0x2d, 0x39, 0x78, 0x40, 0x36,
-	0x2e, 0xa5, 0x2b, 0x6b, 0x8d, 0x7e, 0x66, 0xce, 0xc9, 0x29, 0xec, 0xd9, 0x07, 0x3e, 0xa1, 0x52,
-	0xb4, 0xc0, 0x86, 0x34, 0x13, 0x79, 0xed, 0x34, 0x98, 0x73, 0xf2, 0xbb, 0xf5, 0xe3, 0x0d, 0x2b,
-	0x51, 0x91, 0xe1, 0xfd, 0xa6, 0xcc, 0x1a, 0x5d, 0xa4, 0xbd, 0x1f, 0xde, 0xdd, 0x27, 0x67, 0x10,
-	0x2d, 0xb1, 0x56, 0x71, 0x67, 0xd4, 0x19, 0x0f, 0x26, 0x47, 0xe9, 0xd6, 0x5b, 0xe9, 0x07, 0xac,
-	0xd5, 0x35, 0xd7, 0xb2, 0xce, 0x0c, 0x67, 0xf8, 0x0a, 0xfa, 0x6d, 0xa8, 0xf9, 0xd2, 0x96, 0xe8,
-	0xb5, 0x34, 0xc7, 0xe6, 0xab, 0xda, 0xd0, 0x72, 0xed, 0x9b, 0xb0, 0xe0, 0x22, 0x7c, 0x1d, 0x24,
-	0x97, 0xbe, 0x93, 0x37, 0x62, 0xb5, 0x42, 0xae, 0xff, 0x37, 0xfa, 0x7f, 0x29, 0xbc, 0xbf, 0xb8,
-	0x8f, 0x6c, 0xf9, 0xe8, 0x8a, 0xaf, 0x9e, 0xc2, 0x1e, 0x13, 0x69, 0xf3, 0x5f, 0xc0, 0x1a, 0xbb,
-	0xcf, 0xbe, 0x84, 0xd5, 0x6c, 0xd6, 0x35, 0xb6, 0x7f, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0x00,
-	0x27, 0xfa, 0x31, 0xe5, 0x04, 0x00, 0x00,
+	proto.RegisterFile("threads_service.proto", fileDescriptor_threads_service_4c1918b4c91090dc)
+}
+
+var fileDescriptor_threads_service_4c1918b4c91090dc = []byte{
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x8e, 0xdc, 0x44,
+	0x10, 0xc6, 0xf6, 0xfc, 0xe0, 0x9a, 0xdd, 0xd5, 0xd0, 0x64, 0x17, 0x67, 0x05, 0xda, 0x95, 0x83,
+	0xa2, 0xc9, 0x1e, 0x1c, 0x34, 0x44, 0x02, 0xe5, 0xb2, 0x9a, 0x25, 0x13, 0x7e, 0x42, 0xd8, 0xa8,
+	0x59, 0x72, 0xe0, 0x12, 0xf5, 0xd8, 0x85, 0xdd, 0x8c, 0xed, 0xb6, 0x6c, 0xef, 0x68, 0xfc, 0x06,
+	0xdc, 0xb8, 0x72, 0x44, 0x3c, 0x07, 0xaf, 0xc5, 0x1d, 0xb9, 0x7f, 0xbc, 0x9e, 0x24, 0x2c, 0x07,
+	0xb8, 0x8c, 0x5c, 0x55, 0x5f, 0x77, 0x7f, 0x55, 0xf5, 0x55, 0x0d, 0x1c, 0xd6, 0x49, 0x89, 0x2c,
+	0xaa, 0x5e, 0x55, 0x58, 0x6e, 0x78, 0x88, 0x41, 0x51, 0x8a, 0x5a, 0x1c, 0xdf, 0x8d, 0x85, 0x88,
+	0x53, 0x7c, 0x28, 0xad, 0xd5, 0xf5, 0x4f, 0x0f, 0x59, 0xde, 0xe8, 0xd0, 0xc9, 0xeb, 0xa1, 0x9a,
+	0x67, 0x58, 0xd5, 0x2c, 0x2b, 0x34, 0x60, 0x92, 0x89, 0x08, 0x53, 0x65, 0xf8, 0xbf, 0x5b, 0x70,
+	0x70, 0x25, 0x9f, 0x58, 0xe6, 0x1b, 0x4c, 0x45, 0x81, 0xe4, 0x08, 0x46, 0xea, 0x51, 0xcf, 0x3a,
+	0xb5, 0x66, 0x2e, 0xd5, 0x16, 0x39, 0x82, 0x41, 0xc2, 0xaa, 0xc4, 0xb3, 0x5b, 0xef, 0x85, 0xed,
+	0x59, 0x54, 0xda, 0xc4, 0x07, 0x08, 0x79, 0x91, 0x60, 0x59, 0xe3, 0xb6, 0xf6, 0x9c, 0x53, 0x6b,
+	0xb6, 0x27, 0xa3, 0x3d, 0x2f, 0x99, 0x82, 0x53, 0xf1, 0xd8, 0x1b, 0xb4, 0x41, 0xda, 0x7e, 0x12,
+	0x02, 0x83, 0x5c, 0x44, 0xe8, 0x0d, 0xa5, 0x4b, 0x7e, 0x93, 0x3b, 0x30, 0x5c, 0xa5, 0x22, 0x5c,
+	0x7b, 0x23, 0xe9, 0x54, 0x86, 0x7f, 0x0f, 0xde, 0xdb, 0x65, 0xb8, 0x08, 0xd7, 0xe4, 0x00, 0x6c,
+	0x6e, 0x08, 0xda, 0x3c, 0xf2, 0x7f, 0xb5, 0x60, 0xa2, 0x50, 0x17, 0xed, 0x21, 0x72, 0x06, 0xa3,
+	0x04, 0x59, 0x84, 0xa5, 0xc4, 0x4c, 0xe6, 0x24, 0xe8, 0x45, 0xbf, 0x92, 0x11, 0xaa, 0x11, 0xe4,
+	0x63, 0x18, 0xd4, 0x4d, 0x81, 0x32, 0xb1, 0x83, 0xf9, 0x34, 0x90, 0x18, 0xf5, 0x7b, 0xd5, 0x14,
+	0x48, 0x65, 0x94, 0x04, 0x30, 0x2e, 0x58, 0x93, 0x0a, 0x16, 0xc9, 0x1c, 0x27, 0xf3, 0x3b, 0x81,
+	0xaa, 0x74, 0x60, 0x2a, 0x1d, 0x2c, 0xf2, 0x86, 0x1a, 0x90, 0xff, 0x97, 0x65, 0x78, 0xf7, 0xde,
+	0x24, 0x01, 0x0c, 0x22, 0x56, 0xa3, 0x66, 0x75, 0xfc, 0xc6, 0x15, 0x57, 0xa6, 0x59, 0x54, 0xe2,
+	0xc8, 0x87, 0xed, 0xab, 0x25, 0xe6, 0x75, 0xe5, 0xd9, 0xa7, 0x8e, 0xae, 0xbb, 0x71, 0xb5, 0xad,
+	0x62, 0xd7, 0x75, 0x22, 0x4a, 0x49, 0xc9, 0xa5, 0xda, 0x22, 0x1e, 0x8c, 0x59, 0x14, 0x95, 0x58,
+	0x55, 0xb2, 0xe4, 0x2e, 0x35, 0x26, 0x79, 0x04, 0x63, 0xdc, 0x16, 0xbc, 0xc4, 0xca, 0x1b, 0xfe,
+	0x2b, 0x05, 0x03, 0x6d, 0x1b, 0x13, 0x76, 0x8d, 0x71, 0xa8, 0x32, 0x5a, 0x2f, 0x16, 0x22, 0x4c,
+	0xbc, 0xf1, 0xa9, 0x35, 0x1b, 0x52, 0x65, 0xf8, 0x31, 0xb8, 0x2a, 0xed, 0x45, 0x14, 0x91, 0x13,
+	0x18, 0xf3, 0x7c, 0xc3, 0xeb, 0xae, 0x0f, 0xc3, 0xe0, 0x05, 0x62, 0x49, 0x8d, 0x97, 0x9c, 0x74,
+	0x62, 0xb3, 0x65, 0x7c, 0xac, 0xfb, 0xd4, 0xa9, 0xce, 0x33, 0x37, 0xa0, 0xce, 0xd1, 0x98, 0xfe,
+	0x19, 0xec, 0x29, 0xec, 0xd7, 0x71, 0x2e, 0x4a, 0xa5, 0x5b, 0x56, 0xc6, 0x58, 0x77, 0xba, 0x95,
+	0xd6, 0x63, 0xdb, 0xb3, 0xfc, 0x19, 0x80, 0xc2, 0x3e, 0x4d, 0x59, 0x7c, 0x2b, 0x72, 0x61, 0x90,
+	0xdf, 0x08, 0x9e, 0x13, 0x6f, 0x97, 0xbf, 0x7b, 0x43, 0xfc, 0x2e, 0x0c, 0x0a, 0xc4, 0xd2, 0xb3,
+	0xfb, 0x69, 0x49, 0x97, 0x7f, 0x6e, 0x46, 0x6a, 0x91, 0xe7, 0xe2, 0x3a, 0x0f, 0xb1, 0x03, 0x5b,
+	0x6f, 0x80, 0xe5, 0x1c, 0xb0, 0x4c, 0x89, 0xcf, 0xa5, 0xf2, 0xdb, 0xff, 0xd3, 0x82, 0xa9, 0xba,
+	0xe1, 0x39, 0x66, 0x2b, 0x2c, 0xab, 0x84, 0x17, 0xfd, 0x9e, 0x5a, 0xbb, 0x3d, 0xfd, 0x04, 0x46,
+	0x2c, 0xac, 0xb9, 0xc8, 0xb5, 0x82, 0xbd, 0xe0, 0xf5, 0xc3, 0xc1, 0x42, 0xc6, 0xa9, 0xc6, 0x91,
+	0xfb, 0x30, 0x28, 0x45, 0xaa, 0x2a, 0x7a, 0x30, 0x27, 0x3b, 0xf8, 0x80, 0x8a, 0x14, 0xa9, 0x8c,
+	0xfb, 0x8f, 0x60, 0xa4, 0x4e, 0x92, 0x31, 0x38, 0x8b, 0x27, 0x4f, 0xa6, 0xef, 0x10, 0x80, 0x11,
+	0x5d, 0x3e, 0xbf, 0x7c, 0xb9, 0x9c, 0x5a, 0xc4, 0x85, 0xe1, 0x97, 0x74, 0xf1, 0xdd, 0xd5, 0xd4,
+	0x56, 0xee, 0x97, 0x97, 0xcf, 0x96, 0x53, 0xc7, 0xbf, 0x84, 0x43, 0xdd, 0x44, 0xac, 0x31, 0x6f,
+	0x8f, 0xbf, 0x10, 0x29, 0x0f, 0x1b, 0xf2, 0x01, 0x8c, 0x33, 0xb6, 0x7d, 0xc5, 0x62, 0xa5, 0x7f,
+	0x87, 0x8e, 0x32, 0xb6, 0x5d, 0xc4, 0x48, 0x3e, 0x02, 0x68, 0x03, 0x72, 0xde, 0x2b, 0x99, 0xc5,
+	0x90, 0xba, 0x19, 0xdb, 0xca, 0xc9, 0xa9, 0xfc, 0x73, 0x20, 0xea, 0xc2, 0xef, 0xc3, 0x04, 0x33,
+	0xf6, 0x43, 0x21, 0x47, 0xe3, 0x01, 0xbc, 0xbb, 0xc1, 0xb2, 0xe2, 0x22, 0x6f, 0x2b, 0xe2, 0xcc,
+	0x26, 0xf3, 0xfd, 0xa0, 0x0f, 0xa3, 0x5d, 0xd8, 0xff, 0xa5, 0xdb, 0x0e, 0x14, 0xd7, 0xd8, 0xdc,
+	0x28, 0xd7, 0xea, 0x29, 0x97, 0x9c, 0xc1, 0x60, 0x8d, 0x8d, 0x1a, 0xb4, 0xc9, 0xfc, 0x28, 0xe8,
+	0x9d, 0x08, 0x9e, 0x61, 0x53, 0x2d, 0xf3, 0xba, 0x6c, 0xa8, 0xc4, 0x1c, 0x7f, 0x06, 0x6e, 0xe7,
+	0x6a, 0xb7, 0xdb, 0x1a, 0x1b, 0xdd, 0x16, 0x47, 0x3f, 0xb0, 0x61, 0xe9, 0xb5, 0x6a, 0xeb, 0x1e,
+	0x55, 0xc6, 0x63, 0xfb, 0x73, 0xcb, 0xbf, 0x07, 0xfb, 0xa6, 0xda, 0x55, 0xc5, 0x62, 0x6c, 0x05,
+	0xb0, 0x12, 0x91, 0x39, 0x2d, 0xbf, 0xfd, 0xdf, 0x2c, 0xa3, 0xc2, 0x65, 0xc4, 0xeb, 0xb7, 0x41,
+	0x5a, 0x5f, 0xc4, 0x6a, 0x66, 0x74, 0xd3, 0x7e, 0x93, 0x07, 0x3a, 0x01, 0x47, 0x26, 0x70, 0x18,
+	0xdc, 0x5c, 0xf1, 0x9f, 0xf8, 0xbb, 0x7d, 0xfe, 0x7f, 0x74, 0xa5, 0x7c, 0xca, 0x53, 0xac, 0xc8,
+	0xf1, 0xee, 0x2c, 0xc9, 0xfd, 0xa4, 0x3d, 0x1d, 0x6f, 0xbb, 0xc7, 0xfb, 0x6c, 0x87, 0xe3, 0x51,
+	0xd0, 0xbb, 0xeb, 0xff, 0x23, 0x79, 0x6e, 0x8a, 0xfc, 0x85, 0xc8, 0x32, 0xcc, 0xeb, 0x7f, 0x9a,
+	0xf8, 0xb7, 0x31, 0xdc, 0xdd, 0x17, 0xdf, 0xf2, 0xf5, 0xed, 0x9b, 0xe5, 0xbe, 0x19, 0x76, 0x8a,
+	0x7a, 0xb8, 0x5a, 0x71, 0x65, 0xe2, 0x67, 0xae, 0xc1, 0xca, 0xb8, 0x78, 0x1f, 0xf6, 0xb9, 0x08,
+	0xda, 0x3f, 0x43, 0xde, 0x2e, 0xdb, 0xd5, 0x8f, 0x76, 0xb1, 0x5a, 0x8d, 0xe4, 0xd2, 0xfd, 0xf4,
+	0xef, 0x01, 0x00, 0x4b, 0xac, 0x92, 0xcd, 0xe6, 0x07, 0x00, 0x00,
 }
@@ -27,6 +27,9 @@ const (
 	AddThreadConfig_Schema_BLOB        AddThreadConfig_Schema_Preset = 1
 	AddThreadConfig_Schema_CAMERA_ROLL AddThreadConfig_Schema_Preset = 2
 	AddThreadConfig_Schema_MEDIA       AddThreadConfig_Schema_Preset = 3
+	AddThreadConfig_Schema_VIDEO       AddThreadConfig_Schema_Preset = 4
+	AddThreadConfig_Schema_AUDIO       AddThreadConfig_Schema_Preset = 5
+	AddThreadConfig_Schema_DOCUMENT    AddThreadConfig_Schema_Preset = 6
 )
 
 var AddThreadConfig_Schema_Preset_name = map[int32]string{
@@ -34,19 +37,25 @@ var AddThreadConfig_Schema_Preset_name = map[int32]string{
 	1: "BLOB",
 	2: "CAMERA_ROLL",
 	3: "MEDIA",
+	4: "VIDEO",
+	5: "AUDIO",
+	6: "DOCUMENT",
 }
 var AddThreadConfig_Schema_Preset_value = map[string]int32{
 	"NONE":        0,
 	"BLOB":        1,
 	"CAMERA_ROLL": 2,
 	"MEDIA":       3,
+	"VIDEO":       4,
+	"AUDIO":       5,
+	"DOCUMENT":    6,
 }
 
 func (x AddThreadConfig_Schema_Preset) String() string {
 	return proto.EnumName(AddThreadConfig_Schema_Preset_name, int32(x))
 }
 func (AddThreadConfig_Schema_Preset) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{0, 0, 0}
+	return fileDescriptor_view_b5330e9359925ac6, []int{0, 0, 0}
 }
 
 type FeedRequest_Mode int32
@@ -72,7 +81,7 @@ func (x FeedRequest_Mode) String() string {
 	return proto.EnumName(FeedRequest_Mode_name, int32(x))
 }
 func (FeedRequest_Mode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{9, 0}
+	return fileDescriptor_view_b5330e9359925ac6, []int{9, 0}
 }
 
 type AccountUpdate_Type int32
@@ -101,7 +110,7 @@ func (x AccountUpdate_Type) String() string {
 	return proto.EnumName(AccountUpdate_Type_name, int32(x))
 }
 func (AccountUpdate_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{27, 0}
+	return fileDescriptor_view_b5330e9359925ac6, []int{32, 0}
 }
 
 type LogLevel_Level int32
@@ -136,7 +145,7 @@ func (x LogLevel_Level) String() string {
 	return proto.EnumName(LogLevel_Level_name, int32(x))
 }
 func (LogLevel_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{29, 0}
+	return fileDescriptor_view_b5330e9359925ac6, []int{34, 0}
 }
 
 type AddThreadConfig struct {
@@ -156,7 +165,7 @@ func (m *AddThreadConfig) Reset()         { *m = AddThreadConfig{} }
 func (m *AddThreadConfig) String() string { return proto.CompactTextString(m) }
 func (*AddThreadConfig) ProtoMessage()    {}
 func (*AddThreadConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{0}
+	return fileDescriptor_view_b5330e9359925ac6, []int{0}
 }
 func (m *AddThreadConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddThreadConfig.Unmarshal(m, b)
@@ -238,7 +247,7 @@ func (m *AddThreadConfig_Schema) Reset()         { *m = AddThreadConfig_Schema{}
 func (m *AddThreadConfig_Schema) String() string { return proto.CompactTextString(m) }
 func (*AddThreadConfig_Schema) ProtoMessage()    {}
 func (*AddThreadConfig_Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{0, 0}
+	return fileDescriptor_view_b5330e9359925ac6, []int{0, 0}
 }
 func (m *AddThreadConfig_Schema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddThreadConfig_Schema.Unmarshal(m, b)
@@ -292,7 +301,7 @@ func (m *BlockViz) Reset()         { *m = BlockViz{} }
 func (m *BlockViz) String() string { return proto.CompactTextString(m) }
 func (*BlockViz) ProtoMessage()    {}
 func (*BlockViz) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{1}
+	return fileDescriptor_view_b5330e9359925ac6, []int{1}
 }
 func (m *BlockViz) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockViz.Unmarshal(m, b)
@@ -345,7 +354,7 @@ func (m *Step) Reset()         { *m = Step{} }
 func (m *Step) String() string { return proto.CompactTextString(m) }
 func (*Step) ProtoMessage()    {}
 func (*Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{2}
+	return fileDescriptor_view_b5330e9359925ac6, []int{2}
 }
 func (m *Step) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Step.Unmarshal(m, b)
@@ -390,7 +399,7 @@ func (m *Directory) Reset()         { *m = Directory{} }
 func (m *Directory) String() string { return proto.CompactTextString(m) }
 func (*Directory) ProtoMessage()    {}
 func (*Directory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{3}
+	return fileDescriptor_view_b5330e9359925ac6, []int{3}
 }
 func (m *Directory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Directory.Unmarshal(m, b)
@@ -428,7 +437,7 @@ func (m *DirectoryList) Reset()         { *m = DirectoryList{} }
 func (m *DirectoryList) String() string { return proto.CompactTextString(m) }
 func (*DirectoryList) ProtoMessage()    {}
 func (*DirectoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{4}
+	return fileDescriptor_view_b5330e9359925ac6, []int{4}
 }
 func (m *DirectoryList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryList.Unmarshal(m, b)
@@ -466,7 +475,7 @@ func (m *Keys) Reset()         { *m = Keys{} }
 func (m *Keys) String() string { return proto.CompactTextString(m) }
 func (*Keys) ProtoMessage()    {}
 func (*Keys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{5}
+	return fileDescriptor_view_b5330e9359925ac6, []int{5}
 }
 func (m *Keys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Keys.Unmarshal(m, b)
@@ -507,7 +516,7 @@ func (m *InviteView) Reset()         { *m = InviteView{} }
 func (m *InviteView) String() string { return proto.CompactTextString(m) }
 func (*InviteView) ProtoMessage()    {}
 func (*InviteView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{6}
+	return fileDescriptor_view_b5330e9359925ac6, []int{6}
 }
 func (m *InviteView) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteView.Unmarshal(m, b)
@@ -566,7 +575,7 @@ func (m *InviteViewList) Reset()         { *m = InviteViewList{} }
 func (m *InviteViewList) String() string { return proto.CompactTextString(m) }
 func (*InviteViewList) ProtoMessage()    {}
 func (*InviteViewList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{7}
+	return fileDescriptor_view_b5330e9359925ac6, []int{7}
 }
 func (m *InviteViewList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteViewList.Unmarshal(m, b)
@@ -606,7 +615,7 @@ func (m *ExternalInvite) Reset()         { *m = ExternalInvite{} }
 func (m *ExternalInvite) String() string { return proto.CompactTextString(m) }
 func (*ExternalInvite) ProtoMessage()    {}
 func (*ExternalInvite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{8}
+	return fileDescriptor_view_b5330e9359925ac6, []int{8}
 }
 func (m *ExternalInvite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExternalInvite.Unmarshal(m, b)
@@ -652,6 +661,7 @@ type FeedRequest struct {
 	Offset               string           `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	Limit                int32            `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Mode                 FeedRequest_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=FeedRequest_Mode" json:"mode,omitempty"`
+	Sort                 Block_BlockSort  `protobuf:"varint,5,opt,name=sort,proto3,enum=Block_BlockSort" json:"sort,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -661,7 +671,7 @@ func (m *FeedRequest) Reset()         { *m = FeedRequest{} }
 func (m *FeedRequest) String() string { return proto.CompactTextString(m) }
 func (*FeedRequest) ProtoMessage()    {}
 func (*FeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{9}
+	return fileDescriptor_view_b5330e9359925ac6, []int{9}
 }
 func (m *FeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedRequest.Unmarshal(m, b)
@@ -709,6 +719,13 @@ func (m *FeedRequest) GetMode() FeedRequest_Mode {
 	return FeedRequest_CHRONO
 }
 
+func (m *FeedRequest) GetSort() Block_BlockSort {
+	if m != nil {
+		return m.Sort
+	}
+	return Block_DATE
+}
+
 type FeedItem struct {
 	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	Thread               string   `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
@@ -722,7 +739,7 @@ func (m *FeedItem) Reset()         { *m = FeedItem{} }
 func (m *FeedItem) String() string { return proto.CompactTextString(m) }
 func (*FeedItem) ProtoMessage()    {}
 func (*FeedItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{10}
+	return fileDescriptor_view_b5330e9359925ac6, []int{10}
 }
 func (m *FeedItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedItem.Unmarshal(m, b)
@@ -776,7 +793,7 @@ func (m *FeedItemList) Reset()         { *m = FeedItemList{} }
 func (m *FeedItemList) String() string { return proto.CompactTextString(m) }
 func (*FeedItemList) ProtoMessage()    {}
 func (*FeedItemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{11}
+	return fileDescriptor_view_b5330e9359925ac6, []int{11}
 }
 func (m *FeedItemList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedItemList.Unmarshal(m, b)
@@ -832,7 +849,7 @@ func (m *Merge) Reset()         { *m = Merge{} }
 func (m *Merge) String() string { return proto.CompactTextString(m) }
 func (*Merge) ProtoMessage()    {}
 func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{12}
+	return fileDescriptor_view_b5330e9359925ac6, []int{12}
 }
 func (m *Merge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Merge.Unmarshal(m, b)
@@ -894,7 +911,7 @@ func (m *Ignore) Reset()         { *m = Ignore{} }
 func (m *Ignore) String() string { return proto.CompactTextString(m) }
 func (*Ignore) ProtoMessage()    {}
 func (*Ignore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{13}
+	return fileDescriptor_view_b5330e9359925ac6, []int{13}
 }
 func (m *Ignore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ignore.Unmarshal(m, b)
@@ -956,7 +973,7 @@ func (m *Flag) Reset()         { *m = Flag{} }
 func (m *Flag) String() string { return proto.CompactTextString(m) }
 func (*Flag) ProtoMessage()    {}
 func (*Flag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{14}
+	return fileDescriptor_view_b5330e9359925ac6, []int{14}
 }
 func (m *Flag) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Flag.Unmarshal(m, b)
@@ -1018,7 +1035,7 @@ func (m *Join) Reset()         { *m = Join{} }
 func (m *Join) String() string { return proto.CompactTextString(m) }
 func (*Join) ProtoMessage()    {}
 func (*Join) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{15}
+	return fileDescriptor_view_b5330e9359925ac6, []int{15}
 }
 func (m *Join) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Join.Unmarshal(m, b)
@@ -1079,7 +1096,7 @@ func (m *Announce) Reset()         { *m = Announce{} }
 func (m *Announce) String() string { return proto.CompactTextString(m) }
 func (*Announce) ProtoMessage()    {}
 func (*Announce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{16}
+	return fileDescriptor_view_b5330e9359925ac6, []int{16}
 }
 func (m *Announce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Announce.Unmarshal(m, b)
@@ -1134,7 +1151,7 @@ func (m *Leave) Reset()         { *m = Leave{} }
 func (m *Leave) String() string { return proto.CompactTextString(m) }
 func (*Leave) ProtoMessage()    {}
 func (*Leave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{17}
+	return fileDescriptor_view_b5330e9359925ac6, []int{17}
 }
 func (m *Leave) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Leave.Unmarshal(m, b)
@@ -1189,6 +1206,11 @@ type Text struct {
 	Body                 string               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	Comments             []*Comment           `protobuf:"bytes,5,rep,name=comments,proto3" json:"comments,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,6,rep,name=likes,proto3" json:"likes,omitempty"`
+	Edits                []*Edit              `protobuf:"bytes,7,rep,name=edits,proto3" json:"edits,omitempty"`
+	Reactions            []*ReactionGroup     `protobuf:"bytes,8,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Target               *FeedItem            `protobuf:"bytes,9,opt,name=target,proto3" json:"target,omitempty"`
+	Replies              []*Text              `protobuf:"bytes,10,rep,name=replies,proto3" json:"replies,omitempty"`
+	Mentions             []string             `protobuf:"bytes,11,rep,name=mentions,proto3" json:"mentions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1198,7 +1220,7 @@ func (m *Text) Reset()         { *m = Text{} }
 func (m *Text) String() string { return proto.CompactTextString(m) }
 func (*Text) ProtoMessage()    {}
 func (*Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{18}
+	return fileDescriptor_view_b5330e9359925ac6, []int{18}
 }
 func (m *Text) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Text.Unmarshal(m, b)
@@ -1260,6 +1282,41 @@ func (m *Text) GetLikes() []*Like {
 	return nil
 }
 
+func (m *Text) GetEdits() []*Edit {
+	if m != nil {
+		return m.Edits
+	}
+	return nil
+}
+
+func (m *Text) GetReactions() []*ReactionGroup {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *Text) GetTarget() *FeedItem {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Text) GetReplies() []*Text {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
+func (m *Text) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
 type TextList struct {
 	Items                []*Text  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1271,7 +1328,7 @@ func (m *TextList) Reset()         { *m = TextList{} }
 func (m *TextList) String() string { return proto.CompactTextString(m) }
 func (*TextList) ProtoMessage()    {}
 func (*TextList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{19}
+	return fileDescriptor_view_b5330e9359925ac6, []int{19}
 }
 func (m *TextList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TextList.Unmarshal(m, b)
@@ -1311,7 +1368,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{20}
+	return fileDescriptor_view_b5330e9359925ac6, []int{20}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_File.Unmarshal(m, b)
@@ -1363,6 +1420,9 @@ type Files struct {
 	Comments             []*Comment           `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,8,rep,name=likes,proto3" json:"likes,omitempty"`
 	Threads              []string             `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	Edits                []*Edit              `protobuf:"bytes,11,rep,name=edits,proto3" json:"edits,omitempty"`
+	Reactions            []*ReactionGroup     `protobuf:"bytes,12,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Replies              []*Text              `protobuf:"bytes,13,rep,name=replies,proto3" json:"replies,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1372,7 +1432,7 @@ func (m *Files) Reset()         { *m = Files{} }
 func (m *Files) String() string { return proto.CompactTextString(m) }
 func (*Files) ProtoMessage()    {}
 func (*Files) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{21}
+	return fileDescriptor_view_b5330e9359925ac6, []int{21}
 }
 func (m *Files) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Files.Unmarshal(m, b)
@@ -1462,6 +1522,27 @@ func (m *Files) GetThreads() []string {
 	return nil
 }
 
+func (m *Files) GetEdits() []*Edit {
+	if m != nil {
+		return m.Edits
+	}
+	return nil
+}
+
+func (m *Files) GetReactions() []*ReactionGroup {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *Files) GetReplies() []*Text {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
 type FilesList struct {
 	Items                []*Files `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1473,7 +1554,7 @@ func (m *FilesList) Reset()         { *m = FilesList{} }
 func (m *FilesList) String() string { return proto.CompactTextString(m) }
 func (*FilesList) ProtoMessage()    {}
 func (*FilesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{22}
+	return fileDescriptor_view_b5330e9359925ac6, []int{22}
 }
 func (m *FilesList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilesList.Unmarshal(m, b)
@@ -1516,7 +1597,7 @@ func (m *Comment) Reset()         { *m = Comment{} }
 func (m *Comment) String() string { return proto.CompactTextString(m) }
 func (*Comment) ProtoMessage()    {}
 func (*Comment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{23}
+	return fileDescriptor_view_b5330e9359925ac6, []int{23}
 }
 func (m *Comment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Comment.Unmarshal(m, b)
@@ -1583,7 +1664,7 @@ func (m *CommentList) Reset()         { *m = CommentList{} }
 func (m *CommentList) String() string { return proto.CompactTextString(m) }
 func (*CommentList) ProtoMessage()    {}
 func (*CommentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{24}
+	return fileDescriptor_view_b5330e9359925ac6, []int{24}
 }
 func (m *CommentList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommentList.Unmarshal(m, b)
@@ -1624,7 +1705,7 @@ func (m *Like) Reset()         { *m = Like{} }
 func (m *Like) String() string { return proto.CompactTextString(m) }
 func (*Like) ProtoMessage()    {}
 func (*Like) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{25}
+	return fileDescriptor_view_b5330e9359925ac6, []int{25}
 }
 func (m *Like) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Like.Unmarshal(m, b)
@@ -1683,7 +1764,7 @@ func (m *LikeList) Reset()         { *m = LikeList{} }
 func (m *LikeList) String() string { return proto.CompactTextString(m) }
 func (*LikeList) ProtoMessage()    {}
 func (*LikeList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{26}
+	return fileDescriptor_view_b5330e9359925ac6, []int{26}
 }
 func (m *LikeList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LikeList.Unmarshal(m, b)
@@ -1710,6 +1791,284 @@ func (m *LikeList) GetItems() []*Like {
 	return nil
 }
 
+type Edit struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Body                 string               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Target               *FeedItem            `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Data                 string               `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Edit) Reset()         { *m = Edit{} }
+func (m *Edit) String() string { return proto.CompactTextString(m) }
+func (*Edit) ProtoMessage()    {}
+func (*Edit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_b5330e9359925ac6, []int{27}
+}
+func (m *Edit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Edit.Unmarshal(m, b)
+}
+func (m *Edit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Edit.Marshal(b, m, deterministic)
+}
+func (dst *Edit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edit.Merge(dst, src)
+}
+func (m *Edit) XXX_Size() int {
+	return xxx_messageInfo_Edit.Size(m)
+}
+func (m *Edit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edit proto.InternalMessageInfo
+
+func (m *Edit) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Edit) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Edit) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *Edit) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Edit) GetTarget() *FeedItem {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Edit) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type EditList struct {
+	Items                []*Edit  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EditList) Reset()         { *m = EditList{} }
+func (m *EditList) String() string { return proto.CompactTextString(m) }
+func (*EditList) ProtoMessage()    {}
+func (*EditList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_b5330e9359925ac6, []int{28}
+}
+func (m *EditList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditList.Unmarshal(m, b)
+}
+func (m *EditList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditList.Marshal(b, m, deterministic)
+}
+func (dst *EditList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditList.Merge(dst, src)
+}
+func (m *EditList) XXX_Size() int {
+	return xxx_messageInfo_EditList.Size(m)
+}
+func (m *EditList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditList proto.InternalMessageInfo
+
+func (m *EditList) GetItems() []*Edit {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type Reaction struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Emoji                string               `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Target               *FeedItem            `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Reaction) Reset()         { *m = Reaction{} }
+func (m *Reaction) String() string { return proto.CompactTextString(m) }
+func (*Reaction) ProtoMessage()    {}
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_b5330e9359925ac6, []int{29}
+}
+func (m *Reaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reaction.Unmarshal(m, b)
+}
+func (m *Reaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reaction.Marshal(b, m, deterministic)
+}
+func (dst *Reaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reaction.Merge(dst, src)
+}
+func (m *Reaction) XXX_Size() int {
+	return xxx_messageInfo_Reaction.Size(m)
+}
+func (m *Reaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reaction proto.InternalMessageInfo
+
+func (m *Reaction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Reaction) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Reaction) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *Reaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *Reaction) GetTarget() *FeedItem {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type ReactionGroup struct {
+	Emoji                string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Users                []*User  `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReactionGroup) Reset()         { *m = ReactionGroup{} }
+func (m *ReactionGroup) String() string { return proto.CompactTextString(m) }
+func (*ReactionGroup) ProtoMessage()    {}
+func (*ReactionGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_b5330e9359925ac6, []int{30}
+}
+func (m *ReactionGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactionGroup.Unmarshal(m, b)
+}
+func (m *ReactionGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactionGroup.Marshal(b, m, deterministic)
+}
+func (dst *ReactionGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionGroup.Merge(dst, src)
+}
+func (m *ReactionGroup) XXX_Size() int {
+	return xxx_messageInfo_ReactionGroup.Size(m)
+}
+func (m *ReactionGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionGroup proto.InternalMessageInfo
+
+func (m *ReactionGroup) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ReactionGroup) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReactionGroup) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type ReactionGroupList struct {
+	Items                []*ReactionGroup `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReactionGroupList) Reset()         { *m = ReactionGroupList{} }
+func (m *ReactionGroupList) String() string { return proto.CompactTextString(m) }
+func (*ReactionGroupList) ProtoMessage()    {}
+func (*ReactionGroupList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_b5330e9359925ac6, []int{31}
+}
+func (m *ReactionGroupList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactionGroupList.Unmarshal(m, b)
+}
+func (m *ReactionGroupList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactionGroupList.Marshal(b, m, deterministic)
+}
+func (dst *ReactionGroupList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionGroupList.Merge(dst, src)
+}
+func (m *ReactionGroupList) XXX_Size() int {
+	return xxx_messageInfo_ReactionGroupList.Size(m)
+}
+func (m *ReactionGroupList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionGroupList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionGroupList proto.InternalMessageInfo
+
+func (m *ReactionGroupList) GetItems() []*ReactionGroup {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type AccountUpdate struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Key                  string             `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // Deprecated: Do not use.
@@ -1723,7 +2082,7 @@ func (m *AccountUpdate) Reset()         { *m = AccountUpdate{} }
 func (m *AccountUpdate) String() string { return proto.CompactTextString(m) }
 func (*AccountUpdate) ProtoMessage()    {}
 func (*AccountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{27}
+	return fileDescriptor_view_b5330e9359925ac6, []int{32}
 }
 func (m *AccountUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountUpdate.Unmarshal(m, b)
@@ -1781,7 +2140,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{28}
+	return fileDescriptor_view_b5330e9359925ac6, []int{33}
 }
 func (m *Summary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Summary.Unmarshal(m, b)
@@ -1854,7 +2213,7 @@ func (m *LogLevel) Reset()         { *m = LogLevel{} }
 func (m *LogLevel) String() string { return proto.CompactTextString(m) }
 func (*LogLevel) ProtoMessage()    {}
 func (*LogLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{29}
+	return fileDescriptor_view_b5330e9359925ac6, []int{34}
 }
 func (m *LogLevel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevel.Unmarshal(m, b)
@@ -1892,7 +2251,7 @@ func (m *Strings) Reset()         { *m = Strings{} }
 func (m *Strings) String() string { return proto.CompactTextString(m) }
 func (*Strings) ProtoMessage()    {}
 func (*Strings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_50b756b4bf97e28a, []int{30}
+	return fileDescriptor_view_b5330e9359925ac6, []int{35}
 }
 func (m *Strings) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Strings.Unmarshal(m, b)
@@ -1951,6 +2310,11 @@ func init() {
 	proto.RegisterType((*CommentList)(nil), "CommentList")
 	proto.RegisterType((*Like)(nil), "Like")
 	proto.RegisterType((*LikeList)(nil), "LikeList")
+	proto.RegisterType((*Edit)(nil), "Edit")
+	proto.RegisterType((*EditList)(nil), "EditList")
+	proto.RegisterType((*Reaction)(nil), "Reaction")
+	proto.RegisterType((*ReactionGroup)(nil), "ReactionGroup")
+	proto.RegisterType((*ReactionGroupList)(nil), "ReactionGroupList")
 	proto.RegisterType((*AccountUpdate)(nil), "AccountUpdate")
 	proto.RegisterType((*Summary)(nil), "Summary")
 	proto.RegisterType((*LogLevel)(nil), "LogLevel")
@@ -1962,102 +2326,116 @@ func init() {
 	proto.RegisterEnum("LogLevel_Level", LogLevel_Level_name, LogLevel_Level_value)
 }
 
-func init() { proto.RegisterFile("view.proto", fileDescriptor_view_50b756b4bf97e28a) }
-
-var fileDescriptor_view_50b756b4bf97e28a = []byte{
-	// 1496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x72, 0xdb, 0x44,
-	0x1b, 0x8e, 0x64, 0xc9, 0xb6, 0x5e, 0x27, 0xa9, 0xbe, 0x6d, 0xbe, 0x7e, 0x6a, 0xda, 0x69, 0x1c,
-	0xf5, 0x2b, 0x4d, 0x07, 0x50, 0x69, 0x3a, 0x30, 0x9d, 0x9e, 0x29, 0xb6, 0xd2, 0x9a, 0x3a, 0x76,
-	0x67, 0xed, 0x94, 0x81, 0x03, 0x32, 0x8a, 0xb5, 0x71, 0x44, 0x6c, 0xc9, 0x48, 0xeb, 0x34, 0xe6,
-	0x80, 0x19, 0x66, 0xe0, 0xa4, 0xc3, 0x09, 0x37, 0x00, 0xa7, 0xc0, 0x45, 0xf4, 0x02, 0xb8, 0x03,
-	0xee, 0x86, 0xd9, 0x1f, 0xf9, 0x27, 0x76, 0x69, 0xcb, 0x4c, 0x80, 0x13, 0x8f, 0xde, 0x1f, 0xef,
-	0x3e, 0xef, 0xff, 0xbb, 0x00, 0xa7, 0x21, 0x79, 0xee, 0x0c, 0x92, 0x98, 0xc6, 0xeb, 0x57, 0xbb,
-	0x71, 0xdc, 0xed, 0x91, 0xbb, 0x9c, 0x3a, 0x1c, 0x1e, 0xdd, 0xf5, 0xa3, 0x91, 0x14, 0x6d, 0x9c,
-	0x17, 0xd1, 0xb0, 0x4f, 0x52, 0xea, 0xf7, 0x07, 0x52, 0xa1, 0xd4, 0x8f, 0x03, 0xd2, 0x13, 0x84,
-	0xfd, 0x22, 0x07, 0x97, 0xdc, 0x20, 0x68, 0x1f, 0x27, 0xc4, 0x0f, 0x2a, 0x71, 0x74, 0x14, 0x76,
-	0x91, 0x09, 0xb9, 0x13, 0x32, 0xb2, 0x94, 0xb2, 0xb2, 0x65, 0x60, 0xf6, 0x89, 0x10, 0x68, 0x91,
-	0xdf, 0x27, 0x96, 0xca, 0x59, 0xfc, 0x1b, 0xdd, 0x85, 0x7c, 0xda, 0x39, 0x26, 0x7d, 0xdf, 0xca,
-	0x95, 0x95, 0xad, 0xd2, 0xf6, 0xff, 0x9c, 0x73, 0xe7, 0x38, 0x2d, 0x2e, 0xc6, 0x52, 0x0d, 0x95,
-	0x41, 0xa3, 0xa3, 0x01, 0xb1, 0xb4, 0xb2, 0xb2, 0xb5, 0xba, 0xbd, 0xec, 0x08, 0x5d, 0xa7, 0x3d,
-	0x1a, 0x10, 0xcc, 0x25, 0xe8, 0x0e, 0x14, 0xd2, 0x63, 0x3f, 0x09, 0xa3, 0xae, 0xa5, 0x73, 0xa5,
-	0x4b, 0x99, 0x52, 0x4b, 0xb0, 0x71, 0x26, 0x47, 0xd7, 0xc1, 0x78, 0x7e, 0x1c, 0x52, 0xd2, 0x0b,
-	0x53, 0x6a, 0xe5, 0xcb, 0xb9, 0x2d, 0x03, 0x4f, 0x18, 0x68, 0x0d, 0xf4, 0xa3, 0x38, 0xe9, 0x10,
-	0xab, 0x50, 0x56, 0xb6, 0x8a, 0x58, 0x10, 0xeb, 0x3f, 0x2a, 0x90, 0x17, 0x98, 0xd0, 0x2a, 0xa8,
-	0x61, 0x20, 0x2d, 0x54, 0xc3, 0x80, 0x19, 0xf8, 0x45, 0x1a, 0x47, 0x99, 0x81, 0xec, 0x1b, 0x7d,
-	0x04, 0xf9, 0x41, 0x42, 0x52, 0x42, 0xb9, 0x81, 0xab, 0xdb, 0x37, 0x5e, 0x61, 0xa0, 0xf3, 0x94,
-	0x6b, 0x61, 0xa9, 0x6d, 0x3f, 0x80, 0xbc, 0xe0, 0xa0, 0x22, 0x68, 0x8d, 0x66, 0xc3, 0x33, 0x97,
-	0xd8, 0xd7, 0x4e, 0xbd, 0xb9, 0x63, 0x2a, 0xe8, 0x12, 0x94, 0x2a, 0xee, 0x9e, 0x87, 0xdd, 0x03,
-	0xdc, 0xac, 0xd7, 0x4d, 0x15, 0x19, 0xa0, 0xef, 0x79, 0xd5, 0x9a, 0x6b, 0xe6, 0xec, 0xc7, 0x50,
-	0xdc, 0xe9, 0xc5, 0x9d, 0x93, 0x67, 0xe1, 0x57, 0x0c, 0x51, 0x10, 0xd3, 0x54, 0x62, 0xe4, 0xdf,
-	0xcc, 0xac, 0x4e, 0x3c, 0x8c, 0x28, 0x87, 0xa9, 0x63, 0x41, 0xf0, 0xe0, 0x90, 0x33, 0x81, 0x92,
-	0x05, 0x87, 0x9c, 0x51, 0xfb, 0x43, 0xd0, 0x5a, 0x94, 0x0c, 0xc6, 0x81, 0x53, 0xa6, 0x02, 0x77,
-	0x15, 0xb4, 0x5e, 0x18, 0x9d, 0xf0, 0x43, 0x4a, 0xdb, 0xba, 0x53, 0x0f, 0xa3, 0x13, 0xcc, 0x59,
-	0xf6, 0xd7, 0x60, 0x54, 0xc3, 0x84, 0x74, 0x68, 0x9c, 0x8c, 0xd0, 0xbb, 0xa0, 0x1f, 0x85, 0x3d,
-	0xc2, 0x20, 0xe4, 0xb6, 0x4a, 0xdb, 0xff, 0x75, 0xc6, 0x22, 0x67, 0x97, 0xf1, 0xbd, 0x88, 0x26,
-	0x23, 0x2c, 0x74, 0xd6, 0xab, 0x00, 0x13, 0xe6, 0x82, 0x0c, 0x2a, 0x83, 0x7e, 0xea, 0xf7, 0x86,
-	0x44, 0xde, 0x0a, 0xfc, 0x88, 0x5a, 0x14, 0x90, 0x33, 0x2c, 0x04, 0x0f, 0xd5, 0x07, 0x8a, 0x7d,
-	0x0f, 0x56, 0xc6, 0x97, 0xd4, 0x59, 0x20, 0xcb, 0xa0, 0x87, 0x94, 0xf4, 0x33, 0x0c, 0x30, 0xc1,
-	0x80, 0x85, 0xc0, 0x3e, 0x06, 0xed, 0x09, 0x19, 0xa5, 0xe8, 0x9d, 0x59, 0xb4, 0xa6, 0xc3, 0xb8,
-	0x0b, 0x80, 0x3e, 0x78, 0x0d, 0xd0, 0xb5, 0x69, 0xa0, 0xc6, 0x34, 0xb8, 0x6f, 0x14, 0x80, 0x5a,
-	0x74, 0x1a, 0x52, 0xf2, 0x2c, 0x24, 0xcf, 0x17, 0xa5, 0xd0, 0x5c, 0x8d, 0x6c, 0x40, 0x21, 0xe4,
-	0xff, 0x48, 0x64, 0x91, 0xe8, 0xce, 0x7e, 0x4a, 0x12, 0x9c, 0x71, 0x91, 0x03, 0x5a, 0xe0, 0x53,
-	0x51, 0x13, 0xa5, 0xed, 0x75, 0x47, 0xd4, 0xae, 0x93, 0xd5, 0xae, 0xd3, 0xce, 0x6a, 0x17, 0x73,
-	0x3d, 0xfb, 0x3e, 0xac, 0x4e, 0x20, 0x70, 0x0f, 0x6d, 0xce, 0x7a, 0xa8, 0xe4, 0x4c, 0xe4, 0x99,
-	0x8b, 0xea, 0xb0, 0xea, 0x9d, 0x51, 0x92, 0x44, 0x7e, 0x4f, 0x08, 0xe7, 0xb0, 0x4b, 0x37, 0xa8,
-	0x13, 0x37, 0x58, 0xb3, 0xc8, 0x8d, 0x31, 0x64, 0xfb, 0x17, 0x05, 0x4a, 0xbb, 0x84, 0x04, 0x98,
-	0x7c, 0x39, 0x24, 0x29, 0x45, 0x57, 0x20, 0x4f, 0x79, 0x51, 0xc8, 0xf3, 0x24, 0xc5, 0xf8, 0xf1,
-	0xd1, 0x11, 0x2b, 0x1f, 0x71, 0xac, 0xa4, 0x98, 0x83, 0x7b, 0x61, 0x3f, 0x14, 0xf9, 0xaa, 0x63,
-	0x41, 0xa0, 0x5b, 0xa0, 0xb1, 0xb6, 0x24, 0x9b, 0xc3, 0x7f, 0x9c, 0xa9, 0x1b, 0x9c, 0xbd, 0x38,
-	0x20, 0x98, 0x8b, 0xed, 0xf7, 0x41, 0x63, 0x14, 0x02, 0xc8, 0x57, 0x1e, 0xe3, 0x66, 0xa3, 0x69,
-	0x2e, 0xa1, 0x15, 0x30, 0xdc, 0x46, 0xa3, 0xd9, 0x76, 0xdb, 0x5e, 0xd5, 0x54, 0x98, 0xa8, 0xd5,
-	0x76, 0x2b, 0x4f, 0x5a, 0xa6, 0x6a, 0x1f, 0x43, 0x91, 0x1d, 0x54, 0xa3, 0xa4, 0xcf, 0xee, 0x3d,
-	0x64, 0xc5, 0x25, 0x61, 0x0a, 0x62, 0x0a, 0xbd, 0x3a, 0x83, 0xde, 0x81, 0xc2, 0xc0, 0x1f, 0xf5,
-	0x62, 0x3f, 0x90, 0x91, 0x5b, 0x9b, 0x8b, 0x8d, 0x1b, 0x8d, 0x70, 0xa6, 0x64, 0x7f, 0x0a, 0xcb,
-	0xd9, 0x4d, 0x3c, 0x2c, 0x1b, 0xb3, 0x61, 0x31, 0x9c, 0x4c, 0x2a, 0x83, 0xf2, 0x16, 0xb5, 0xfc,
-	0x83, 0x02, 0xfa, 0x1e, 0x49, 0xba, 0xe4, 0x15, 0x26, 0x64, 0x39, 0xa4, 0xbe, 0x59, 0x0e, 0xb1,
-	0xfa, 0x1f, 0xa6, 0xe7, 0x33, 0x92, 0xb3, 0xd0, 0x4d, 0x28, 0x50, 0x3f, 0xe9, 0x12, 0x9a, 0x5a,
-	0xda, 0x79, 0xdc, 0x99, 0xe4, 0xa1, 0x6a, 0x29, 0xf6, 0xf7, 0x0a, 0xe4, 0x6b, 0xdd, 0x28, 0x4e,
-	0xfe, 0x06, 0x50, 0x9b, 0x90, 0x17, 0x57, 0xcb, 0x2a, 0x99, 0xc2, 0x24, 0x05, 0xf6, 0x0b, 0x05,
-	0xb4, 0xdd, 0x9e, 0xdf, 0xfd, 0x57, 0x80, 0xf9, 0x56, 0x01, 0xed, 0xe3, 0x38, 0x8c, 0x2e, 0x1e,
-	0xcc, 0x35, 0x56, 0x4a, 0x27, 0x24, 0x0b, 0x16, 0x6b, 0xe5, 0x27, 0x04, 0x0b, 0x9e, 0x7d, 0x02,
-	0x45, 0x37, 0x8a, 0xe2, 0x61, 0xd4, 0xb9, 0xf8, 0x18, 0xd9, 0xdf, 0x29, 0xa0, 0xd7, 0x89, 0x7f,
-	0x4a, 0xfe, 0x61, 0xa3, 0x5f, 0x2a, 0xa0, 0xb5, 0xc9, 0x19, 0xbd, 0x78, 0x18, 0x08, 0xb4, 0xc3,
-	0x38, 0x18, 0xf1, 0x34, 0x30, 0x30, 0xff, 0x46, 0xff, 0x87, 0x62, 0x27, 0xee, 0xf7, 0x49, 0x44,
-	0x53, 0x4b, 0xe7, 0xe8, 0x8a, 0x4e, 0x45, 0x30, 0xf0, 0x58, 0x32, 0x31, 0x20, 0xbf, 0xc0, 0x80,
-	0xdb, 0x50, 0x64, 0xf8, 0x79, 0x0f, 0xb9, 0x36, 0xdb, 0x43, 0x74, 0x87, 0x49, 0xb2, 0xa6, 0xfe,
-	0x2b, 0x4b, 0xf9, 0xb0, 0xc7, 0x1d, 0x1e, 0xb2, 0x39, 0xca, 0x2d, 0xd5, 0xb1, 0x20, 0xd0, 0x0d,
-	0xd0, 0xd8, 0xbc, 0x5b, 0x30, 0x6e, 0x39, 0x9f, 0x8d, 0x4b, 0x36, 0xf1, 0x53, 0x2b, 0x27, 0xc7,
-	0x25, 0x53, 0xe0, 0xab, 0x40, 0x36, 0x2e, 0xb9, 0x98, 0xcd, 0xf5, 0x09, 0xf3, 0x2f, 0xcf, 0xf5,
-	0x9f, 0x55, 0xd0, 0xf9, 0xd4, 0xfd, 0x93, 0x2e, 0x2c, 0xaa, 0x2a, 0xeb, 0xc2, 0x9c, 0xe2, 0x4b,
-	0x90, 0x4f, 0x7d, 0x0b, 0xe4, 0x12, 0xe4, 0x53, 0x7f, 0x1c, 0xc3, 0xdc, 0x5b, 0xc6, 0x50, 0x9b,
-	0x8f, 0xa1, 0x05, 0x85, 0x8e, 0x3f, 0xa0, 0x61, 0x1c, 0xf1, 0x7d, 0xd3, 0xc0, 0x19, 0xc9, 0x5c,
-	0x2f, 0xb6, 0x89, 0x2c, 0x46, 0x0c, 0xbd, 0x5c, 0x21, 0x66, 0xc2, 0x5c, 0x78, 0x7d, 0x98, 0x8b,
-	0xf3, 0x61, 0x66, 0x37, 0x8b, 0x41, 0x93, 0x5a, 0x06, 0x5f, 0x5e, 0x33, 0xd2, 0xbe, 0x03, 0x06,
-	0xf7, 0x14, 0xcf, 0x80, 0xeb, 0xb3, 0x19, 0x90, 0x17, 0xfb, 0x4c, 0x96, 0x02, 0x3f, 0x29, 0x50,
-	0x90, 0xf7, 0xce, 0x4d, 0xf4, 0x0b, 0xce, 0xf4, 0x49, 0x1b, 0xd4, 0x5f, 0xd1, 0x06, 0xf9, 0x98,
-	0xb8, 0x07, 0x25, 0x09, 0x90, 0x9b, 0x73, 0x63, 0xd6, 0x9c, 0x89, 0xd7, 0x04, 0x9b, 0xff, 0x85,
-	0x75, 0x4f, 0xe6, 0xa9, 0x8b, 0xb4, 0xe8, 0x0d, 0x9a, 0xf8, 0x6d, 0x28, 0x32, 0x14, 0x8b, 0xeb,
-	0x50, 0x44, 0x52, 0x04, 0xe1, 0xa5, 0x02, 0x2b, 0x6e, 0x87, 0x4f, 0xef, 0xfd, 0x01, 0xbf, 0xf8,
-	0x3c, 0xf0, 0xb5, 0xa9, 0xe5, 0x6a, 0x47, 0xb5, 0x14, 0x51, 0x38, 0xb7, 0xe5, 0x6b, 0x48, 0xbc,
-	0x2d, 0x2e, 0x3b, 0x33, 0x67, 0x4c, 0x3d, 0x8a, 0xec, 0xcf, 0x41, 0x63, 0x14, 0x32, 0x61, 0xb9,
-	0xfd, 0x18, 0x7b, 0x6e, 0xf5, 0xc0, 0xad, 0x56, 0xbd, 0xaa, 0xb9, 0x84, 0x10, 0xac, 0x4a, 0x0e,
-	0xf6, 0xf6, 0x9a, 0xcf, 0xf8, 0xf6, 0x73, 0x05, 0x90, 0x5b, 0xa9, 0x34, 0xf7, 0x1b, 0xed, 0x83,
-	0xa7, 0x9e, 0x87, 0xa5, 0xae, 0x8a, 0x2c, 0x58, 0x9b, 0xe1, 0x67, 0xff, 0xc8, 0xd9, 0xbf, 0x29,
-	0x50, 0x68, 0x0d, 0xfb, 0x7d, 0x3f, 0x19, 0xcd, 0x41, 0xb7, 0xa0, 0xe0, 0x07, 0x41, 0x42, 0xd2,
-	0x54, 0x16, 0x66, 0x46, 0xa2, 0xf7, 0x00, 0xf9, 0x02, 0xf1, 0xc1, 0x80, 0x90, 0xe4, 0x40, 0xec,
-	0x32, 0x62, 0xa5, 0x33, 0xa5, 0xe4, 0x29, 0x21, 0x49, 0x85, 0xaf, 0x35, 0x9b, 0xb0, 0x2c, 0xf2,
-	0x5b, 0xea, 0x69, 0x5c, 0xaf, 0x44, 0xe5, 0x63, 0x8a, 0xa9, 0x6c, 0x40, 0x89, 0x57, 0x97, 0xd4,
-	0xd0, 0xb9, 0x06, 0x70, 0x96, 0x50, 0xb8, 0x09, 0x2b, 0x9d, 0x38, 0xa2, 0x7e, 0x87, 0x4a, 0x95,
-	0x3c, 0x57, 0x59, 0x96, 0x4c, 0xae, 0x64, 0xff, 0xae, 0x40, 0xb1, 0x1e, 0x77, 0xeb, 0xe4, 0x94,
-	0xf4, 0xd0, 0x07, 0x50, 0x48, 0x47, 0xe9, 0x54, 0xe4, 0xae, 0x38, 0x99, 0xcc, 0x69, 0x09, 0x81,
-	0xe8, 0x75, 0x99, 0xda, 0xfa, 0x13, 0x58, 0x9e, 0x16, 0x2c, 0xe8, 0x77, 0xb7, 0xa6, 0xfb, 0x1d,
-	0x7b, 0xa0, 0x8e, 0x4f, 0xe4, 0xbf, 0xd3, 0x4d, 0xaf, 0xc1, 0x46, 0x22, 0xc3, 0xb1, 0x0c, 0xc5,
-	0x0a, 0xae, 0xb5, 0x6b, 0x15, 0xb7, 0x6e, 0x2e, 0xb1, 0xf7, 0x9e, 0x87, 0x71, 0x13, 0x9b, 0x0a,
-	0x2a, 0x41, 0xe1, 0x13, 0x17, 0x37, 0x6a, 0x8d, 0x47, 0xa6, 0xca, 0xf6, 0xd6, 0x46, 0xb3, 0x5d,
-	0xab, 0x78, 0x66, 0x8e, 0x3d, 0x17, 0x6b, 0x8d, 0xdd, 0xa6, 0xa9, 0x31, 0xed, 0xaa, 0xb7, 0xb3,
-	0xff, 0xc8, 0xd4, 0xed, 0x4d, 0x28, 0xb4, 0x28, 0x7b, 0xfc, 0xa6, 0xac, 0x5f, 0xf2, 0x7b, 0x84,
-	0x61, 0x06, 0x96, 0xd4, 0xce, 0x65, 0x58, 0x09, 0x63, 0x87, 0x92, 0x33, 0xca, 0xba, 0xf9, 0xe0,
-	0xf0, 0x33, 0x75, 0x70, 0x78, 0x98, 0xe7, 0x25, 0x72, 0xff, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xae, 0xbe, 0xa8, 0x37, 0x40, 0x10, 0x00, 0x00,
+func init() { proto.RegisterFile("view.proto", fileDescriptor_view_b5330e9359925ac6) }
+
+var fileDescriptor_view_b5330e9359925ac6 = []byte{
+	// 1719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xdb, 0xca,
+	0x15, 0x36, 0x25, 0x52, 0x22, 0x8f, 0x64, 0x87, 0x99, 0xb8, 0x29, 0xe3, 0x04, 0xb1, 0xcc, 0x24,
+	0x8d, 0x83, 0xa6, 0x4c, 0xe3, 0xa0, 0x45, 0x9a, 0x1d, 0x2d, 0xd1, 0x89, 0x1a, 0x59, 0x0a, 0x46,
+	0xb2, 0xfb, 0x40, 0x51, 0x83, 0x16, 0xc7, 0x32, 0x63, 0x89, 0x54, 0xc9, 0x91, 0x63, 0x75, 0x51,
+	0xa0, 0x40, 0xbb, 0x29, 0xba, 0x29, 0xd0, 0x75, 0xbb, 0x2f, 0x8a, 0xfe, 0x84, 0x2e, 0xba, 0xec,
+	0xa6, 0xeb, 0xfe, 0x84, 0xfb, 0x2f, 0x2e, 0xe6, 0x41, 0x3d, 0x6c, 0xf9, 0x3a, 0xb9, 0x80, 0xef,
+	0xcd, 0x46, 0x98, 0xf3, 0xd0, 0xcc, 0x77, 0xe6, 0x7c, 0x67, 0xce, 0x70, 0x00, 0x4e, 0x43, 0xf2,
+	0xc1, 0x19, 0x26, 0x31, 0x8d, 0xd7, 0xee, 0xf4, 0xe2, 0xb8, 0xd7, 0x27, 0xcf, 0xb8, 0x74, 0x38,
+	0x3a, 0x7a, 0xe6, 0x47, 0x63, 0x69, 0x5a, 0x3f, 0x6f, 0xa2, 0xe1, 0x80, 0xa4, 0xd4, 0x1f, 0x0c,
+	0xa5, 0x43, 0x69, 0x10, 0x07, 0xa4, 0x2f, 0x04, 0xfb, 0x5f, 0x79, 0xb8, 0xe1, 0x06, 0x41, 0xe7,
+	0x38, 0x21, 0x7e, 0x50, 0x8d, 0xa3, 0xa3, 0xb0, 0x87, 0x4c, 0xc8, 0x9f, 0x90, 0xb1, 0xa5, 0x54,
+	0x94, 0x4d, 0x03, 0xb3, 0x21, 0x42, 0xa0, 0x46, 0xfe, 0x80, 0x58, 0x39, 0xae, 0xe2, 0x63, 0xf4,
+	0x0c, 0x0a, 0x69, 0xf7, 0x98, 0x0c, 0x7c, 0x2b, 0x5f, 0x51, 0x36, 0x4b, 0x5b, 0xdf, 0x75, 0xce,
+	0xcd, 0xe3, 0xb4, 0xb9, 0x19, 0x4b, 0x37, 0x54, 0x01, 0x95, 0x8e, 0x87, 0xc4, 0x52, 0x2b, 0xca,
+	0xe6, 0xca, 0x56, 0xd9, 0x11, 0xbe, 0x4e, 0x67, 0x3c, 0x24, 0x98, 0x5b, 0xd0, 0x13, 0x28, 0xa6,
+	0xc7, 0x7e, 0x12, 0x46, 0x3d, 0x4b, 0xe3, 0x4e, 0x37, 0x32, 0xa7, 0xb6, 0x50, 0xe3, 0xcc, 0x8e,
+	0xee, 0x81, 0xf1, 0xe1, 0x38, 0xa4, 0xa4, 0x1f, 0xa6, 0xd4, 0x2a, 0x54, 0xf2, 0x9b, 0x06, 0x9e,
+	0x2a, 0xd0, 0x2a, 0x68, 0x47, 0x71, 0xd2, 0x25, 0x56, 0xb1, 0xa2, 0x6c, 0xea, 0x58, 0x08, 0x6b,
+	0xff, 0x51, 0xa0, 0x20, 0x30, 0xa1, 0x15, 0xc8, 0x85, 0x81, 0x8c, 0x30, 0x17, 0x06, 0x2c, 0xc0,
+	0xf7, 0x69, 0x1c, 0x65, 0x01, 0xb2, 0x31, 0xfa, 0x31, 0x14, 0x86, 0x09, 0x49, 0x09, 0xe5, 0x01,
+	0xae, 0x6c, 0xdd, 0xbf, 0x24, 0x40, 0xe7, 0x1d, 0xf7, 0xc2, 0xd2, 0xdb, 0xfe, 0x15, 0x14, 0x84,
+	0x06, 0xe9, 0xa0, 0x36, 0x5b, 0x4d, 0xcf, 0x5c, 0x62, 0xa3, 0xed, 0x46, 0x6b, 0xdb, 0x54, 0xd0,
+	0x0d, 0x28, 0x55, 0xdd, 0x5d, 0x0f, 0xbb, 0x07, 0xb8, 0xd5, 0x68, 0x98, 0x39, 0x64, 0x80, 0xb6,
+	0xeb, 0xd5, 0xea, 0xae, 0x99, 0x67, 0xc3, 0xfd, 0x7a, 0xcd, 0x6b, 0x99, 0x2a, 0x1b, 0xba, 0x7b,
+	0xb5, 0x7a, 0xcb, 0xd4, 0x50, 0x19, 0xf4, 0x5a, 0xab, 0xba, 0xb7, 0xeb, 0x35, 0x3b, 0x66, 0xc1,
+	0x7e, 0x03, 0xfa, 0x76, 0x3f, 0xee, 0x9e, 0xec, 0x87, 0xbf, 0x65, 0xa8, 0x83, 0x98, 0xa6, 0x32,
+	0x0e, 0x3e, 0x66, 0xa1, 0x77, 0xe3, 0x51, 0x44, 0x79, 0x28, 0x1a, 0x16, 0x02, 0x4f, 0x20, 0x39,
+	0x13, 0x91, 0xb0, 0x04, 0x92, 0x33, 0x6a, 0xff, 0x08, 0xd4, 0x36, 0x25, 0xc3, 0x49, 0x72, 0x95,
+	0x99, 0xe4, 0xde, 0x01, 0xb5, 0x1f, 0x46, 0x27, 0x7c, 0x92, 0xd2, 0x96, 0xe6, 0x34, 0xc2, 0xe8,
+	0x04, 0x73, 0x95, 0xfd, 0x3b, 0x30, 0x6a, 0x61, 0x42, 0xba, 0x34, 0x4e, 0xc6, 0xe8, 0xfb, 0xa0,
+	0x1d, 0x85, 0x7d, 0xc2, 0x20, 0xe4, 0x37, 0x4b, 0x5b, 0xdf, 0x71, 0x26, 0x26, 0x67, 0x87, 0xe9,
+	0xbd, 0x88, 0x26, 0x63, 0x2c, 0x7c, 0xd6, 0x6a, 0x00, 0x53, 0xe5, 0x02, 0x96, 0x55, 0x40, 0x3b,
+	0xf5, 0xfb, 0x23, 0x22, 0x57, 0x05, 0x3e, 0x45, 0x3d, 0x0a, 0xc8, 0x19, 0x16, 0x86, 0x57, 0xb9,
+	0x97, 0x8a, 0xfd, 0x1c, 0x96, 0x27, 0x8b, 0x34, 0x58, 0xb2, 0x2b, 0xa0, 0x85, 0x94, 0x0c, 0x32,
+	0x0c, 0x30, 0xc5, 0x80, 0x85, 0xc1, 0x3e, 0x06, 0xf5, 0x2d, 0x19, 0xa7, 0xe8, 0x7b, 0xf3, 0x68,
+	0x4d, 0x87, 0x69, 0x17, 0x00, 0x7d, 0x79, 0x05, 0xd0, 0xd5, 0x59, 0xa0, 0xc6, 0x2c, 0xb8, 0xdf,
+	0x2b, 0x00, 0xf5, 0xe8, 0x34, 0xa4, 0x64, 0x3f, 0x24, 0x1f, 0x16, 0xd1, 0xec, 0x42, 0x1d, 0xad,
+	0x43, 0x31, 0xe4, 0xff, 0x48, 0x64, 0x21, 0x69, 0xce, 0x5e, 0x4a, 0x12, 0x9c, 0x69, 0x91, 0x03,
+	0x6a, 0xe0, 0x53, 0x51, 0x37, 0xa5, 0xad, 0x35, 0x47, 0xd4, 0xb7, 0x93, 0xd5, 0xb7, 0xd3, 0xc9,
+	0xea, 0x1b, 0x73, 0x3f, 0xfb, 0x05, 0xac, 0x4c, 0x21, 0xf0, 0x1d, 0xda, 0x98, 0xdf, 0xa1, 0x92,
+	0x33, 0xb5, 0x67, 0x5b, 0xd4, 0x80, 0x15, 0xef, 0x8c, 0x92, 0x24, 0xf2, 0xfb, 0xc2, 0x78, 0x01,
+	0xbb, 0xdc, 0x86, 0xdc, 0x74, 0x1b, 0xac, 0x79, 0xe4, 0xc6, 0x04, 0xb2, 0xfd, 0x3f, 0x05, 0x4a,
+	0x3b, 0x84, 0x04, 0x98, 0xfc, 0x66, 0x44, 0x52, 0x8a, 0x6e, 0x43, 0x81, 0xf2, 0xc2, 0x91, 0xf3,
+	0x49, 0x89, 0xe9, 0xe3, 0xa3, 0x23, 0x56, 0x62, 0x62, 0x5a, 0x29, 0xb1, 0x0d, 0xee, 0x87, 0x83,
+	0x50, 0xf0, 0x55, 0xc3, 0x42, 0x40, 0x8f, 0x40, 0x65, 0x47, 0x97, 0x3c, 0x40, 0x6e, 0x3a, 0x33,
+	0x2b, 0x38, 0xbb, 0x71, 0x40, 0x30, 0x37, 0xa3, 0x87, 0xa0, 0xa6, 0x71, 0x42, 0xe5, 0x11, 0x62,
+	0x3a, 0xbc, 0x5c, 0xc4, 0x6f, 0x3b, 0x4e, 0x28, 0xe6, 0x56, 0xfb, 0x07, 0xa0, 0xb2, 0xff, 0x20,
+	0x80, 0x42, 0xf5, 0x0d, 0x6e, 0x35, 0x5b, 0xe6, 0x12, 0x5a, 0x06, 0xc3, 0x6d, 0x36, 0x5b, 0x1d,
+	0xb7, 0xe3, 0xd5, 0x4c, 0x85, 0x99, 0xda, 0x1d, 0xb7, 0xfa, 0xb6, 0x6d, 0xe6, 0xec, 0x63, 0xd0,
+	0xd9, 0x72, 0x75, 0x4a, 0x06, 0x0c, 0xdd, 0x21, 0x9b, 0x4d, 0x06, 0x23, 0x84, 0x99, 0x18, 0x73,
+	0x73, 0x31, 0x3a, 0x50, 0x1c, 0xfa, 0xe3, 0x7e, 0xec, 0x07, 0x32, 0xbf, 0xab, 0x17, 0x32, 0xe8,
+	0x46, 0x63, 0x9c, 0x39, 0xd9, 0xbf, 0x80, 0x72, 0xb6, 0x12, 0x4f, 0xde, 0xfa, 0x7c, 0xf2, 0x0c,
+	0x27, 0xb3, 0xca, 0xd4, 0x7d, 0x42, 0xc5, 0xff, 0x45, 0x01, 0x6d, 0x97, 0x24, 0x3d, 0x72, 0x49,
+	0x08, 0x19, 0xd3, 0x72, 0x1f, 0xc7, 0x34, 0x76, 0x4a, 0x8c, 0xd2, 0xf3, 0xbc, 0xe5, 0x2a, 0xf4,
+	0x00, 0x8a, 0xd4, 0x4f, 0x7a, 0x84, 0xa6, 0x96, 0x7a, 0x1e, 0x77, 0x66, 0x79, 0x95, 0xb3, 0x14,
+	0xfb, 0xcf, 0x0a, 0x14, 0xea, 0xbd, 0x28, 0x4e, 0xbe, 0x01, 0x50, 0x1b, 0x50, 0x10, 0x4b, 0xcb,
+	0x5a, 0x9a, 0xc1, 0x24, 0x0d, 0xf6, 0x9f, 0x14, 0x50, 0x77, 0xfa, 0x7e, 0xef, 0xb3, 0x00, 0xf3,
+	0x07, 0x05, 0xd4, 0x9f, 0xc6, 0x61, 0x74, 0xfd, 0x60, 0xee, 0xb2, 0x82, 0x3b, 0x21, 0x59, 0xb2,
+	0xd8, 0x81, 0x7f, 0x42, 0xb0, 0xd0, 0xd9, 0x27, 0xa0, 0xbb, 0x51, 0x14, 0x8f, 0xa2, 0xee, 0xf5,
+	0xe7, 0xc8, 0xfe, 0xa3, 0x02, 0x5a, 0x83, 0xf8, 0xa7, 0xe4, 0x5b, 0x0e, 0xfa, 0x8b, 0x1c, 0xa8,
+	0x1d, 0x72, 0x46, 0xaf, 0x1f, 0x06, 0x02, 0xf5, 0x30, 0x0e, 0xc6, 0x9c, 0x06, 0x06, 0xe6, 0x63,
+	0xf4, 0x10, 0xf4, 0x6e, 0x3c, 0x18, 0x90, 0x88, 0xa6, 0x96, 0xc6, 0xd1, 0xe9, 0x4e, 0x55, 0x28,
+	0xf0, 0xc4, 0x32, 0x0d, 0xa0, 0x70, 0x31, 0x00, 0x66, 0x24, 0x41, 0x48, 0x53, 0xab, 0x28, 0x8d,
+	0x5e, 0x10, 0x52, 0x2c, 0x74, 0xe8, 0x29, 0x18, 0x09, 0xf1, 0xbb, 0x34, 0x8c, 0xa3, 0xd4, 0xd2,
+	0xb9, 0xc3, 0x8a, 0x83, 0xa5, 0xe6, 0x75, 0x12, 0x8f, 0x86, 0x78, 0xea, 0x30, 0x43, 0x55, 0xe3,
+	0x12, 0xaa, 0xb2, 0x2e, 0x96, 0x90, 0x61, 0x3f, 0x24, 0xa9, 0x05, 0x72, 0x3d, 0xb6, 0x7b, 0x38,
+	0xd3, 0xa2, 0x35, 0xd0, 0x19, 0x68, 0xbe, 0x60, 0x89, 0xdf, 0xd7, 0x26, 0xb2, 0xfd, 0x18, 0x74,
+	0xe6, 0xcc, 0x8f, 0xbb, 0xbb, 0xf3, 0xc7, 0x9d, 0x9c, 0x46, 0x76, 0xa9, 0x7f, 0xb0, 0xea, 0x0c,
+	0xfb, 0x9c, 0x1b, 0x21, 0xbb, 0x18, 0xf0, 0xa4, 0x68, 0x58, 0x08, 0xe8, 0x3e, 0xa8, 0xac, 0x81,
+	0x2f, 0xb8, 0x3f, 0x70, 0x3d, 0xeb, 0xff, 0xec, 0x0a, 0x93, 0x5a, 0x79, 0xd9, 0xff, 0x99, 0x03,
+	0xbf, 0xdb, 0x64, 0xfd, 0x9f, 0x9b, 0xd9, 0x45, 0x65, 0xaa, 0xfc, 0xda, 0x17, 0x95, 0xbf, 0xe6,
+	0x41, 0x63, 0x86, 0xf4, 0x2b, 0x1a, 0x86, 0xd8, 0xd5, 0xac, 0x61, 0x70, 0x89, 0xdf, 0xea, 0x7c,
+	0xea, 0x5b, 0x20, 0x6f, 0x75, 0x3e, 0xf5, 0x27, 0x74, 0xcb, 0x7f, 0x22, 0xdd, 0xd4, 0x8b, 0x74,
+	0xb3, 0xa0, 0xd8, 0xf5, 0x87, 0x6c, 0xe3, 0x79, 0x87, 0x34, 0x70, 0x26, 0xb2, 0xad, 0x17, 0xd7,
+	0xa3, 0x8c, 0x4e, 0x0c, 0xbd, 0xbc, 0x13, 0xcd, 0x31, 0xb2, 0x78, 0x35, 0x23, 0xf5, 0x05, 0x8c,
+	0xb4, 0xa0, 0x28, 0x7a, 0x62, 0x6a, 0x19, 0x9c, 0x01, 0x99, 0x38, 0xe5, 0x6a, 0xe9, 0x2a, 0xae,
+	0x96, 0xaf, 0xe2, 0xea, 0x0c, 0x11, 0x97, 0x17, 0x11, 0xd1, 0x7e, 0x02, 0x06, 0xcf, 0x0a, 0x67,
+	0xdb, 0xbd, 0x79, 0xb6, 0x15, 0xc4, 0x65, 0x30, 0xa3, 0xdb, 0xdf, 0x15, 0x28, 0xca, 0x18, 0x2f,
+	0x5c, 0x87, 0xae, 0xf9, 0x00, 0x98, 0x96, 0x9c, 0x76, 0x49, 0xc9, 0xf1, 0xee, 0xf9, 0x1c, 0x4a,
+	0x12, 0x20, 0x0f, 0xe7, 0xfe, 0x7c, 0x38, 0xd3, 0x0c, 0x09, 0x35, 0xff, 0x0b, 0x6b, 0x2a, 0x2c,
+	0x2b, 0xd7, 0x19, 0xd1, 0x47, 0xf4, 0xb6, 0xc7, 0xa0, 0x33, 0x14, 0x8b, 0x6b, 0x5e, 0xb0, 0x46,
+	0x24, 0xe1, 0x9f, 0x0a, 0xa8, 0x8c, 0x0e, 0x9f, 0x5f, 0x06, 0x26, 0x95, 0x5a, 0x98, 0x56, 0x2a,
+	0x8b, 0x8b, 0xa1, 0x5d, 0x1c, 0x97, 0xa0, 0xb5, 0x88, 0xeb, 0x6f, 0x0a, 0xe8, 0x19, 0x8b, 0xaf,
+	0x33, 0xb6, 0x55, 0xd0, 0xc8, 0x20, 0x7e, 0x1f, 0xca, 0xe0, 0x84, 0xf0, 0x11, 0xd1, 0xd9, 0x3f,
+	0x87, 0xe5, 0xb9, 0x2a, 0x9b, 0xce, 0xa4, 0xcc, 0xce, 0xb4, 0xf8, 0xfa, 0x79, 0x17, 0x34, 0xb6,
+	0x7a, 0x76, 0xd4, 0x4a, 0x44, 0x42, 0x67, 0xff, 0x04, 0x6e, 0xce, 0xcd, 0xcc, 0x37, 0xeb, 0xe1,
+	0xfc, 0x66, 0x9d, 0x2f, 0x71, 0xb9, 0x6b, 0xff, 0x56, 0x60, 0xd9, 0xed, 0xf2, 0x35, 0xf6, 0x86,
+	0x3c, 0xf4, 0xf3, 0x5b, 0xb7, 0x3a, 0xf3, 0x9d, 0xb2, 0x9d, 0xb3, 0x14, 0x71, 0x64, 0x3f, 0x96,
+	0x8f, 0x0f, 0xe2, 0x53, 0xfe, 0x96, 0x33, 0x37, 0xc7, 0xcc, 0x1b, 0x84, 0xfd, 0x6b, 0x50, 0x99,
+	0x84, 0x4c, 0x28, 0x77, 0xde, 0x60, 0xcf, 0xad, 0x1d, 0xb8, 0xb5, 0x9a, 0x57, 0x33, 0x97, 0x10,
+	0x82, 0x15, 0xa9, 0xc1, 0xde, 0x6e, 0x6b, 0x9f, 0x7f, 0x22, 0xdc, 0x06, 0xe4, 0x56, 0xab, 0xad,
+	0xbd, 0x66, 0xe7, 0xe0, 0x9d, 0xe7, 0x61, 0xe9, 0x9b, 0x43, 0x16, 0xac, 0xce, 0xe9, 0xb3, 0x7f,
+	0xe4, 0xed, 0xff, 0x2a, 0x50, 0x6c, 0x8f, 0x06, 0x03, 0x3f, 0x19, 0x5f, 0x80, 0x6e, 0x41, 0xd1,
+	0x0f, 0x82, 0x84, 0xa4, 0xa9, 0x6c, 0x09, 0x99, 0x88, 0x9e, 0x02, 0xf2, 0x05, 0xe2, 0x83, 0x21,
+	0x21, 0xc9, 0x01, 0x1f, 0xca, 0xaf, 0x23, 0x53, 0x5a, 0xde, 0x11, 0x92, 0x54, 0xd9, 0x00, 0x6d,
+	0x40, 0x59, 0x9c, 0xac, 0xd2, 0x4f, 0xe5, 0x7e, 0x25, 0x2a, 0xdf, 0x2e, 0x98, 0xcb, 0x3a, 0x94,
+	0xf8, 0xb9, 0x2e, 0x3d, 0x34, 0xee, 0x01, 0x5c, 0x25, 0x1c, 0x1e, 0xc0, 0x72, 0x37, 0x8e, 0xa8,
+	0xdf, 0xa5, 0xd2, 0xa5, 0xc0, 0x5d, 0xca, 0x52, 0xc9, 0x9d, 0xec, 0xff, 0x2b, 0xa0, 0x37, 0xe2,
+	0x5e, 0x83, 0x9c, 0x92, 0x3e, 0xfa, 0x21, 0x14, 0xd3, 0x71, 0x3a, 0x93, 0xc2, 0xdb, 0x4e, 0x66,
+	0x73, 0xda, 0xc2, 0x20, 0xba, 0x6c, 0xe6, 0xb6, 0xf6, 0x16, 0xca, 0xb3, 0x86, 0x05, 0x9d, 0xf6,
+	0xd1, 0x6c, 0xa7, 0x65, 0xef, 0x41, 0x93, 0x19, 0xf9, 0xef, 0x6c, 0xbb, 0x6d, 0x82, 0x26, 0x70,
+	0x94, 0x41, 0xaf, 0xe2, 0x7a, 0xa7, 0x5e, 0x75, 0x1b, 0xe6, 0x12, 0x7b, 0x48, 0xf1, 0x30, 0x6e,
+	0x61, 0x53, 0x41, 0x25, 0x28, 0xfe, 0xcc, 0xc5, 0xcd, 0x7a, 0xf3, 0xb5, 0x99, 0x63, 0x1f, 0x77,
+	0xcd, 0x56, 0xa7, 0x5e, 0xf5, 0xcc, 0x3c, 0x7b, 0x9d, 0xa9, 0x37, 0x77, 0xe4, 0xb3, 0x4b, 0xcd,
+	0xdb, 0xde, 0x7b, 0x6d, 0x6a, 0xf6, 0x06, 0x14, 0xdb, 0x94, 0xbd, 0x35, 0xa5, 0xac, 0x53, 0xf3,
+	0x75, 0x44, 0x60, 0x06, 0x96, 0xd2, 0xf6, 0x2d, 0x58, 0x0e, 0x63, 0x87, 0x92, 0x33, 0xca, 0xee,
+	0x11, 0xc3, 0xc3, 0x5f, 0xe6, 0x86, 0x87, 0x87, 0x05, 0x5e, 0xa4, 0x2f, 0xbe, 0x1c, 0x00, 0x9c,
+	0x9c, 0xb1, 0xff, 0xaf, 0x13, 0x00, 0x00,
 }
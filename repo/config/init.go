@@ -1,6 +1,8 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -20,6 +22,7 @@ type Config struct {
 	IsMobile  bool      // local node is setup for mobile
 	IsServer  bool      // local node is setup for a server w/ a public IP
 	Cafe      Cafe      // local node cafe settings
+	Mills     []Mill    // external mills
 }
 
 // Account store public account info
@@ -71,10 +74,49 @@ type CafeHost struct {
 	URL         string // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
 	NeighborURL string // Specifies the URL of a secondary cafe. Must return cafe info.
 	SizeLimit   int64  // Maximum file size limit to accept for POST requests in bytes.
+	Quota       CafeQuota
+	GC          CafeGC
+	Cluster     []string // Peer IDs of cafes that replicate client data with this one, which must list it as well
+	AdminToken  string   // Bearer token required by the API's cafe admin routes, which are disabled when empty
+}
+
+// CafeQuota settings, the default storage limits for each registered client.
+// Zero values are unlimited.
+type CafeQuota struct {
+	Bytes   int64 // Maximum bytes pinned
+	Objects int32 // Maximum objects pinned
+	Threads int32 // Maximum thread backups
+}
+
+// CafeGC settings, the retention policy for registered clients.
+// Content stored by clients unseen for ClientTTL days is deleted after GracePeriod
+// more days, unless they return. Zero ClientTTL disables collection.
+type CafeGC struct {
+	ClientTTL   int // Days a client can go unseen
+	GracePeriod int // Days between scheduling and deletion
+}
+
+// Mill settings for an external mill, which is either a local executable that
+// receives input on stdin, or an HTTP endpoint that receives input as a POST body
+type Mill struct {
+	ID        string   // mill id used in schema links, e.g., /acme/ocr
+	Media     []string // accepted input media types, all if empty
+	Output    string   // output media type, same as input if empty
+	Command   []string // executable and arguments
+	URL       string   // HTTP endpoint
+	Timeout   string   // max duration of each run, e.g., 30s (default: 1m)
+	Plaintext bool     // when true, output is not encrypted
+	Pin       bool     // when true, output is pinned
 }
 
 // Init returns the default textile config
 func Init() (*Config, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	adminToken := hex.EncodeToString(secret)
+
 	return &Config{
 		Account: Account{
 			Address: "",
@@ -153,8 +195,13 @@ func Init() (*Config, error) {
 				URL:         "",
 				NeighborURL: "",
 				SizeLimit:   0,
+				Quota:       CafeQuota{},
+				GC:          CafeGC{},
+				Cluster:     make([]string, 0),
+				AdminToken:  adminToken,
 			},
 		},
+		Mills:    make([]Mill, 0),
 		IsMobile: false,
 		IsServer: false,
 	}, nil
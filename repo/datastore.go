@@ -14,6 +14,11 @@ type Datastore interface {
 	Files() FileStore
 	Threads() ThreadStore
 	ThreadPeers() ThreadPeerStore
+	ThreadSchemas() ThreadSchemaStore
+	ThreadMembers() ThreadMemberStore
+	ThreadMemberChanges() ThreadMemberChangeStore
+	ThreadKeys() ThreadKeyStore
+	ThreadRetentions() ThreadRetentionStore
 	Blocks() BlockStore
 	BlockMessages() BlockMessageStore
 	Invites() InviteStore
@@ -26,6 +31,8 @@ type Datastore interface {
 	CafeTokens() CafeTokenStore
 	CafeClientThreads() CafeClientThreadStore
 	CafeClientMessages() CafeClientMessageStore
+	CafeClientObjects() CafeClientObjectStore
+	CafeReplications() CafeReplicationStore
 	Ping() error
 	Close()
 }
@@ -69,8 +76,11 @@ type FileStore interface {
 	Get(hash string) *pb.FileIndex
 	GetByPrimary(mill string, checksum string) *pb.FileIndex
 	GetBySource(mill string, source string, opts string) *pb.FileIndex
+	ListByMill(mill string) []pb.FileIndex
 	AddTarget(hash string, target string) error
 	RemoveTarget(hash string, target string) error
+	AddChunks(hash string, chunks []string) error
+	CountChunkFiles(chunk string) int
 	Count() int
 	Delete(hash string) error
 }
@@ -102,12 +112,49 @@ type ThreadPeerStore interface {
 	DeleteByThread(thread string) error
 }
 
+type ThreadSchemaStore interface {
+	Queryable
+	Put(schema *pb.ThreadSchema) error
+	List(threadId string) *pb.ThreadSchemaList
+	DeleteByThread(threadId string) error
+}
+
+type ThreadMemberStore interface {
+	Queryable
+	Put(member *pb.ThreadMember) error
+	Get(threadId string, address string) *pb.ThreadMember
+	ListByThread(threadId string) *pb.ThreadMemberList
+	DeleteByThread(threadId string) error
+}
+
+type ThreadMemberChangeStore interface {
+	Queryable
+	Add(change *pb.ThreadMemberChange) error
+	ListByAddress(threadId string, address string) *pb.ThreadMemberChangeList
+	DeleteByThread(threadId string) error
+}
+
+type ThreadKeyStore interface {
+	Queryable
+	Add(key *pb.ThreadKey) error
+	ListByThread(threadId string) *pb.ThreadKeyList
+	DeleteByThread(threadId string) error
+}
+
+type ThreadRetentionStore interface {
+	Queryable
+	Put(retention *pb.ThreadRetention) error
+	Get(threadId string) *pb.ThreadRetention
+	List() *pb.ThreadRetentionList
+	Delete(threadId string) error
+}
+
 type BlockStore interface {
 	Queryable
 	Add(block *pb.Block) error
 	Replace(block *pb.Block) error
 	Get(id string) *pb.Block
-	List(offset string, limit int, query string) *pb.BlockList
+	List(offset string, limit int, query string, sort pb.Block_BlockSort) *pb.BlockList
 	Count(query string) int
 	AddAttempt(id string) error
 	Delete(id string) error
@@ -149,6 +196,7 @@ type CafeSessionStore interface {
 	AddOrUpdate(session *pb.CafeSession) error
 	Get(cafeId string) *pb.CafeSession
 	List() *pb.CafeSessionList
+	UpdateUsage(cafeId string, usage *pb.CafeUsage) error
 	Delete(cafeId string) error
 }
 
@@ -196,7 +244,12 @@ type CafeClientStore interface {
 	Count() int
 	List() []pb.CafeClient
 	ListByAddress(address string) []pb.CafeClient
+	ListByToken(tokenId string) []pb.CafeClient
 	UpdateLastSeen(id string, date time.Time) error
+	UpdateQuota(id string, quota *pb.CafeQuota) error
+	UpdateExpires(id string, date time.Time) error
+	Usage(id string) *pb.CafeUsage
+	UsageByToken(tokenId string) *pb.CafeUsage
 	Delete(id string) error
 }
 
@@ -215,9 +268,28 @@ type CafeClientMessageStore interface {
 	DeleteByClient(clientId string, limit int) error
 }
 
+type CafeClientObjectStore interface {
+	AddOrUpdate(obj *pb.CafeClientObject) error
+	Get(id string, clientId string) *pb.CafeClientObject
+	ListByClient(clientId string) []pb.CafeClientObject
+	Count(id string) int
+	Delete(id string, clientId string) error
+	DeleteByClient(clientId string) error
+}
+
+type CafeReplicationStore interface {
+	Add(rep *pb.CafeReplication) error
+	ListByPeer(peerId string, limit int) []pb.CafeReplication
+	CountByPeer(peerId string) int
+	AddAttempt(id string) error
+	Delete(id string) error
+}
+
 type CafeTokenStore interface {
 	Add(token *pb.CafeToken) error
 	Get(id string) *pb.CafeToken
 	List() []pb.CafeToken
+	UpdateQuota(id string, quota *pb.CafeQuota) error
+	AddRegistration(id string) (bool, error)
 	Delete(id string) error
 }
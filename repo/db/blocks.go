@@ -29,8 +29,8 @@ func (c *BlockDB) Add(block *pb.Block) error {
 	}
 	stmt, err := tx.Prepare(`
         INSERT INTO blocks(
-    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts
-        ) VALUES (?,?,?,?,?,?,?,?,?,?,?)
+    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts, expires, clock
+        ) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)
     `)
 	if err != nil {
 		return err
@@ -49,6 +49,8 @@ func (c *BlockDB) Add(block *pb.Block) error {
 		block.Data,
 		int32(block.Status),
 		block.Attempts,
+		expiresNanos(block),
+		block.Clock,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -69,8 +71,8 @@ func (c *BlockDB) Replace(block *pb.Block) error {
 
 	stmt, err := tx.Prepare(`
         REPLACE INTO blocks(
-    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts
-        ) VALUES (?,?,?,?,?,?,?,?,?,?,coalesce((SELECT attempts FROM blocks WHERE id=?),?))
+    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts, expires, clock
+        ) VALUES (?,?,?,?,?,?,?,?,?,?,coalesce((SELECT attempts FROM blocks WHERE id=?),?),?,?)
     `)
 	if err != nil {
 		return err
@@ -90,6 +92,8 @@ func (c *BlockDB) Replace(block *pb.Block) error {
 		int32(block.Status),
 		block.Id,
 		block.Attempts,
+		expiresNanos(block),
+		block.Clock,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -110,7 +114,7 @@ func (c *BlockDB) Get(id string) *pb.Block {
 	return res.Items[0]
 }
 
-func (c *BlockDB) List(offset string, limit int, query string) *pb.BlockList {
+func (c *BlockDB) List(offset string, limit int, query string, sort pb.Block_BlockSort) *pb.BlockList {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -120,11 +124,17 @@ func (c *BlockDB) List(offset string, limit int, query string) *pb.BlockList {
 		if query != "" {
 			query += " and "
 		}
-		stm += " WHERE " + query + "(date<(SELECT date FROM blocks WHERE id='" + offset + "'))"
+		stm += " WHERE " + query + "(" + offsetClause(offset, sort) + ")"
 	} else if query != "" {
 		stm += " WHERE " + query
 	}
-	stm += " ORDER BY date DESC LIMIT " + limits + ";"
+	switch sort {
+	case pb.Block_CAUSAL:
+		stm += " ORDER BY clock DESC, date DESC, id DESC"
+	default:
+		stm += " ORDER BY date DESC"
+	}
+	stm += " LIMIT " + limits + ";"
 
 	return c.handleQuery(stm)
 }
@@ -182,15 +192,15 @@ func (c *BlockDB) handleQuery(stm string) *pb.BlockList {
 	for rows.Next() {
 		var id, threadId, authorId, parents, target, body, data string
 		var typeInt, statusInt, attempts int
-		var dateInt int64
+		var dateInt, expiresInt, clock int64
 
-		err = rows.Scan(&id, &threadId, &authorId, &typeInt, &dateInt, &parents, &target, &body, &data, &statusInt, &attempts)
+		err = rows.Scan(&id, &threadId, &authorId, &typeInt, &dateInt, &parents, &target, &body, &data, &statusInt, &attempts, &expiresInt, &clock)
 		if err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
 
-		list.Items = append(list.Items, &pb.Block{
+		block := &pb.Block{
 			Id:       id,
 			Thread:   threadId,
 			Author:   authorId,
@@ -202,8 +212,37 @@ func (c *BlockDB) handleQuery(stm string) *pb.BlockList {
 			Data:     data,
 			Status:   pb.Block_BlockStatus(statusInt),
 			Attempts: int32(attempts),
-		})
+			Clock:    clock,
+		}
+		if expiresInt > 0 {
+			block.Expires = util.ProtoTs(expiresInt)
+		}
+		list.Items = append(list.Items, block)
 	}
 
 	return list
 }
+
+// expiresNanos returns the expiration of a block, or zero if it doesn't expire
+func expiresNanos(block *pb.Block) int64 {
+	if block.Expires == nil {
+		return 0
+	}
+	return util.ProtoNanos(block.Expires)
+}
+
+// offsetClause selects the blocks after offset in the given sort order
+func offsetClause(offset string, sort pb.Block_BlockSort) string {
+	sel := func(col string) string {
+		return "(SELECT " + col + " FROM blocks WHERE id='" + offset + "')"
+	}
+
+	switch sort {
+	case pb.Block_CAUSAL:
+		return "clock<" + sel("clock") +
+			" or (clock=" + sel("clock") + " and date<" + sel("date") + ")" +
+			" or (clock=" + sel("clock") + " and date=" + sel("date") + " and id<'" + offset + "')"
+	default:
+		return "date<" + sel("date")
+	}
+}
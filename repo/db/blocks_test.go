@@ -96,6 +96,27 @@ func TestBlockDB_Get(t *testing.T) {
 	}
 }
 
+func TestBlockDB_Expires(t *testing.T) {
+	if blockStore.Get("abcde").Expires != nil {
+		t.Error("block should not expire")
+	}
+	expires, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	err := blockStore.Add(&pb.Block{
+		Id:      "fghij",
+		Thread:  "thread_id",
+		Date:    ptypes.TimestampNow(),
+		Expires: expires,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	block := blockStore.Get("fghij")
+	if block.Expires == nil || util.ProtoNanos(block.Expires) != util.ProtoNanos(expires) {
+		t.Error("wrong expiration")
+	}
+}
+
 func TestBlockDB_List(t *testing.T) {
 	setupBlockDB()
 	err := blockStore.Add(&pb.Block{
@@ -132,30 +153,63 @@ func TestBlockDB_List(t *testing.T) {
 		return
 	}
 
-	all := blockStore.List("", -1, "").Items
+	all := blockStore.List("", -1, "", pb.Block_DATE).Items
 	if len(all) != 2 {
 		t.Error("returned incorrect number of blocks")
 		return
 	}
 
-	limited := blockStore.List("", 1, "").Items
+	limited := blockStore.List("", 1, "", pb.Block_DATE).Items
 	if len(limited) != 1 {
 		t.Error("returned incorrect number of blocks")
 		return
 	}
 
-	offset := blockStore.List(limited[0].Id, -1, "").Items
+	offset := blockStore.List(limited[0].Id, -1, "", pb.Block_DATE).Items
 	if len(offset) != 1 {
 		t.Error("returned incorrect number of blocks")
 		return
 	}
 
-	filtered := blockStore.List("", -1, "threadId='thread_id'").Items
+	filtered := blockStore.List("", -1, "threadId='thread_id'", pb.Block_DATE).Items
 	if len(filtered) != 2 {
 		t.Error("returned incorrect number of blocks")
 	}
 }
 
+func TestBlockDB_ListCausal(t *testing.T) {
+	setupBlockDB()
+	date := ptypes.TimestampNow()
+	later := util.ProtoTs(time.Now().Add(time.Minute).UnixNano())
+	for _, block := range []*pb.Block{
+		{Id: "a", Thread: "thread_id", Date: later, Clock: 1},
+		{Id: "b", Thread: "thread_id", Date: date, Clock: 2},
+		{Id: "c", Thread: "thread_id", Date: date, Clock: 2},
+		{Id: "d", Thread: "thread_id", Date: later, Clock: 2},
+	} {
+		err := blockStore.Add(block)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	all := blockStore.List("", -1, "", pb.Block_CAUSAL).Items
+	var order string
+	for _, block := range all {
+		order += block.Id
+	}
+	if order != "dcba" {
+		t.Errorf("expected causal order dcba, got %s", order)
+		return
+	}
+
+	offset := blockStore.List("c", -1, "", pb.Block_CAUSAL).Items
+	if len(offset) != 2 || offset[0].Id != "b" || offset[1].Id != "a" {
+		t.Error("returned incorrect blocks after offset")
+	}
+}
+
 func TestBlockDB_Count(t *testing.T) {
 	setupBlockDB()
 	err := blockStore.Add(&pb.Block{
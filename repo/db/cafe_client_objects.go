@@ -0,0 +1,109 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeClientObjectDB struct {
+	modelStore
+}
+
+func NewCafeClientObjectStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientObjectStore {
+	return &CafeClientObjectDB{modelStore{db, lock}}
+}
+
+func (c *CafeClientObjectDB) AddOrUpdate(obj *pb.CafeClientObject) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into cafe_client_objects(id, clientId, size, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		obj.Id,
+		obj.Client,
+		obj.Size,
+		util.ProtoNanos(obj.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *CafeClientObjectDB) Get(id string, clientId string) *pb.CafeClientObject {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_objects where id='" + id + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientObjectDB) ListByClient(clientId string) []pb.CafeClientObject {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_objects where clientId='" + clientId + "' order by date desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientObjectDB) Count(id string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_objects where id='" + id + "';")
+	var count int
+	_ = row.Scan(&count)
+	return count
+}
+
+func (c *CafeClientObjectDB) Delete(id string, clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_objects where id=? and clientId=?", id, clientId)
+	return err
+}
+
+func (c *CafeClientObjectDB) DeleteByClient(clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_objects where clientId=?", clientId)
+	return err
+}
+
+func (c *CafeClientObjectDB) handleQuery(stm string) []pb.CafeClientObject {
+	var list []pb.CafeClientObject
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, clientId string
+		var size, dateInt int64
+		if err := rows.Scan(&id, &clientId, &size, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientObject{
+			Id:     id,
+			Client: clientId,
+			Size:   size,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
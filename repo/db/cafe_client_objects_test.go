@@ -0,0 +1,119 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientObjectStore repo.CafeClientObjectStore
+var cafeClientUsageStore repo.CafeClientStore
+
+func init() {
+	setupCafeClientObjectDB()
+}
+
+func setupCafeClientObjectDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeClientObjectStore = NewCafeClientObjectStore(conn, new(sync.Mutex))
+	cafeClientUsageStore = NewCafeClientStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientObjectDB_AddOrUpdate(t *testing.T) {
+	err := cafeClientObjectStore.AddOrUpdate(&pb.CafeClientObject{
+		Id:     "cid",
+		Client: "client",
+		Size:   1024,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	obj := cafeClientObjectStore.Get("cid", "client")
+	if obj == nil || obj.Size != 1024 {
+		t.Error("failed to get object")
+	}
+}
+
+func TestCafeClientObjectDB_Count(t *testing.T) {
+	err := cafeClientObjectStore.AddOrUpdate(&pb.CafeClientObject{
+		Id:     "cid",
+		Client: "client2",
+		Size:   1024,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientObjectStore.Count("cid") != 2 {
+		t.Error("count incorrect")
+	}
+}
+
+func TestCafeClientDB_Usage(t *testing.T) {
+	err := cafeClientUsageStore.Add(&pb.CafeClient{
+		Id:      "client",
+		Address: "address",
+		Created: ptypes.TimestampNow(),
+		Seen:    ptypes.TimestampNow(),
+		Token:   "token",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = cafeClientObjectStore.AddOrUpdate(&pb.CafeClientObject{
+		Id:     "cid2",
+		Client: "client",
+		Size:   512,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	usage := cafeClientUsageStore.Usage("client")
+	if usage.Bytes != 1536 || usage.Objects != 2 || usage.Threads != 0 {
+		t.Errorf("usage incorrect: %v", usage)
+	}
+	usage = cafeClientUsageStore.UsageByToken("token")
+	if usage.Bytes != 1536 || usage.Objects != 2 {
+		t.Errorf("token usage incorrect: %v", usage)
+	}
+}
+
+func TestCafeClientObjectDB_ListByClient(t *testing.T) {
+	list := cafeClientObjectStore.ListByClient("client")
+	if len(list) != 2 {
+		t.Error("returned incorrect number of objects")
+	}
+}
+
+func TestCafeClientObjectDB_Delete(t *testing.T) {
+	err := cafeClientObjectStore.Delete("cid", "client2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientObjectStore.Get("cid", "client2") != nil {
+		t.Error("delete failed")
+	}
+}
+
+func TestCafeClientObjectDB_DeleteByClient(t *testing.T) {
+	err := cafeClientObjectStore.DeleteByClient("client")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(cafeClientObjectStore.ListByClient("client")) != 0 {
+		t.Error("delete by client failed")
+	}
+}
@@ -25,7 +25,7 @@ func (c *CafeClientDB) Add(client *pb.CafeClient) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_clients(id, address, created, lastSeen, tokenId) values(?,?,?,?,?)`
+	stm := `insert into cafe_clients(id, address, created, lastSeen, tokenId, quotaBytes, quotaObjects, quotaThreads) values(?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -38,6 +38,9 @@ func (c *CafeClientDB) Add(client *pb.CafeClient) error {
 		util.ProtoNanos(client.Created),
 		util.ProtoNanos(client.Seen),
 		client.Token,
+		client.Quota.GetBytes(),
+		client.Quota.GetObjects(),
+		client.Quota.GetThreads(),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -79,6 +82,13 @@ func (c *CafeClientDB) ListByAddress(address string) []pb.CafeClient {
 	return c.handleQuery(stm)
 }
 
+func (c *CafeClientDB) ListByToken(tokenId string) []pb.CafeClient {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_clients where tokenId='" + tokenId + "' order by lastSeen desc;"
+	return c.handleQuery(stm)
+}
+
 func (c *CafeClientDB) UpdateLastSeen(id string, date time.Time) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -86,6 +96,37 @@ func (c *CafeClientDB) UpdateLastSeen(id string, date time.Time) error {
 	return err
 }
 
+func (c *CafeClientDB) UpdateQuota(id string, quota *pb.CafeQuota) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set quotaBytes=?, quotaObjects=?, quotaThreads=? where id=?",
+		quota.GetBytes(), quota.GetObjects(), quota.GetThreads(), id)
+	return err
+}
+
+func (c *CafeClientDB) UpdateExpires(id string, date time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var expires int64
+	if !date.IsZero() {
+		expires = date.UnixNano()
+	}
+	_, err := c.db.Exec("update cafe_clients set expires=? where id=?", expires, id)
+	return err
+}
+
+func (c *CafeClientDB) Usage(id string) *pb.CafeUsage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleUsageQuery("clientId=?", id)
+}
+
+func (c *CafeClientDB) UsageByToken(tokenId string) *pb.CafeUsage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleUsageQuery("clientId in (select id from cafe_clients where tokenId=?)", tokenId)
+}
+
 func (c *CafeClientDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -102,18 +143,43 @@ func (c *CafeClientDB) handleQuery(stm string) []pb.CafeClient {
 	}
 	for rows.Next() {
 		var id, address, tokenId string
-		var createdInt, lastSeenInt int64
-		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId); err != nil {
+		var createdInt, lastSeenInt, quotaBytes, expiresInt int64
+		var quotaObjects, quotaThreads int32
+		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId,
+			&quotaBytes, &quotaObjects, &quotaThreads, &expiresInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
-		list = append(list, pb.CafeClient{
+		item := pb.CafeClient{
 			Id:      id,
 			Address: address,
 			Created: util.ProtoTs(createdInt),
 			Seen:    util.ProtoTs(lastSeenInt),
 			Token:   tokenId,
-		})
+			Quota: &pb.CafeQuota{
+				Bytes:   quotaBytes,
+				Objects: quotaObjects,
+				Threads: quotaThreads,
+			},
+		}
+		if expiresInt > 0 {
+			item.Expires = util.ProtoTs(expiresInt)
+		}
+		list = append(list, item)
 	}
 	return list
 }
+
+// handleUsageQuery sums the stored objects and threads of the clients matching where
+func (c *CafeClientDB) handleUsageQuery(where string, arg string) *pb.CafeUsage {
+	usage := &pb.CafeUsage{}
+	row := c.db.QueryRow("select coalesce(sum(size), 0), Count(*) from cafe_client_objects where "+where+";", arg)
+	if err := row.Scan(&usage.Bytes, &usage.Objects); err != nil {
+		log.Errorf("error in db scan: %s", err)
+	}
+	row = c.db.QueryRow("select Count(*) from cafe_client_threads where "+where+";", arg)
+	if err := row.Scan(&usage.Threads); err != nil {
+		log.Errorf("error in db scan: %s", err)
+	}
+	return usage
+}
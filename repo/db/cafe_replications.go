@@ -0,0 +1,108 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeReplicationDB struct {
+	modelStore
+}
+
+func NewCafeReplicationStore(db *sql.DB, lock *sync.Mutex) repo.CafeReplicationStore {
+	return &CafeReplicationDB{modelStore{db, lock}}
+}
+
+// Add queues a replication, ignoring one with an id that is already queued
+func (c *CafeReplicationDB) Add(rep *pb.CafeReplication) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or ignore into cafe_replications(id, peerId, clientId, type, target, date, attempts) values(?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		rep.Id,
+		rep.Peer,
+		rep.Client,
+		int32(rep.Type),
+		rep.Target,
+		util.ProtoNanos(rep.Date),
+		rep.Attempts,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *CafeReplicationDB) ListByPeer(peerId string, limit int) []pb.CafeReplication {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_replications where peerId='" + peerId + "' order by date asc limit " + strconv.Itoa(limit) + ";"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeReplicationDB) CountByPeer(peerId string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_replications where peerId='" + peerId + "';")
+	var count int
+	_ = row.Scan(&count)
+	return count
+}
+
+func (c *CafeReplicationDB) AddAttempt(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_replications set attempts=attempts+1 where id=?", id)
+	return err
+}
+
+func (c *CafeReplicationDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_replications where id=?", id)
+	return err
+}
+
+func (c *CafeReplicationDB) handleQuery(stm string) []pb.CafeReplication {
+	var list []pb.CafeReplication
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, peerId, clientId, target string
+		var typeInt, attempts int32
+		var dateInt int64
+		if err := rows.Scan(&id, &peerId, &clientId, &typeInt, &target, &dateInt, &attempts); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeReplication{
+			Id:       id,
+			Peer:     peerId,
+			Client:   clientId,
+			Type:     pb.CafeReplication_Type(typeInt),
+			Target:   target,
+			Date:     util.ProtoTs(dateInt),
+			Attempts: attempts,
+		})
+	}
+	return list
+}
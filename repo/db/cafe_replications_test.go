@@ -0,0 +1,123 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeReplicationStore repo.CafeReplicationStore
+
+func init() {
+	setupCafeReplicationDB()
+}
+
+func setupCafeReplicationDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeReplicationStore = NewCafeReplicationStore(conn, new(sync.Mutex))
+}
+
+func TestCafeReplicationDB_Add(t *testing.T) {
+	err := cafeReplicationStore.Add(&pb.CafeReplication{
+		Id:     "abc",
+		Peer:   "peer",
+		Client: "client",
+		Type:   pb.CafeReplication_STORE,
+		Target: "cid",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeReplicationStore.ListByPeer("peer", 10)
+	if len(list) != 1 || list[0].Type != pb.CafeReplication_STORE || list[0].Target != "cid" {
+		t.Error("failed to add replication")
+	}
+}
+
+func TestCafeReplicationDB_AddDuplicate(t *testing.T) {
+	err := cafeReplicationStore.Add(&pb.CafeReplication{
+		Id:     "abc",
+		Peer:   "peer",
+		Client: "client",
+		Type:   pb.CafeReplication_UNSTORE,
+		Target: "cid",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeReplicationStore.ListByPeer("peer", 10)
+	if len(list) != 1 || list[0].Type != pb.CafeReplication_STORE {
+		t.Error("duplicate replication was not ignored")
+	}
+}
+
+func TestCafeReplicationDB_ListByPeer(t *testing.T) {
+	err := cafeReplicationStore.Add(&pb.CafeReplication{
+		Id:     "def",
+		Peer:   "peer",
+		Client: "client",
+		Type:   pb.CafeReplication_STORE_THREAD,
+		Target: "thread",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = cafeReplicationStore.Add(&pb.CafeReplication{
+		Id:     "ghi",
+		Peer:   "peer2",
+		Client: "client",
+		Type:   pb.CafeReplication_CLIENT,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeReplicationStore.ListByPeer("peer", 10)
+	if len(list) != 2 || list[0].Id != "abc" || list[1].Id != "def" {
+		t.Error("returned incorrect replications")
+	}
+	if len(cafeReplicationStore.ListByPeer("peer", 1)) != 1 {
+		t.Error("limit not applied")
+	}
+}
+
+func TestCafeReplicationDB_CountByPeer(t *testing.T) {
+	if cafeReplicationStore.CountByPeer("peer") != 2 {
+		t.Error("count incorrect")
+	}
+}
+
+func TestCafeReplicationDB_AddAttempt(t *testing.T) {
+	err := cafeReplicationStore.AddAttempt("abc")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeReplicationStore.ListByPeer("peer", 1)
+	if len(list) != 1 || list[0].Attempts != 1 {
+		t.Error("failed to add attempt")
+	}
+}
+
+func TestCafeReplicationDB_Delete(t *testing.T) {
+	err := cafeReplicationStore.Delete("abc")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeReplicationStore.CountByPeer("peer") != 1 {
+		t.Error("delete failed")
+	}
+}
@@ -25,7 +25,7 @@ func (c *CafeSessionDB) AddOrUpdate(session *pb.CafeSession) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into cafe_sessions(cafeId, access, refresh, expiry, cafe) values(?,?,?,?,?)`
+	stm := `insert or replace into cafe_sessions(cafeId, access, refresh, expiry, cafe, usage) values(?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -37,6 +37,14 @@ func (c *CafeSessionDB) AddOrUpdate(session *pb.CafeSession) error {
 	if err != nil {
 		return err
 	}
+	var usage []byte
+	if session.Usage != nil {
+		str, err := pbMarshaler.MarshalToString(session.Usage)
+		if err != nil {
+			return err
+		}
+		usage = []byte(str)
+	}
 
 	_, err = stmt.Exec(
 		session.Id,
@@ -44,6 +52,7 @@ func (c *CafeSessionDB) AddOrUpdate(session *pb.CafeSession) error {
 		session.Refresh,
 		util.ProtoNanos(session.Exp),
 		[]byte(cafe),
+		usage,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -69,6 +78,17 @@ func (c *CafeSessionDB) List() *pb.CafeSessionList {
 	return c.handleQuery(stm)
 }
 
+func (c *CafeSessionDB) UpdateUsage(cafeId string, usage *pb.CafeUsage) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	str, err := pbMarshaler.MarshalToString(usage)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec("update cafe_sessions set usage=? where cafeId=?", []byte(str), cafeId)
+	return err
+}
+
 func (c *CafeSessionDB) Delete(cafeId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -86,8 +106,8 @@ func (c *CafeSessionDB) handleQuery(stm string) *pb.CafeSessionList {
 	for rows.Next() {
 		var cafeId, access, refresh string
 		var expiryInt int64
-		var cafe []byte
-		if err := rows.Scan(&cafeId, &access, &refresh, &expiryInt, &cafe); err != nil {
+		var cafe, usage []byte
+		if err := rows.Scan(&cafeId, &access, &refresh, &expiryInt, &cafe, &usage); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -98,12 +118,22 @@ func (c *CafeSessionDB) handleQuery(stm string) *pb.CafeSessionList {
 			continue
 		}
 
+		var rusage *pb.CafeUsage
+		if len(usage) > 0 {
+			rusage = new(pb.CafeUsage)
+			if err := pbUnmarshaler.Unmarshal(bytes.NewReader(usage), rusage); err != nil {
+				log.Errorf("error unmarshaling usage: %s", err)
+				continue
+			}
+		}
+
 		list.Items = append(list.Items, &pb.CafeSession{
 			Id:      cafeId,
 			Access:  access,
 			Refresh: refresh,
 			Exp:     util.ProtoTs(expiryInt),
 			Cafe:    rcafe,
+			Usage:   rusage,
 		})
 	}
 	return list
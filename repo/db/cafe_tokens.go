@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strings"
 	"sync"
 
 	"github.com/textileio/go-textile/pb"
@@ -24,7 +25,7 @@ func (c *CafeTokenDB) Add(token *pb.CafeToken) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_tokens(id, token, date) values(?,?,?)`
+	stm := `insert into cafe_tokens(id, token, date, quotaBytes, quotaObjects, quotaThreads, expires, maxRegistrations, registrations, scopes) values(?,?,?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -35,6 +36,13 @@ func (c *CafeTokenDB) Add(token *pb.CafeToken) error {
 		token.Id,
 		token.Value,
 		util.ProtoNanos(token.Date),
+		token.Quota.GetBytes(),
+		token.Quota.GetObjects(),
+		token.Quota.GetThreads(),
+		tokenExpiresNanos(token),
+		token.MaxRegistrations,
+		token.Registrations,
+		strings.Join(tokenScopeNames(token.Scopes), ","),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -60,6 +68,30 @@ func (c *CafeTokenDB) List() []pb.CafeToken {
 	return c.handleQuery(stm)
 }
 
+func (c *CafeTokenDB) UpdateQuota(id string, quota *pb.CafeQuota) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_tokens set quotaBytes=?, quotaObjects=?, quotaThreads=? where id=?",
+		quota.GetBytes(), quota.GetObjects(), quota.GetThreads(), id)
+	return err
+}
+
+// AddRegistration takes a registration from the token if it has any left,
+// returning false when the token is full
+func (c *CafeTokenDB) AddRegistration(id string) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res, err := c.db.Exec("update cafe_tokens set registrations=registrations+1 where id=? and (maxRegistrations=0 or registrations<maxRegistrations)", id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
 func (c *CafeTokenDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -77,16 +109,50 @@ func (c *CafeTokenDB) handleQuery(stm string) []pb.CafeToken {
 	for rows.Next() {
 		var id string
 		var token []byte
-		var dateInt int64
-		if err := rows.Scan(&id, &token, &dateInt); err != nil {
+		var dateInt, quotaBytes, expiresInt int64
+		var quotaObjects, quotaThreads, maxRegistrations, registrations int32
+		var scopes string
+		if err := rows.Scan(&id, &token, &dateInt, &quotaBytes, &quotaObjects, &quotaThreads,
+			&expiresInt, &maxRegistrations, &registrations, &scopes); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
-		list = append(list, pb.CafeToken{
+		item := pb.CafeToken{
 			Id:    id,
 			Value: token,
 			Date:  util.ProtoTs(dateInt),
-		})
+			Quota: &pb.CafeQuota{
+				Bytes:   quotaBytes,
+				Objects: quotaObjects,
+				Threads: quotaThreads,
+			},
+			MaxRegistrations: maxRegistrations,
+			Registrations:    registrations,
+		}
+		if expiresInt > 0 {
+			item.Expires = util.ProtoTs(expiresInt)
+		}
+		for _, name := range util.SplitString(scopes, ",") {
+			item.Scopes = append(item.Scopes, pb.CafeToken_Scope(pb.CafeToken_Scope_value[name]))
+		}
+		list = append(list, item)
 	}
 	return list
 }
+
+// tokenExpiresNanos returns the expiration of a token, or zero if it doesn't expire
+func tokenExpiresNanos(token *pb.CafeToken) int64 {
+	if token.Expires == nil {
+		return 0
+	}
+	return util.ProtoNanos(token.Expires)
+}
+
+// tokenScopeNames returns the names of token scopes
+func tokenScopeNames(scopes []pb.CafeToken_Scope) []string {
+	var names []string
+	for _, scope := range scopes {
+		names = append(names, scope.String())
+	}
+	return names
+}
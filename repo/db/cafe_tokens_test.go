@@ -0,0 +1,92 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeTokenStore repo.CafeTokenStore
+
+func init() {
+	setupCafeTokenDB()
+}
+
+func setupCafeTokenDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeTokenStore = NewCafeTokenStore(conn, new(sync.Mutex))
+}
+
+func TestCafeTokenDB_Add(t *testing.T) {
+	expires, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = cafeTokenStore.Add(&pb.CafeToken{
+		Id:               "id",
+		Value:            []byte("token"),
+		Date:             ptypes.TimestampNow(),
+		Expires:          expires,
+		MaxRegistrations: 2,
+		Scopes:           []pb.CafeToken_Scope{pb.CafeToken_STORE, pb.CafeToken_SEARCH},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	token := cafeTokenStore.Get("id")
+	if token == nil {
+		t.Error("failed to get token")
+		return
+	}
+	if token.Expires.Seconds != expires.Seconds || token.MaxRegistrations != 2 {
+		t.Error("token limits incorrect")
+	}
+	if len(token.Scopes) != 2 || token.Scopes[0] != pb.CafeToken_STORE || token.Scopes[1] != pb.CafeToken_SEARCH {
+		t.Error("token scopes incorrect")
+	}
+}
+
+func TestCafeTokenDB_AddRegistration(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		ok, err := cafeTokenStore.AddRegistration("id")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !ok {
+			t.Error("failed to add registration")
+			return
+		}
+	}
+	ok, err := cafeTokenStore.AddRegistration("id")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if ok {
+		t.Error("added registration beyond max")
+	}
+	token := cafeTokenStore.Get("id")
+	if token == nil || token.Registrations != 2 {
+		t.Error("failed to add registration")
+	}
+}
+
+func TestCafeTokenDB_Delete(t *testing.T) {
+	err := cafeTokenStore.Delete("id")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeTokenStore.Get("id") != nil {
+		t.Error("delete failed")
+	}
+}
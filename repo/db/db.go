@@ -23,25 +23,32 @@ var pbUnmarshaler = jsonpb.Unmarshaler{
 }
 
 type SQLiteDatastore struct {
-	config             repo.ConfigStore
-	peers              repo.PeerStore
-	files              repo.FileStore
-	threads            repo.ThreadStore
-	threadPeers        repo.ThreadPeerStore
-	blocks             repo.BlockStore
-	blockMessages      repo.BlockMessageStore
-	invites            repo.InviteStore
-	notifications      repo.NotificationStore
-	cafeSessions       repo.CafeSessionStore
-	cafeRequests       repo.CafeRequestStore
-	cafeMessages       repo.CafeMessageStore
-	cafeClientNonces   repo.CafeClientNonceStore
-	cafeClients        repo.CafeClientStore
-	cafeTokens         repo.CafeTokenStore
-	cafeClientThreads  repo.CafeClientThreadStore
-	cafeClientMessages repo.CafeClientMessageStore
-	db                 *sql.DB
-	lock               *sync.Mutex
+	config              repo.ConfigStore
+	peers               repo.PeerStore
+	files               repo.FileStore
+	threads             repo.ThreadStore
+	threadPeers         repo.ThreadPeerStore
+	threadSchemas       repo.ThreadSchemaStore
+	threadMembers       repo.ThreadMemberStore
+	threadMemberChanges repo.ThreadMemberChangeStore
+	threadKeys          repo.ThreadKeyStore
+	threadRetentions    repo.ThreadRetentionStore
+	blocks              repo.BlockStore
+	blockMessages       repo.BlockMessageStore
+	invites             repo.InviteStore
+	notifications       repo.NotificationStore
+	cafeSessions        repo.CafeSessionStore
+	cafeRequests        repo.CafeRequestStore
+	cafeMessages        repo.CafeMessageStore
+	cafeClientNonces    repo.CafeClientNonceStore
+	cafeClients         repo.CafeClientStore
+	cafeTokens          repo.CafeTokenStore
+	cafeClientThreads   repo.CafeClientThreadStore
+	cafeClientMessages  repo.CafeClientMessageStore
+	cafeClientObjects   repo.CafeClientObjectStore
+	cafeReplications    repo.CafeReplicationStore
+	db                  *sql.DB
+	lock                *sync.Mutex
 }
 
 func Create(repoPath, pin string) (*SQLiteDatastore, error) {
@@ -60,25 +67,32 @@ func Create(repoPath, pin string) (*SQLiteDatastore, error) {
 	}
 	lock := new(sync.Mutex)
 	return &SQLiteDatastore{
-		config:             NewConfigStore(conn, lock, dbPath),
-		peers:              NewPeerStore(conn, lock),
-		files:              NewFileStore(conn, lock),
-		threads:            NewThreadStore(conn, lock),
-		threadPeers:        NewThreadPeerStore(conn, lock),
-		blocks:             NewBlockStore(conn, lock),
-		blockMessages:      NewBlockMessageStore(conn, lock),
-		invites:            NewInviteStore(conn, lock),
-		notifications:      NewNotificationStore(conn, lock),
-		cafeSessions:       NewCafeSessionStore(conn, lock),
-		cafeRequests:       NewCafeRequestStore(conn, lock),
-		cafeMessages:       NewCafeMessageStore(conn, lock),
-		cafeClientNonces:   NewCafeClientNonceStore(conn, lock),
-		cafeClients:        NewCafeClientStore(conn, lock),
-		cafeTokens:         NewCafeTokenStore(conn, lock),
-		cafeClientThreads:  NewCafeClientThreadStore(conn, lock),
-		cafeClientMessages: NewCafeClientMessageStore(conn, lock),
-		db:                 conn,
-		lock:               lock,
+		config:              NewConfigStore(conn, lock, dbPath),
+		peers:               NewPeerStore(conn, lock),
+		files:               NewFileStore(conn, lock),
+		threads:             NewThreadStore(conn, lock),
+		threadPeers:         NewThreadPeerStore(conn, lock),
+		threadSchemas:       NewThreadSchemaStore(conn, lock),
+		threadMembers:       NewThreadMemberStore(conn, lock),
+		threadMemberChanges: NewThreadMemberChangeStore(conn, lock),
+		threadKeys:          NewThreadKeyStore(conn, lock),
+		threadRetentions:    NewThreadRetentionStore(conn, lock),
+		blocks:              NewBlockStore(conn, lock),
+		blockMessages:       NewBlockMessageStore(conn, lock),
+		invites:             NewInviteStore(conn, lock),
+		notifications:       NewNotificationStore(conn, lock),
+		cafeSessions:        NewCafeSessionStore(conn, lock),
+		cafeRequests:        NewCafeRequestStore(conn, lock),
+		cafeMessages:        NewCafeMessageStore(conn, lock),
+		cafeClientNonces:    NewCafeClientNonceStore(conn, lock),
+		cafeClients:         NewCafeClientStore(conn, lock),
+		cafeTokens:          NewCafeTokenStore(conn, lock),
+		cafeClientThreads:   NewCafeClientThreadStore(conn, lock),
+		cafeClientMessages:  NewCafeClientMessageStore(conn, lock),
+		cafeClientObjects:   NewCafeClientObjectStore(conn, lock),
+		cafeReplications:    NewCafeReplicationStore(conn, lock),
+		db:                  conn,
+		lock:                lock,
 	}, nil
 }
 
@@ -110,6 +124,26 @@ func (d *SQLiteDatastore) ThreadPeers() repo.ThreadPeerStore {
 	return d.threadPeers
 }
 
+func (d *SQLiteDatastore) ThreadSchemas() repo.ThreadSchemaStore {
+	return d.threadSchemas
+}
+
+func (d *SQLiteDatastore) ThreadMembers() repo.ThreadMemberStore {
+	return d.threadMembers
+}
+
+func (d *SQLiteDatastore) ThreadMemberChanges() repo.ThreadMemberChangeStore {
+	return d.threadMemberChanges
+}
+
+func (d *SQLiteDatastore) ThreadKeys() repo.ThreadKeyStore {
+	return d.threadKeys
+}
+
+func (d *SQLiteDatastore) ThreadRetentions() repo.ThreadRetentionStore {
+	return d.threadRetentions
+}
+
 func (d *SQLiteDatastore) Blocks() repo.BlockStore {
 	return d.blocks
 }
@@ -158,6 +192,14 @@ func (d *SQLiteDatastore) CafeClientMessages() repo.CafeClientMessageStore {
 	return d.cafeClientMessages
 }
 
+func (d *SQLiteDatastore) CafeClientObjects() repo.CafeClientObjectStore {
+	return d.cafeClientObjects
+}
+
+func (d *SQLiteDatastore) CafeReplications() repo.CafeReplicationStore {
+	return d.cafeReplications
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, pin string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -215,10 +257,13 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index peer_username on peers (username);
     create index peer_updated on peers (updated);
 
-    create table files (mill text not null, checksum text not null, source text not null, opts text not null, hash text not null, key text not null, media text not null, name text not null, size integer not null, added integer not null, meta blob, targets text, primary key (mill, checksum));
+    create table files (mill text not null, checksum text not null, source text not null, opts text not null, hash text not null, key text not null, media text not null, name text not null, size integer not null, added integer not null, meta blob, targets text, encryption integer not null default 0, primary key (mill, checksum));
     create index file_hash on files (hash);
     create unique index file_mill_source_opts on files (mill, source, opts);
 
+    create table file_chunks (chunk text not null, file text not null, primary key (chunk, file));
+    create index file_chunk_file on file_chunks (file);
+
     create table threads (id text primary key not null, key text not null, sk blob not null, name text not null, schema text not null, initiator text not null, type integer not null, state integer not null, head text not null, members text not null, sharing integer not null);
     create unique index thread_key on threads (key);
 
@@ -227,13 +272,26 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index thread_peer_threadId on thread_peers (threadId);
     create index thread_peer_welcomed on thread_peers (welcomed);
 
-    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null);
+    create table thread_schemas (threadId text not null, version integer not null, hash text not null, date integer not null, primary key (threadId, version));
+
+    create table thread_members (threadId text not null, address text not null, role integer not null, removed integer not null, date integer not null, blockId text not null, primary key (threadId, address));
+
+    create table thread_member_changes (id text primary key not null, threadId text not null, address text not null, clock integer not null, date integer not null, role integer not null, membership integer not null);
+    create index thread_member_change_threadId_address on thread_member_changes (threadId, address);
+
+    create table thread_keys (threadId text not null, epoch integer not null, sk blob not null, blockId text not null, date integer not null, primary key (threadId, blockId));
+
+    create table thread_retentions (threadId text primary key not null, maxAge integer not null, maxBlocks integer not null);
+
+    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null, expires integer not null, clock integer not null);
     create index block_threadId on blocks (threadId);
     create index block_type on blocks (type);
     create index block_date on blocks (date);
     create index block_target on blocks (target);
     create index block_data on blocks (data);
     create index block_status on blocks (status);
+    create index block_expires on blocks (expires);
+    create index block_clock on blocks (clock);
 
     create table block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
     create index block_message_date on block_messages (date);
@@ -248,7 +306,7 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index notification_blockId on notifications (blockId);
     create index notification_read on notifications (read);
 
-    create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
+    create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null, usage blob);
 
     create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null);
     create index cafe_request_cafeId on cafe_requests (cafeId);
@@ -262,7 +320,7 @@ func initDatabaseTables(db *sql.DB, pin string) error {
 
     create table cafe_client_nonces (value text primary key not null, address text not null, date integer not null);
 
-    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null);
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null, quotaBytes integer not null default 0, quotaObjects integer not null default 0, quotaThreads integer not null default 0, expires integer not null default 0);
     create index cafe_client_address on cafe_clients (address);
     create index cafe_client_lastSeen on cafe_clients (lastSeen);
 
@@ -273,7 +331,14 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index cafe_client_message_clientId on cafe_client_messages (clientId);
     create index cafe_client_message_date on cafe_client_messages (date);
 
-    create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+    create table cafe_client_objects (id text not null, clientId text not null, size integer not null, date integer not null, primary key (id, clientId));
+    create index cafe_client_object_clientId on cafe_client_objects (clientId);
+
+    create table cafe_replications (id text primary key not null, peerId text not null, clientId text not null, type integer not null, target text not null, date integer not null, attempts integer not null);
+    create index cafe_replication_peerId on cafe_replications (peerId);
+    create index cafe_replication_date on cafe_replications (date);
+
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null, quotaBytes integer not null default 0, quotaObjects integer not null default 0, quotaThreads integer not null default 0, expires integer not null default 0, maxRegistrations integer not null default 0, registrations integer not null default 0, scopes text not null default '');
     `
 	if _, err := db.Exec(sqlStmt); err != nil {
 		return err
@@ -30,7 +30,7 @@ func (c *FileDB) Add(file *pb.FileIndex) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into files(mill, checksum, source, opts, hash, key, media, name, size, added, meta, targets) values(?,?,?,?,?,?,?,?,?,?,?,?)`
+	stm := `insert into files(mill, checksum, source, opts, hash, key, media, name, size, added, meta, targets, encryption) values(?,?,?,?,?,?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -73,6 +73,7 @@ func (c *FileDB) Add(file *pb.FileIndex) error {
 		added,
 		[]byte(meta),
 		targets,
+		int32(file.Encryption),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -112,6 +113,12 @@ func (c *FileDB) GetBySource(mill string, source string, opts string) *pb.FileIn
 	return &res[0]
 }
 
+func (c *FileDB) ListByMill(mill string) []pb.FileIndex {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from files where mill='" + mill + "';")
+}
+
 func (c *FileDB) AddTarget(hash string, target string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -164,6 +171,43 @@ func (c *FileDB) RemoveTarget(hash string, target string) error {
 	return err
 }
 
+// AddChunks records the chunks that make up the content of file hash
+func (c *FileDB) AddChunks(hash string, chunks []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or ignore into file_chunks(chunk, file) values(?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+
+	for _, chunk := range chunks {
+		_, err = stmt.Exec(chunk, hash)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CountChunkFiles returns the number of files that share chunk
+func (c *FileDB) CountChunkFiles(chunk string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from file_chunks where chunk=?;", chunk)
+	var count int
+	_ = row.Scan(&count)
+	return count
+}
+
 func (c *FileDB) Count() int {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -177,6 +221,10 @@ func (c *FileDB) Delete(hash string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	_, err := c.db.Exec("delete from files where hash=?", hash)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec("delete from file_chunks where file=?", hash)
 	return err
 }
 
@@ -193,8 +241,9 @@ func (c *FileDB) handleQuery(stm string) []pb.FileIndex {
 		var addedInt int64
 		var metab []byte
 		var targets *string
+		var encryptionInt int
 
-		if err := rows.Scan(&mill, &checksum, &source, &opts, &hash, &key, &media, &name, &size, &addedInt, &metab, &targets); err != nil {
+		if err := rows.Scan(&mill, &checksum, &source, &opts, &hash, &key, &media, &name, &size, &addedInt, &metab, &targets, &encryptionInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -213,18 +262,19 @@ func (c *FileDB) handleQuery(stm string) []pb.FileIndex {
 		}
 
 		list = append(list, pb.FileIndex{
-			Mill:     mill,
-			Checksum: checksum,
-			Source:   source,
-			Opts:     opts,
-			Hash:     hash,
-			Key:      key,
-			Media:    media,
-			Name:     name,
-			Size:     size,
-			Added:    util.ProtoTs(addedInt),
-			Meta:     meta,
-			Targets:  tlist,
+			Mill:       mill,
+			Checksum:   checksum,
+			Source:     source,
+			Opts:       opts,
+			Hash:       hash,
+			Key:        key,
+			Media:      media,
+			Name:       name,
+			Size:       size,
+			Added:      util.ProtoTs(addedInt),
+			Meta:       meta,
+			Targets:    tlist,
+			Encryption: pb.FileIndex_Encryption(encryptionInt),
 		})
 	}
 
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ThreadKeyDB struct {
+	modelStore
+}
+
+func NewThreadKeyStore(db *sql.DB, lock *sync.Mutex) repo.ThreadKeyStore {
+	return &ThreadKeyDB{modelStore{db, lock}}
+}
+
+func (c *ThreadKeyDB) Add(key *pb.ThreadKey) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into thread_keys(threadId, epoch, sk, blockId, date) values(?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		key.Thread,
+		key.Epoch,
+		key.Sk,
+		key.Block,
+		util.ProtoNanos(key.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListByThread returns the rotated keys of a thread, newest first
+func (c *ThreadKeyDB) ListByThread(threadId string) *pb.ThreadKeyList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from thread_keys where threadId='" + threadId + "' order by epoch desc, date desc, blockId desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *ThreadKeyDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_keys where threadId=?", threadId)
+	return err
+}
+
+func (c *ThreadKeyDB) handleQuery(stm string) *pb.ThreadKeyList {
+	list := &pb.ThreadKeyList{Items: make([]*pb.ThreadKey, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var threadId, blockId string
+		var epoch int32
+		var sk []byte
+		var dateInt int64
+		if err := rows.Scan(&threadId, &epoch, &sk, &blockId, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.ThreadKey{
+			Thread: threadId,
+			Epoch:  epoch,
+			Sk:     sk,
+			Block:  blockId,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
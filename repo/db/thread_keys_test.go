@@ -0,0 +1,80 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var threadKeyStore repo.ThreadKeyStore
+
+func init() {
+	setupThreadKeyDB()
+}
+
+func setupThreadKeyDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	threadKeyStore = NewThreadKeyStore(conn, new(sync.Mutex))
+}
+
+func TestThreadKeyDB_Add(t *testing.T) {
+	err := threadKeyStore.Add(&pb.ThreadKey{
+		Thread: "thread",
+		Epoch:  1,
+		Sk:     []byte("sk1"),
+		Block:  "block1",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = threadKeyStore.Add(&pb.ThreadKey{
+		Thread: "thread",
+		Epoch:  1,
+		Sk:     []byte("sk1"),
+		Block:  "block1",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err == nil {
+		t.Error("added a duplicate key")
+	}
+}
+
+func TestThreadKeyDB_ListByThread(t *testing.T) {
+	err := threadKeyStore.Add(&pb.ThreadKey{
+		Thread: "thread",
+		Epoch:  2,
+		Sk:     []byte("sk2"),
+		Block:  "block2",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := threadKeyStore.ListByThread("thread")
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of keys")
+		return
+	}
+	if list.Items[0].Epoch != 2 || string(list.Items[0].Sk) != "sk2" {
+		t.Error("keys are not ordered newest first")
+	}
+}
+
+func TestThreadKeyDB_DeleteByThread(t *testing.T) {
+	err := threadKeyStore.DeleteByThread("thread")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(threadKeyStore.ListByThread("thread").Items) != 0 {
+		t.Error("delete by thread failed")
+	}
+}
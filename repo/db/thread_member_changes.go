@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ThreadMemberChangeDB struct {
+	modelStore
+}
+
+func NewThreadMemberChangeStore(db *sql.DB, lock *sync.Mutex) repo.ThreadMemberChangeStore {
+	return &ThreadMemberChangeDB{modelStore{db, lock}}
+}
+
+func (c *ThreadMemberChangeDB) Add(change *pb.ThreadMemberChange) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into thread_member_changes(id, threadId, address, clock, date, role, membership) values(?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		change.Block,
+		change.Thread,
+		change.Address,
+		change.Clock,
+		util.ProtoNanos(change.Date),
+		int32(change.Role),
+		int32(change.Membership),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListByAddress returns the membership changes of an address in thread history order
+func (c *ThreadMemberChangeDB) ListByAddress(threadId string, address string) *pb.ThreadMemberChangeList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from thread_member_changes where threadId='" + threadId + "' and address='" + address + "' order by clock asc, date asc, id asc;"
+	return c.handleQuery(stm)
+}
+
+func (c *ThreadMemberChangeDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_member_changes where threadId=?", threadId)
+	return err
+}
+
+func (c *ThreadMemberChangeDB) handleQuery(stm string) *pb.ThreadMemberChangeList {
+	list := &pb.ThreadMemberChangeList{Items: make([]*pb.ThreadMemberChange, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var id, threadId, address string
+		var clock, dateInt int64
+		var roleInt, membershipInt int
+		if err := rows.Scan(&id, &threadId, &address, &clock, &dateInt, &roleInt, &membershipInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.ThreadMemberChange{
+			Block:      id,
+			Thread:     threadId,
+			Address:    address,
+			Clock:      clock,
+			Date:       util.ProtoTs(dateInt),
+			Role:       pb.ThreadMember_Role(roleInt),
+			Membership: pb.ThreadMemberChange_Membership(membershipInt),
+		})
+	}
+	return list
+}
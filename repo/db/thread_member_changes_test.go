@@ -0,0 +1,89 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var threadMemberChangeStore repo.ThreadMemberChangeStore
+
+func init() {
+	setupThreadMemberChangeDB()
+}
+
+func setupThreadMemberChangeDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	threadMemberChangeStore = NewThreadMemberChangeStore(conn, new(sync.Mutex))
+}
+
+func TestThreadMemberChangeDB_Add(t *testing.T) {
+	err := threadMemberChangeStore.Add(&pb.ThreadMemberChange{
+		Block:      "block",
+		Thread:     "thread",
+		Address:    "address",
+		Clock:      2,
+		Date:       ptypes.TimestampNow(),
+		Role:       pb.ThreadMember_WRITER,
+		Membership: pb.ThreadMemberChange_ADDED,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := threadMemberChangeStore.PrepareQuery("select address from thread_member_changes where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var address string
+	err = stmt.QueryRow("block").Scan(&address)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if address != "address" {
+		t.Error("wrong address")
+	}
+}
+
+func TestThreadMemberChangeDB_ListByAddress(t *testing.T) {
+	err := threadMemberChangeStore.Add(&pb.ThreadMemberChange{
+		Block:      "block2",
+		Thread:     "thread",
+		Address:    "address",
+		Clock:      1,
+		Date:       ptypes.TimestampNow(),
+		Role:       pb.ThreadMember_ADMIN,
+		Membership: pb.ThreadMemberChange_KEPT,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := threadMemberChangeStore.ListByAddress("thread", "address")
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of changes")
+		return
+	}
+	if list.Items[0].Block != "block2" {
+		t.Error("changes are not in clock order")
+	}
+}
+
+func TestThreadMemberChangeDB_DeleteByThread(t *testing.T) {
+	err := threadMemberChangeStore.DeleteByThread("thread")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(threadMemberChangeStore.ListByAddress("thread", "address").Items) != 0 {
+		t.Error("delete by thread failed")
+	}
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ThreadMemberDB struct {
+	modelStore
+}
+
+func NewThreadMemberStore(db *sql.DB, lock *sync.Mutex) repo.ThreadMemberStore {
+	return &ThreadMemberDB{modelStore{db, lock}}
+}
+
+// Put adds or replaces the membership state of an address in a thread
+func (c *ThreadMemberDB) Put(member *pb.ThreadMember) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into thread_members(threadId, address, role, removed, date, blockId) values(?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		member.Thread,
+		member.Address,
+		int32(member.Role),
+		member.Removed,
+		util.ProtoNanos(member.Date),
+		member.Block,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *ThreadMemberDB) Get(threadId string, address string) *pb.ThreadMember {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from thread_members where threadId='" + threadId + "' and address='" + address + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *ThreadMemberDB) ListByThread(threadId string) *pb.ThreadMemberList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from thread_members where threadId='" + threadId + "' order by date asc;"
+	return c.handleQuery(stm)
+}
+
+func (c *ThreadMemberDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_members where threadId=?", threadId)
+	return err
+}
+
+func (c *ThreadMemberDB) handleQuery(stm string) *pb.ThreadMemberList {
+	list := &pb.ThreadMemberList{Items: make([]*pb.ThreadMember, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var threadId, address, blockId string
+		var roleInt, removedInt int
+		var dateInt int64
+		if err := rows.Scan(&threadId, &address, &roleInt, &removedInt, &dateInt, &blockId); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.ThreadMember{
+			Thread:  threadId,
+			Address: address,
+			Role:    pb.ThreadMember_Role(roleInt),
+			Removed: removedInt == 1,
+			Date:    util.ProtoTs(dateInt),
+			Block:   blockId,
+		})
+	}
+	return list
+}
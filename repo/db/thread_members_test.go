@@ -0,0 +1,103 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var threadMemberStore repo.ThreadMemberStore
+
+func init() {
+	setupThreadMemberDB()
+}
+
+func setupThreadMemberDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	threadMemberStore = NewThreadMemberStore(conn, new(sync.Mutex))
+}
+
+func TestThreadMemberDB_Put(t *testing.T) {
+	err := threadMemberStore.Put(&pb.ThreadMember{
+		Thread:  "thread",
+		Address: "address",
+		Role:    pb.ThreadMember_WRITER,
+		Date:    ptypes.TimestampNow(),
+		Block:   "block",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := threadMemberStore.PrepareQuery("select address from thread_members where threadId=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var address string
+	err = stmt.QueryRow("thread").Scan(&address)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if address != "address" {
+		t.Error("wrong address")
+	}
+}
+
+func TestThreadMemberDB_Replace(t *testing.T) {
+	err := threadMemberStore.Put(&pb.ThreadMember{
+		Thread:  "thread",
+		Address: "address",
+		Removed: true,
+		Date:    ptypes.TimestampNow(),
+		Block:   "block2",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	member := threadMemberStore.Get("thread", "address")
+	if member == nil {
+		t.Error("could not get member")
+		return
+	}
+	if !member.Removed || member.Role != pb.ThreadMember_DEFAULT || member.Block != "block2" {
+		t.Error("member was not replaced")
+	}
+}
+
+func TestThreadMemberDB_ListByThread(t *testing.T) {
+	err := threadMemberStore.Put(&pb.ThreadMember{
+		Thread:  "thread",
+		Address: "address2",
+		Role:    pb.ThreadMember_ADMIN,
+		Date:    ptypes.TimestampNow(),
+		Block:   "block3",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := threadMemberStore.ListByThread("thread")
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of members")
+	}
+}
+
+func TestThreadMemberDB_DeleteByThread(t *testing.T) {
+	err := threadMemberStore.DeleteByThread("thread")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if threadMemberStore.Get("thread", "address2") != nil {
+		t.Error("delete by thread failed")
+	}
+}
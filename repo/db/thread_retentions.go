@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+type ThreadRetentionDB struct {
+	modelStore
+}
+
+func NewThreadRetentionStore(db *sql.DB, lock *sync.Mutex) repo.ThreadRetentionStore {
+	return &ThreadRetentionDB{modelStore{db, lock}}
+}
+
+// Put adds or replaces the retention policy of a thread
+func (c *ThreadRetentionDB) Put(retention *pb.ThreadRetention) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into thread_retentions(threadId, maxAge, maxBlocks) values(?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		retention.Thread,
+		retention.MaxAge,
+		retention.MaxBlocks,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *ThreadRetentionDB) Get(threadId string) *pb.ThreadRetention {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from thread_retentions where threadId='" + threadId + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *ThreadRetentionDB) List() *pb.ThreadRetentionList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from thread_retentions;")
+}
+
+func (c *ThreadRetentionDB) Delete(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_retentions where threadId=?", threadId)
+	return err
+}
+
+func (c *ThreadRetentionDB) handleQuery(stm string) *pb.ThreadRetentionList {
+	list := &pb.ThreadRetentionList{Items: make([]*pb.ThreadRetention, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var threadId string
+		var maxAge int64
+		var maxBlocks int
+		if err := rows.Scan(&threadId, &maxAge, &maxBlocks); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.ThreadRetention{
+			Thread:    threadId,
+			MaxAge:    maxAge,
+			MaxBlocks: int32(maxBlocks),
+		})
+	}
+	return list
+}
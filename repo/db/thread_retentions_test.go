@@ -0,0 +1,77 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var threadRetentionStore repo.ThreadRetentionStore
+
+func init() {
+	setupThreadRetentionDB()
+}
+
+func setupThreadRetentionDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	threadRetentionStore = NewThreadRetentionStore(conn, new(sync.Mutex))
+}
+
+func TestThreadRetentionDB_Put(t *testing.T) {
+	err := threadRetentionStore.Put(&pb.ThreadRetention{
+		Thread: "thread",
+		MaxAge: 3600,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = threadRetentionStore.Put(&pb.ThreadRetention{
+		Thread:    "thread",
+		MaxBlocks: 10,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestThreadRetentionDB_Get(t *testing.T) {
+	retention := threadRetentionStore.Get("thread")
+	if retention == nil {
+		t.Error("failed to get retention")
+		return
+	}
+	if retention.MaxAge != 0 || retention.MaxBlocks != 10 {
+		t.Error("retention was not replaced")
+	}
+}
+
+func TestThreadRetentionDB_List(t *testing.T) {
+	err := threadRetentionStore.Put(&pb.ThreadRetention{
+		Thread: "thread2",
+		MaxAge: 60,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := threadRetentionStore.List()
+	if len(list.Items) != 2 {
+		t.Error("wrong number of retentions")
+	}
+}
+
+func TestThreadRetentionDB_Delete(t *testing.T) {
+	err := threadRetentionStore.Delete("thread")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if threadRetentionStore.Get("thread") != nil {
+		t.Error("delete failed")
+	}
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ThreadSchemaDB struct {
+	modelStore
+}
+
+func NewThreadSchemaStore(db *sql.DB, lock *sync.Mutex) repo.ThreadSchemaStore {
+	return &ThreadSchemaDB{modelStore{db, lock}}
+}
+
+// Put adds a schema version of a thread. An existing version is only replaced
+// by a later one, ties are broken by hash.
+func (c *ThreadSchemaDB) Put(schema *pb.ThreadSchema) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into thread_schemas(threadId, version, hash, date) select ?,?,?,?
+    where not exists (select 1 from thread_schemas where threadId=? and version=? and (date>? or (date=? and hash>=?)))`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		_ = tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	date := util.ProtoNanos(schema.Date)
+	_, err = stmt.Exec(
+		schema.Thread,
+		schema.Version,
+		schema.Hash,
+		date,
+		schema.Thread,
+		schema.Version,
+		date,
+		date,
+		schema.Hash,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// List returns the schema versions of a thread, oldest first
+func (c *ThreadSchemaDB) List(threadId string) *pb.ThreadSchemaList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from thread_schemas where threadId='" + threadId + "' order by version asc;"
+	return c.handleQuery(stm)
+}
+
+func (c *ThreadSchemaDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_schemas where threadId=?", threadId)
+	return err
+}
+
+func (c *ThreadSchemaDB) handleQuery(stm string) *pb.ThreadSchemaList {
+	list := &pb.ThreadSchemaList{Items: make([]*pb.ThreadSchema, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var threadId, hash string
+		var version int32
+		var dateInt int64
+		if err := rows.Scan(&threadId, &version, &hash, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.ThreadSchema{
+			Thread:  threadId,
+			Version: version,
+			Hash:    hash,
+			Date:    util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var threadSchemaStore repo.ThreadSchemaStore
+
+func init() {
+	setupThreadSchemaDB()
+}
+
+func setupThreadSchemaDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	threadSchemaStore = NewThreadSchemaStore(conn, new(sync.Mutex))
+}
+
+func TestThreadSchemaDB_Put(t *testing.T) {
+	err := threadSchemaStore.Put(&pb.ThreadSchema{
+		Thread:  "thread",
+		Version: 1,
+		Hash:    "Qm1",
+		Date:    util.ProtoTs(1),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = threadSchemaStore.Put(&pb.ThreadSchema{
+		Thread:  "thread",
+		Version: 2,
+		Hash:    "Qm2",
+		Date:    util.ProtoTs(3),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = threadSchemaStore.Put(&pb.ThreadSchema{
+		Thread:  "other",
+		Version: 1,
+		Hash:    "Qm1",
+		Date:    util.ProtoTs(1),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestThreadSchemaDB_PutConflict(t *testing.T) {
+	// an earlier version 2 loses
+	err := threadSchemaStore.Put(&pb.ThreadSchema{
+		Thread:  "thread",
+		Version: 2,
+		Hash:    "Qm3",
+		Date:    util.ProtoTs(2),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := threadSchemaStore.List("thread")
+	if len(list.Items) != 2 || list.Items[1].Hash != "Qm2" {
+		t.Error("earlier schema version replaced a later one")
+	}
+}
+
+func TestThreadSchemaDB_List(t *testing.T) {
+	list := threadSchemaStore.List("thread")
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of schemas")
+		return
+	}
+	if list.Items[0].Hash != "Qm1" || list.Items[1].Hash != "Qm2" {
+		t.Error("schemas are not ordered by version")
+	}
+}
+
+func TestThreadSchemaDB_DeleteByThread(t *testing.T) {
+	err := threadSchemaStore.DeleteByThread("thread")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(threadSchemaStore.List("thread").Items) != 0 {
+		t.Error("delete by thread failed")
+	}
+	if len(threadSchemaStore.List("other").Items) != 1 {
+		t.Error("delete by thread removed another thread's schemas")
+	}
+}
@@ -34,6 +34,18 @@ var migrations = []Migration{
 	m.Minor013{},
 	m.Minor014{},
 	m.Minor015{},
+	m.Minor016{},
+	m.Minor017{},
+	m.Minor018{},
+	m.Minor019{},
+	m.Minor020{},
+	m.Minor021{},
+	m.Minor022{},
+	m.Minor023{},
+	m.Minor024{},
+	m.Minor025{},
+	m.Minor026{},
+	m.Minor027{},
 }
 
 // Stat returns whether or not there's a major migration ahead of the current repover
@@ -0,0 +1,89 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt015(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table files (mill text not null, checksum text not null, source text not null, opts text not null, hash text not null, key text not null, media text not null, name text not null, size integer not null, added integer not null, meta blob, targets text, primary key (mill, checksum));
+    create index file_hash on files (hash);
+    create unique index file_mill_source_opts on files (mill, source, opts);
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into files(mill, checksum, source, opts, hash, key, media, name, size, added, meta, targets) values(?,?,?,?,?,?,?,?,?,?,?,?)", "/blob", "checksum", "source", "opts", "hash", "key", "media", "name", 8, 0, []byte("{}"), "targets")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test016(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt015(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor016
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new tables
+	_, err = db.Exec("insert into files(mill, checksum, source, opts, hash, key, media, name, size, added, meta, targets, encryption) values(?,?,?,?,?,?,?,?,?,?,?,?,?)", "/blob", "checksum2", "source2", "opts", "hash2", "key", "media", "name", 8, 0, []byte("{}"), "targets", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure existing files default to whole-file encryption
+	var encryption int
+	err = db.QueryRow("select encryption from files where hash='hash';").Scan(&encryption)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if encryption != 0 {
+		t.Error("wrong default encryption")
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "17" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
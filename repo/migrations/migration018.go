@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+	"time"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor018 struct{}
+
+func (Minor018) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		_, err = db.Exec("pragma key='" + pinCode + "';")
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table thread_schemas (threadId text not null, version integer not null, hash text not null, date integer not null, primary key (threadId, version));
+    `
+	_, err = db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// existing schemas are the first version
+	_, err = db.Exec("insert into thread_schemas select id, 1, schema, ? from threads where schema!='';", time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+
+	// update version
+	f19, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f19.Close()
+	if _, err = f19.Write([]byte("19")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor018) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor018) Major() bool {
+	return false
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor021 struct{}
+
+func (Minor021) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		_, err = db.Exec("pragma key='" + pinCode + "';")
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+    alter table blocks add column expires integer not null default 0;
+    create index block_expires on blocks (expires);
+    create table thread_retentions (threadId text primary key not null, maxAge integer not null, maxBlocks integer not null);
+    `
+	_, err = db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	f22, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f22.Close()
+	if _, err = f22.Write([]byte("22")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor021) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor021) Major() bool {
+	return false
+}
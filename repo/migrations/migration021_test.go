@@ -0,0 +1,87 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt020(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null);
+    create index block_threadId on blocks (threadId);
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into blocks(id, threadId, authorId, type, date, parents, target, body, data, status, attempts) values(?,?,?,?,?,?,?,?,?,?,?)", "id", "threadId", "authorId", 6, 0, "parents", "target", "body", "data", 0, 0)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test021(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt020(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor021
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// existing blocks don't expire
+	var expires int64
+	err = db.QueryRow("select expires from blocks where id='id';").Scan(&expires)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expires != 0 {
+		t.Errorf("expected zero expiration, got %d", expires)
+	}
+
+	// test new tables
+	_, err = db.Exec("insert into thread_retentions(threadId, maxAge, maxBlocks) values(?,?,?)", "thread", 3600, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "22" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor022 struct{}
+
+func (Minor022) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		_, err = db.Exec("pragma key='" + pinCode + "';")
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+    alter table blocks add column clock integer not null default 0;
+    create index block_clock on blocks (clock);
+    `
+	_, err = db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	f23, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f23.Close()
+	if _, err = f23.Write([]byte("23")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor022) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor022) Major() bool {
+	return false
+}
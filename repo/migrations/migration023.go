@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor023 struct{}
+
+func (Minor023) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		_, err = db.Exec("pragma key='" + pinCode + "';")
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+    alter table cafe_sessions add column usage blob;
+    alter table cafe_clients add column quotaBytes integer not null default 0;
+    alter table cafe_clients add column quotaObjects integer not null default 0;
+    alter table cafe_clients add column quotaThreads integer not null default 0;
+    alter table cafe_tokens add column quotaBytes integer not null default 0;
+    alter table cafe_tokens add column quotaObjects integer not null default 0;
+    alter table cafe_tokens add column quotaThreads integer not null default 0;
+    create table cafe_client_objects (id text not null, clientId text not null, size integer not null, date integer not null, primary key (id, clientId));
+    create index cafe_client_object_clientId on cafe_client_objects (clientId);
+    `
+	_, err = db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	f24, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f24.Close()
+	if _, err = f24.Write([]byte("24")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor023) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor023) Major() bool {
+	return false
+}
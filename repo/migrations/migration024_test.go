@@ -0,0 +1,81 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt023(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null, quotaBytes integer not null default 0, quotaObjects integer not null default 0, quotaThreads integer not null default 0);
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_tokens(id, token, date) values(?,?,?)", "id", "token", 0)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test024(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt023(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor024
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// existing tokens are unrestricted
+	var expires, maxRegistrations int64
+	var scopes string
+	err = db.QueryRow("select expires, maxRegistrations, scopes from cafe_tokens where id='id';").
+		Scan(&expires, &maxRegistrations, &scopes)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expires != 0 || maxRegistrations != 0 || scopes != "" {
+		t.Error("expected an unrestricted token")
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "25" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
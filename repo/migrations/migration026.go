@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor026 struct{}
+
+func (Minor026) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		_, err = db.Exec("pragma key='" + pinCode + "';")
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table cafe_replications (id text primary key not null, peerId text not null, clientId text not null, type integer not null, target text not null, date integer not null, attempts integer not null);
+    create index cafe_replication_peerId on cafe_replications (peerId);
+    create index cafe_replication_date on cafe_replications (date);
+    `
+	_, err = db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	f27, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f27.Close()
+	if _, err = f27.Write([]byte("27")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor026) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor026) Major() bool {
+	return false
+}
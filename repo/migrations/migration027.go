@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor027 struct{}
+
+func (Minor027) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		_, err = db.Exec("pragma key='" + pinCode + "';")
+		if err != nil {
+			return err
+		}
+	}
+
+	// existing members are kept as changes that come before all blocks with a clock
+	query := `
+    create table thread_member_changes (id text primary key not null, threadId text not null, address text not null, clock integer not null, date integer not null, role integer not null, membership integer not null);
+    create index thread_member_change_threadId_address on thread_member_changes (threadId, address);
+    insert or ignore into thread_member_changes select blockId, threadId, address, 0, date, role, case removed when 1 then 2 else 1 end from thread_members;
+    `
+	_, err = db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	f28, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f28.Close()
+	if _, err = f28.Write([]byte("28")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor027) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor027) Major() bool {
+	return false
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"sort"
 
 	ipld "github.com/ipfs/go-ipld-format"
 	"github.com/textileio/go-textile/pb"
@@ -25,20 +26,43 @@ var ErrMissingJsonSchema = fmt.Errorf("json mill requires a json schema")
 // ErrBadJsonSchema indicates json schema is invalid
 var ErrBadJsonSchema = fmt.Errorf("json schema is not valid")
 
+// ErrOptionalLinkUse indicates a required link uses an optional link
+var ErrOptionalLinkUse = fmt.Errorf("required link uses an optional link")
+
 // FileTag indicates the link should "use" the input file as source
 const FileTag = ":file"
 
 // SingleFileTag is a magic key indicating that a directory is actually a single file
 const SingleFileTag = ":single"
 
-// ValidateMill is false if mill is not one of the built in tags
-func ValidateMill(mill string) bool {
+// ValidateMill is false if mill is not one of the built in tags or the given external mills
+func ValidateMill(mill string, external []string) bool {
+	if BuiltinMill(mill) {
+		return true
+	}
+	for _, id := range external {
+		if id == mill {
+			return true
+		}
+	}
+	return false
+}
+
+// BuiltinMill is false if mill is not one of the built in tags
+func BuiltinMill(mill string) bool {
 	switch mill {
 	case
 		"/schema",
 		"/blob",
 		"/image/resize",
 		"/image/exif",
+		"/image/phash",
+		"/video/poster",
+		"/video/probe",
+		"/audio/meta",
+		"/audio/cover",
+		"/doc/meta",
+		"/doc/text",
 		"/json":
 		return true
 	}
@@ -76,6 +100,20 @@ func Steps(links map[string]*pb.Link) ([]pb.Step, error) {
 	return steps, nil
 }
 
+// ValidateOptional returns an error if a required link uses an optional link,
+// which would fail whenever the optional link is skipped
+func ValidateOptional(links map[string]*pb.Link) error {
+	for _, link := range links {
+		if link.Optional {
+			continue
+		}
+		if use, ok := links[link.Use]; ok && use.Optional {
+			return ErrOptionalLinkUse
+		}
+	}
+	return nil
+}
+
 // orderLinks attempts to place all links in steps, returning any unused
 // whose source is not yet in steps
 func orderLinks(links map[string]*pb.Link, steps *[]pb.Step) map[string]*pb.Link {
@@ -100,3 +138,62 @@ func orderLinks(links map[string]*pb.Link, steps *[]pb.Step) map[string]*pb.Link
 	}
 	return unused
 }
+
+// Compare returns the link differences between two schemas. A single file
+// schema is compared as one link named SingleFileTag.
+func Compare(old *pb.Node, new *pb.Node) *pb.SchemaDiff {
+	diff := &pb.SchemaDiff{}
+	olinks := nodeLinks(old)
+	nlinks := nodeLinks(new)
+
+	for name, nl := range nlinks {
+		ol, ok := olinks[name]
+		if !ok {
+			diff.Added = append(diff.Added, name)
+			continue
+		}
+		if ol.Use != nl.Use || ol.Mill != nl.Mill || !sameOpts(ol.Opts, nl.Opts) {
+			diff.Changed = append(diff.Changed, name)
+		}
+	}
+	for name := range olinks {
+		if _, ok := nlinks[name]; !ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+
+	diff.Compatible = len(diff.Removed) == 0 && len(diff.Changed) == 0
+	return diff
+}
+
+// nodeLinks returns the links of a schema node
+func nodeLinks(node *pb.Node) map[string]*pb.Link {
+	if node == nil {
+		return nil
+	}
+	if node.Mill != "" {
+		return map[string]*pb.Link{
+			SingleFileTag: {
+				Use:  FileTag,
+				Mill: node.Mill,
+				Opts: node.Opts,
+			},
+		}
+	}
+	return node.Links
+}
+
+func sameOpts(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package textile
+
+var Audio = `
+{
+  "name": "audio",
+  "pin": true,
+  "links": {
+    "raw": {
+      "use": ":file",
+      "mill": "/blob"
+    },
+    "meta": {
+      "use": "raw",
+      "pin": true,
+      "mill": "/audio/meta"
+    },
+    "cover": {
+      "use": "raw",
+      "optional": true,
+      "mill": "/audio/cover",
+      "opts": {
+        "width": "800",
+        "quality": "80"
+      }
+    },
+    "thumb": {
+      "use": "cover",
+      "pin": true,
+      "optional": true,
+      "mill": "/image/resize",
+      "opts": {
+        "width": "100",
+        "quality": "80"
+      }
+    }
+  }
+}
+`
@@ -1,5 +1,7 @@
 package textile
 
+// CameraRoll backs up photos. Backups often add the same photo more than once,
+// e.g., at different sizes, so the phash link lets Textile.SimilarFiles find them.
 var CameraRoll = `
 {
   "name": "camera_roll",
@@ -13,6 +15,10 @@ var CameraRoll = `
       "use": "raw",
       "mill": "/image/exif"
     },
+    "phash": {
+      "use": "raw",
+      "mill": "/image/phash"
+    },
     "thumb": {
       "use": "raw",
       "pin": true,
@@ -0,0 +1,23 @@
+package textile
+
+var Document = `
+{
+  "name": "document",
+  "pin": true,
+  "links": {
+    "raw": {
+      "use": ":file",
+      "mill": "/blob"
+    },
+    "meta": {
+      "use": "raw",
+      "pin": true,
+      "mill": "/doc/meta"
+    },
+    "text": {
+      "use": "raw",
+      "mill": "/doc/text"
+    }
+  }
+}
+`
@@ -0,0 +1,35 @@
+package textile
+
+var Video = `
+{
+  "name": "video",
+  "pin": true,
+  "links": {
+    "raw": {
+      "use": ":file",
+      "mill": "/blob"
+    },
+    "meta": {
+      "use": "raw",
+      "mill": "/video/probe"
+    },
+    "poster": {
+      "use": "raw",
+      "mill": "/video/poster",
+      "opts": {
+        "width": "800",
+        "quality": "80"
+      }
+    },
+    "thumb": {
+      "use": "poster",
+      "pin": true,
+      "mill": "/image/resize",
+      "opts": {
+        "width": "320",
+        "quality": "80"
+      }
+    }
+  }
+}
+`